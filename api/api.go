@@ -19,44 +19,59 @@ package api
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
 
+	"github.com/Unbabel/replicant/manager"
 	"github.com/Unbabel/replicant/server"
 	"github.com/Unbabel/replicant/transaction"
+	"github.com/Unbabel/replicant/transaction/validate"
 )
 
 var (
-	EndpointTransaction = "/api/v1/transaction"
-	EndpointRun         = "/api/v1/run"
-	EndpointResult      = "/api/v1/result"
+	EndpointTransaction  = "/api/v1/transaction"
+	EndpointRun          = "/api/v1/run"
+	EndpointResult       = "/api/v1/result"
+	EndpointResultStream = "/api/v1/stream/result"
+	EndpointExecutors    = "/api/v1/executors"
+	EndpointModule       = "/api/v1/module"
 )
 
 // Result is the api calls result envelope
 type Result struct {
-	Error        string               `json:"error,omitempty"`
-	Message      string               `json:"message,omitempty"`
-	Results      []transaction.Result `json:"results,omitempty"`
-	Transactions []transaction.Config `json:"transactions,omitempty"`
+	Error        string                   `json:"error,omitempty"`
+	Errors       validate.Errors          `json:"errors,omitempty"`
+	Message      string                   `json:"message,omitempty"`
+	Results      []transaction.Result     `json:"results,omitempty"`
+	Transactions []transaction.Config     `json:"transactions,omitempty"`
+	Executors    []manager.ExecutorStatus `json:"executors,omitempty"`
 }
 
 // AddAllRoutes api routes to the given server with the given prefix.
 // The prefix can be empty.
-func AddAllRoutes(server *server.Server) {
-	server.AddServerHandler(http.MethodPost, EndpointTransaction, AddTransaction)
-	server.AddServerHandler(http.MethodGet, EndpointTransaction, GetTransactions)
-	server.AddServerHandler(http.MethodGet, EndpointTransaction+"/:name", GetTransaction)
-	server.AddServerHandler(http.MethodDelete, EndpointTransaction+"/:name", RemoveTransaction)
-	server.AddServerHandler(http.MethodPost, EndpointRun, RunTransaction)
-	server.AddServerHandler(http.MethodPost, EndpointRun+`/:name`, RunTransactionByName)
-	server.AddServerHandler(http.MethodGet, EndpointResult, GetResults)
-	server.AddServerHandler(http.MethodGet, EndpointResult+"/:name", GetResult)
-	server.AddServerHandler(http.MethodPost, "/api/v1/callback/:uuid", CallbackRequest)
+func AddAllRoutes(srv *server.Server) {
+	srv.AddServerHandler(http.MethodPost, EndpointTransaction, AddTransaction)
+	srv.AddServerHandler(http.MethodGet, EndpointTransaction, GetTransactions)
+	srv.AddServerHandler(http.MethodGet, EndpointTransaction+"/:name", GetTransaction)
+	srv.AddServerHandler(http.MethodPut, EndpointTransaction+"/:name", UpdateTransaction)
+	srv.AddServerHandler(http.MethodDelete, EndpointTransaction+"/:name", RemoveTransaction)
+	srv.AddServerHandler(http.MethodPost, EndpointRun, RunTransaction)
+	srv.AddServerHandler(http.MethodPost, EndpointRun+`/:name`, RunTransactionByName)
+	srv.AddServerHandler(http.MethodGet, EndpointResult, GetResults)
+	srv.AddServerHandler(http.MethodGet, EndpointResult+"/:name", GetResult)
+	srv.AddServerHandler(http.MethodGet, EndpointResult+"/:name/artifacts/:artifact", GetArtifact)
+	srv.AddServerHandler(http.MethodGet, EndpointResultStream, StreamResults)
+	srv.AddServerHandler(http.MethodGet, EndpointExecutors, GetExecutors)
+	srv.AddServerHandler(http.MethodPost, EndpointExecutors, RegisterExecutor, server.RoleExecutor)
+	srv.AddServerHandler(http.MethodPost, "/api/v1/callback/:uuid", CallbackRequest, server.RoleExecutor)
 }
 
-// httpError wraps http status codes and error messages as json responses
+// httpError wraps http status codes and error messages as json responses.
+// Transaction config validation errors are included as field level errors.
 func httpError(w http.ResponseWriter, err error, code int) {
 	var result Result
 	result.Error = err.Error()
+	errors.As(err, &result.Errors)
 	res, _ := json.Marshal(&result)
 
 	w.WriteHeader(code)
@@ -0,0 +1,63 @@
+package api
+
+/*
+   Copyright 2019 Bruno Moura <brunotm@gmail.com>
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/Unbabel/replicant/server"
+	"github.com/Unbabel/replicant/store"
+)
+
+// GetArtifact serves the named artifact of the result with the given uuid.
+// The router does not allow a different parameter name alongside the result name,
+// so the result uuid is taken from the name parameter.
+func GetArtifact(srv *server.Server) (handle server.Handler) {
+	return func(w http.ResponseWriter, r *http.Request, p server.Params) {
+		defer r.Body.Close()
+
+		artifact, err := srv.Manager().GetArtifact(p.ByName("name"), p.ByName("artifact"))
+		if err != nil {
+			w.Header().Set("Content-Type", "application/json; charset=utf-8")
+			w.Header().Set("X-Content-Type-Options", "nosniff")
+
+			switch {
+			case errors.Is(err, store.ErrInvalidArtifact):
+				httpError(w, err, http.StatusBadRequest)
+			case errors.Is(err, store.ErrArtifactNotFound):
+				httpError(w, err, http.StatusNotFound)
+			default:
+				httpError(w, err, http.StatusInternalServerError)
+			}
+			return
+		}
+
+		if artifact.ContentType == "" {
+			artifact.ContentType = "application/octet-stream"
+		}
+
+		// artifacts such as captured pages must not run in the api origin
+		w.Header().Set("Content-Type", artifact.ContentType)
+		w.Header().Set("Content-Length", strconv.Itoa(len(artifact.Data)))
+		w.Header().Set("Content-Security-Policy", "sandbox")
+		w.Header().Set("X-Content-Type-Options", "nosniff")
+		w.WriteHeader(http.StatusOK)
+		w.Write(artifact.Data)
+	}
+}
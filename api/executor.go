@@ -0,0 +1,85 @@
+package api
+
+/*
+   Copyright 2019 Bruno Moura <brunotm@gmail.com>
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/Unbabel/replicant/manager"
+	"github.com/Unbabel/replicant/server"
+)
+
+// GetExecutors fetches the state of the manager executor pool
+func GetExecutors(srv *server.Server) (handle server.Handler) {
+	return func(w http.ResponseWriter, r *http.Request, p server.Params) {
+		defer r.Body.Close()
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.Header().Set("X-Content-Type-Options", "nosniff")
+
+		var result Result
+		result.Executors = srv.Manager().Executors()
+		buf, err := json.Marshal(&result)
+		if err != nil {
+			httpError(w, fmt.Errorf("error serializing results: %w", err), http.StatusInternalServerError)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		w.Write(buf)
+	}
+}
+
+// RegisterExecutor registers or refreshes an executor in the manager executor pool.
+// Executors call it periodically as a heartbeat.
+func RegisterExecutor(srv *server.Server) (handle server.Handler) {
+	return func(w http.ResponseWriter, r *http.Request, p server.Params) {
+		defer r.Body.Close()
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.Header().Set("X-Content-Type-Options", "nosniff")
+
+		var result Result
+		var err error
+		var buf []byte
+
+		if buf, err = ioutil.ReadAll(r.Body); err != nil {
+			httpError(w, fmt.Errorf("error reading request body: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		var registration manager.Registration
+		if err = json.Unmarshal(buf, &registration); err != nil {
+			httpError(w, fmt.Errorf("error deserializing json request body: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		if err = srv.Manager().RegisterExecutor(registration); err != nil {
+			httpError(w, err, http.StatusBadRequest)
+			return
+		}
+
+		result.Message = "executor registered"
+		buf, err = json.Marshal(&result)
+		if err != nil {
+			httpError(w, fmt.Errorf("error serializing results: %w", err), http.StatusInternalServerError)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		w.Write(buf)
+	}
+}
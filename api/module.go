@@ -0,0 +1,61 @@
+package api
+
+/*
+   Copyright 2019 Bruno Moura <brunotm@gmail.com>
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/Unbabel/replicant/driver/js2"
+	"github.com/Unbabel/replicant/server"
+)
+
+// AddModuleRoutes adds routes for serving the shared javascript modules
+// in the given directory to executors running js2 transactions
+func AddModuleRoutes(srv *server.Server, dir string) {
+	srv.AddHandler(http.MethodGet, EndpointModule+"/:name", GetModule(dir), server.RoleReader, server.RoleExecutor)
+}
+
+// GetModule serves the source of the named javascript module from the given directory
+func GetModule(dir string) (handle server.Handler) {
+	loader := js2.DirLoader(dir)
+
+	return func(w http.ResponseWriter, r *http.Request, p server.Params) {
+		source, err := loader(r.Context(), p.ByName("name"))
+		if err != nil {
+			w.Header().Set("Content-Type", "application/json; charset=utf-8")
+			w.Header().Set("X-Content-Type-Options", "nosniff")
+
+			switch {
+			case errors.Is(err, js2.ErrInvalidModuleName):
+				httpError(w, err, http.StatusBadRequest)
+			case errors.Is(err, os.ErrNotExist):
+				httpError(w, fmt.Errorf("module %s not found", p.ByName("name")), http.StatusNotFound)
+			default:
+				httpError(w, err, http.StatusInternalServerError)
+			}
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/javascript; charset=utf-8")
+		w.Header().Set("X-Content-Type-Options", "nosniff")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(source))
+	}
+}
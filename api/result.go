@@ -20,12 +20,19 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/Unbabel/replicant/server"
+	"github.com/Unbabel/replicant/store"
 	"github.com/Unbabel/replicant/transaction"
 )
 
-// GetResult of managed replicant transactions by name
+// GetResult of managed replicant transactions by name.
+// When any of the from, to, limit or label query parameters are specified the
+// stored result history matching them is returned instead of the latest result.
 func GetResult(srv *server.Server) (handle server.Handler) {
 	return func(w http.ResponseWriter, r *http.Request, p server.Params) {
 		defer r.Body.Close()
@@ -35,13 +42,32 @@ func GetResult(srv *server.Server) (handle server.Handler) {
 		var result Result
 
 		name := p.ByName("name")
-		res, err := srv.Manager().GetResult(name)
-		if err != nil {
-			httpError(w, err, http.StatusNotFound)
-			return
+		values := r.URL.Query()
+
+		switch isResultQuery(values) {
+		case true:
+			query, err := parseResultQuery(values)
+			if err != nil {
+				httpError(w, err, http.StatusBadRequest)
+				return
+			}
+			query.Name = name
+
+			result.Results, err = srv.Manager().QueryResults(query)
+			if err != nil {
+				httpError(w, err, http.StatusInternalServerError)
+				return
+			}
+
+		case false:
+			res, err := srv.Manager().GetResult(name)
+			if err != nil {
+				httpError(w, err, http.StatusNotFound)
+				return
+			}
+			result.Results = []transaction.Result{res}
 		}
 
-		result.Results = []transaction.Result{res}
 		buf, err := json.Marshal(&result)
 		if err != nil {
 			httpError(w, err, http.StatusInternalServerError)
@@ -70,3 +96,49 @@ func GetResults(srv *server.Server) (handle server.Handler) {
 		w.Write(buf)
 	}
 }
+
+// isResultQuery checks if the given url values contain result query parameters
+func isResultQuery(values url.Values) (ok bool) {
+	for _, p := range []string{"from", "to", "limit", "label"} {
+		if _, ok = values[p]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// parseResultQuery parses a result query from the given url values.
+// Times are expected in the RFC3339 format and labels as label=<key>:<value>.
+func parseResultQuery(values url.Values) (query store.ResultQuery, err error) {
+	if v := values.Get("from"); v != "" {
+		if query.From, err = time.Parse(time.RFC3339, v); err != nil {
+			return query, fmt.Errorf("invalid from parameter: %w", err)
+		}
+	}
+
+	if v := values.Get("to"); v != "" {
+		if query.To, err = time.Parse(time.RFC3339, v); err != nil {
+			return query, fmt.Errorf("invalid to parameter: %w", err)
+		}
+	}
+
+	if v := values.Get("limit"); v != "" {
+		if query.Limit, err = strconv.Atoi(v); err != nil || query.Limit < 0 {
+			return query, fmt.Errorf("invalid limit parameter: %s", v)
+		}
+	}
+
+	for _, label := range values["label"] {
+		kv := strings.SplitN(label, ":", 2)
+		if len(kv) != 2 {
+			return query, fmt.Errorf("invalid label parameter: %s", label)
+		}
+
+		if query.Labels == nil {
+			query.Labels = make(map[string]string)
+		}
+		query.Labels[kv[0]] = kv[1]
+	}
+
+	return query, nil
+}
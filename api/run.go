@@ -60,6 +60,11 @@ func RunTransaction(srv *server.Server) (handle server.Handler) {
 			return
 		}
 
+		if err = srv.Manager().Validate(config); err != nil {
+			httpError(w, err, http.StatusBadRequest)
+			return
+		}
+
 		res := srv.Manager().Run(config)
 		result.Results = []transaction.Result{res}
 
@@ -0,0 +1,125 @@
+package api
+
+/*
+   Copyright 2019 Bruno Moura <brunotm@gmail.com>
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/Unbabel/replicant/emitter/stream"
+	"github.com/Unbabel/replicant/server"
+)
+
+// streamKeepAlive is the interval for sending comments on idle streams
+const streamKeepAlive = time.Second * 15
+
+// StreamResults streams results as server-sent events as they are produced.
+// Results can be filtered with the name and label=<key>:<value> query parameters,
+// and streams resumed from the Last-Event-ID header or last_event_id query parameter.
+func StreamResults(srv *server.Server) (handle server.Handler) {
+	return func(w http.ResponseWriter, r *http.Request, p server.Params) {
+		defer r.Body.Close()
+
+		flusher, ok := w.(http.Flusher)
+		if !ok {
+			w.Header().Set("Content-Type", "application/json; charset=utf-8")
+			httpError(w, errors.New("streaming not supported"), http.StatusInternalServerError)
+			return
+		}
+
+		values := r.URL.Query()
+		query, err := parseResultQuery(values)
+		if err != nil {
+			w.Header().Set("Content-Type", "application/json; charset=utf-8")
+			httpError(w, err, http.StatusBadRequest)
+			return
+		}
+
+		lastID := r.Header.Get("Last-Event-ID")
+		if lastID == "" {
+			lastID = values.Get("last_event_id")
+		}
+
+		var last uint64
+		if lastID != "" {
+			if last, err = strconv.ParseUint(lastID, 10, 64); err != nil {
+				w.Header().Set("Content-Type", "application/json; charset=utf-8")
+				httpError(w, fmt.Errorf("invalid last event id: %s", lastID), http.StatusBadRequest)
+				return
+			}
+		}
+
+		sub, missed := srv.Manager().Stream().Subscribe(
+			stream.Filter{Name: values.Get("name"), Labels: query.Labels}, last)
+		defer sub.Close()
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+		w.Header().Set("Connection", "keep-alive")
+		w.Header().Set("X-Accel-Buffering", "no")
+		w.WriteHeader(http.StatusOK)
+
+		for x := 0; x < len(missed); x++ {
+			if err = writeEvent(w, missed[x]); err != nil {
+				return
+			}
+		}
+		flusher.Flush()
+
+		ticker := time.NewTicker(streamKeepAlive)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-r.Context().Done():
+				return
+
+			case <-ticker.C:
+				if _, err = w.Write([]byte(": keepalive\n\n")); err != nil {
+					return
+				}
+				flusher.Flush()
+
+			case event, ok := <-sub.Events():
+				// closed on shutdown or when falling behind, clients resume from the last event id
+				if !ok {
+					return
+				}
+
+				if err = writeEvent(w, event); err != nil {
+					return
+				}
+				flusher.Flush()
+			}
+		}
+	}
+}
+
+// writeEvent writes the event result as a server-sent event
+func writeEvent(w http.ResponseWriter, event stream.Event) (err error) {
+	buf, err := json.Marshal(&event.Result)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(w, "id: %d\nevent: result\ndata: %s\n\n", event.ID, buf)
+	return err
+}
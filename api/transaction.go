@@ -18,11 +18,13 @@ package api
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/http"
 
 	"github.com/Unbabel/replicant/server"
+	"github.com/Unbabel/replicant/store"
 	"github.com/Unbabel/replicant/transaction"
 	"gopkg.in/yaml.v2"
 )
@@ -79,6 +81,71 @@ func AddTransaction(srv *server.Server) (handle server.Handler) {
 	}
 }
 
+// UpdateTransaction replaces a named transaction definition in the replicant manager
+func UpdateTransaction(srv *server.Server) (handle server.Handler) {
+	return func(w http.ResponseWriter, r *http.Request, p server.Params) {
+		defer r.Body.Close()
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.Header().Set("X-Content-Type-Options", "nosniff")
+
+		var result Result
+		var err error
+		var buf []byte
+
+		if buf, err = ioutil.ReadAll(r.Body); err != nil {
+			httpError(w, fmt.Errorf("error reading request body: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		config := transaction.Config{}
+
+		switch r.Header.Get("Content-Type") {
+		case "application/json":
+			if err = json.Unmarshal(buf, &config); err != nil {
+				httpError(w, fmt.Errorf("error deserializing json request body: %w", err), http.StatusBadRequest)
+				return
+			}
+		case "application/yaml":
+			if err = yaml.Unmarshal(buf, &config); err != nil {
+				httpError(w, fmt.Errorf("error deserializing yaml request body: %w", err), http.StatusBadRequest)
+				return
+			}
+		default:
+			httpError(w, fmt.Errorf("unknown Content-Type"), http.StatusBadRequest)
+			return
+		}
+
+		name := p.ByName("name")
+		switch config.Name {
+		case "":
+			config.Name = name
+		case name:
+		default:
+			httpError(w, fmt.Errorf("transaction name %s does not match %s", config.Name, name), http.StatusBadRequest)
+			return
+		}
+
+		err = srv.Manager().Update(config)
+		switch {
+		case errors.Is(err, store.ErrTransactionNotFound):
+			httpError(w, err, http.StatusNotFound)
+			return
+		case err != nil:
+			httpError(w, err, http.StatusBadRequest)
+			return
+		}
+
+		result.Message = "transaction updated"
+		buf, err = json.Marshal(&result)
+		if err != nil {
+			httpError(w, fmt.Errorf("error serializing results: %w", err), http.StatusInternalServerError)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		w.Write(buf)
+	}
+}
+
 // GetTransaction fetches a named transaction definition from the replicant manager
 func GetTransaction(srv *server.Server) (handle server.Handler) {
 	return func(w http.ResponseWriter, r *http.Request, p server.Params) {
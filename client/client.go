@@ -1,25 +1,40 @@
 package client
 
 import (
+	"bufio"
 	"bytes"
-	"crypto/tls"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/Unbabel/replicant/api"
+	"github.com/Unbabel/replicant/internal/tlsutil"
 	"github.com/Unbabel/replicant/transaction"
 )
 
+// ErrTransactionNotFound the transaction is not managed by the server
+var ErrTransactionNotFound = errors.New("client: transaction not found")
+
 // Config for replicant client
 type Config struct {
 	URL                string
 	Username           string
 	Password           string
+	Token              string
 	Timeout            time.Duration
 	InsecureSkipVerify bool
+	// CACertFile with additional CAs for verifying the server certificate
+	CACertFile string
+	// CertFile and KeyFile with the client certificate presented to servers requiring mutual TLS
+	CertFile string
+	KeyFile  string
 }
 
 // Client for replicant api
@@ -33,7 +48,11 @@ func New(c Config) (client *Client, err error) {
 	client = &Client{}
 	client.config = c
 	transport := &http.Transport{}
-	transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: c.InsecureSkipVerify}
+	transport.TLSClientConfig, err = tlsutil.ClientConfig(c.CACertFile, c.CertFile, c.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("client: %w", err)
+	}
+	transport.TLSClientConfig.InsecureSkipVerify = c.InsecureSkipVerify
 	client.http = &http.Client{Transport: transport}
 	client.http.Timeout = c.Timeout
 
@@ -50,9 +69,7 @@ func (c *Client) Run(t transaction.Config) (r transaction.Result, err error) {
 
 	req, err := http.NewRequest(http.MethodPost, c.config.URL+api.EndpointRun, bytes.NewReader(buf))
 	req.Header.Add("Content-Type", "application/json")
-	if c.config.Username != "" && c.config.Password != "" {
-		req.SetBasicAuth(c.config.Username, c.config.Password)
-	}
+	c.setAuth(req)
 
 	resp, err := c.http.Do(req)
 	if err != nil {
@@ -86,9 +103,7 @@ func (c *Client) Run(t transaction.Config) (r transaction.Result, err error) {
 func (c *Client) RunByName(name string) (r transaction.Result, err error) {
 	req, err := http.NewRequest(http.MethodPost, c.config.URL+api.EndpointRun+"/"+name, nil)
 	req.Header.Add("Content-Type", "application/json")
-	if c.config.Username != "" && c.config.Password != "" {
-		req.SetBasicAuth(c.config.Username, c.config.Password)
-	}
+	c.setAuth(req)
 
 	resp, err := c.http.Do(req)
 	if err != nil {
@@ -126,7 +141,7 @@ func (c *Client) GetTransaction(name string) (t transaction.Config, err error) {
 	}
 
 	if len(ts) == 0 {
-		return t, fmt.Errorf("client: no transaction with name %s found", name)
+		return t, fmt.Errorf("%w: %s", ErrTransactionNotFound, name)
 	}
 
 	return ts[0], nil
@@ -147,11 +162,13 @@ func (c *Client) getTransactions(name string) (t []transaction.Config, err error
 		req, err = http.NewRequest(http.MethodGet, c.config.URL+api.EndpointTransaction, nil)
 	}
 
-	req.Header.Add("Content-Type", "application/json")
-	if c.config.Username != "" && c.config.Password != "" {
-		req.SetBasicAuth(c.config.Username, c.config.Password)
+	if err != nil {
+		return nil, fmt.Errorf("client: error creating request: %w", err)
 	}
 
+	req.Header.Add("Content-Type", "application/json")
+	c.setAuth(req)
+
 	resp, err := c.http.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("client: error sending request: %w", err)
@@ -169,6 +186,10 @@ func (c *Client) getTransactions(name string) (t []transaction.Config, err error
 		return nil, fmt.Errorf("client: error unmarshaling response: %w", err)
 	}
 
+	if name != "" && resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("%w: %s", ErrTransactionNotFound, name)
+	}
+
 	if ar.Error != "" {
 		return nil, fmt.Errorf("client: server error: %s", ar.Error)
 	}
@@ -195,6 +216,29 @@ func (c *Client) GetResults() (t []transaction.Result, err error) {
 	return c.getResults("")
 }
 
+// GetResultHistory fetches the stored results for the given transaction from the server
+// within the given time window, ordered from the most recent. Zero values for from, to
+// and limit are not used for filtering.
+func (c *Client) GetResultHistory(name string, from, to time.Time, limit int) (t []transaction.Result, err error) {
+	if name == "" {
+		return nil, fmt.Errorf("client: must specify transaction name")
+	}
+
+	query := url.Values{}
+	if !from.IsZero() {
+		query.Set("from", from.Format(time.RFC3339))
+	}
+
+	if !to.IsZero() {
+		query.Set("to", to.Format(time.RFC3339))
+	}
+
+	// always send the limit parameter so that the server returns the history
+	query.Set("limit", strconv.Itoa(limit))
+
+	return c.getResults(name + "?" + query.Encode())
+}
+
 func (c *Client) getResults(name string) (t []transaction.Result, err error) {
 
 	var req *http.Request
@@ -206,9 +250,7 @@ func (c *Client) getResults(name string) (t []transaction.Result, err error) {
 	}
 
 	req.Header.Add("Content-Type", "application/json")
-	if c.config.Username != "" && c.config.Password != "" {
-		req.SetBasicAuth(c.config.Username, c.config.Password)
-	}
+	c.setAuth(req)
 
 	resp, err := c.http.Do(req)
 	if err != nil {
@@ -234,6 +276,127 @@ func (c *Client) getResults(name string) (t []transaction.Result, err error) {
 	return ar.Results, nil
 }
 
+// Watch streams results from the server as they are produced, calling fn for each result
+// matching the given transaction name and labels, which are optional. Streams are resumed from
+// the last received result on disconnections until the context is done or fn returns an error.
+func (c *Client) Watch(ctx context.Context, name string, labels map[string]string, fn func(r transaction.Result) error) (err error) {
+	query := url.Values{}
+	if name != "" {
+		query.Set("name", name)
+	}
+
+	for k, v := range labels {
+		query.Add("label", k+":"+v)
+	}
+
+	// streams must not be bound by the client timeout
+	client := &http.Client{Transport: c.http.Transport}
+
+	var lastID string
+	for {
+		lastID, err = c.watch(ctx, client, query, lastID, fn)
+
+		var werr *watchError
+		switch {
+		case ctx.Err() != nil:
+			return ctx.Err()
+		case errors.As(err, &werr):
+			return werr.err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(time.Second):
+		}
+	}
+}
+
+// watchError is a non retriable error when watching results
+type watchError struct {
+	err error
+}
+
+func (e *watchError) Error() string { return e.err.Error() }
+
+func (c *Client) watch(ctx context.Context, client *http.Client, query url.Values,
+	lastID string, fn func(r transaction.Result) error) (id string, err error) {
+
+	id = lastID
+	req, err := http.NewRequest(http.MethodGet, c.config.URL+api.EndpointResultStream+"?"+query.Encode(), nil)
+	if err != nil {
+		return id, &watchError{fmt.Errorf("client: error creating request: %w", err)}
+	}
+
+	req = req.WithContext(ctx)
+	req.Header.Set("Accept", "text/event-stream")
+	if lastID != "" {
+		req.Header.Set("Last-Event-ID", lastID)
+	}
+	c.setAuth(req)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return id, fmt.Errorf("client: error sending request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		buf, _ := ioutil.ReadAll(resp.Body)
+		var ar api.Result
+		if json.Unmarshal(buf, &ar) == nil && ar.Error != "" {
+			return id, &watchError{fmt.Errorf("client: server error: %s", ar.Error)}
+		}
+		return id, &watchError{fmt.Errorf("client: unexpected response status: %s", resp.Status)}
+	}
+
+	scanner := bufio.NewScanner(resp.Body)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+
+	var eventID string
+	var data []string
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		switch {
+		case line == "":
+			// dispatch the event on blank lines
+			if len(data) == 0 {
+				continue
+			}
+
+			var r transaction.Result
+			if err = json.Unmarshal([]byte(strings.Join(data, "\n")), &r); err != nil {
+				return id, &watchError{fmt.Errorf("client: error unmarshaling result: %w", err)}
+			}
+			data = data[:0]
+
+			if eventID != "" {
+				id = eventID
+			}
+
+			if err = fn(r); err != nil {
+				return id, &watchError{err}
+			}
+
+		case strings.HasPrefix(line, ":"):
+			// comment
+
+		case strings.HasPrefix(line, "id:"):
+			eventID = strings.TrimSpace(strings.TrimPrefix(line, "id:"))
+
+		case strings.HasPrefix(line, "data:"):
+			data = append(data, strings.TrimPrefix(strings.TrimPrefix(line, "data:"), " "))
+		}
+	}
+
+	if err = scanner.Err(); err != nil {
+		return id, fmt.Errorf("client: error reading stream: %w", err)
+	}
+
+	return id, nil
+}
+
 // Add the given transaction definition
 func (c *Client) Add(t transaction.Config) (err error) {
 
@@ -244,10 +407,51 @@ func (c *Client) Add(t transaction.Config) (err error) {
 
 	req, err := http.NewRequest(http.MethodPost, c.config.URL+api.EndpointTransaction, bytes.NewReader(buf))
 	req.Header.Add("Content-Type", "application/json")
-	if c.config.Username != "" && c.config.Password != "" {
-		req.SetBasicAuth(c.config.Username, c.config.Password)
+	c.setAuth(req)
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return fmt.Errorf("client: error sending request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	buf, err = ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("client: error reading response: %w", err)
+	}
+
+	var ar api.Result
+	err = json.Unmarshal(buf, &ar)
+	if err != nil {
+		return fmt.Errorf("client: error unmarshaling response: %w", err)
+	}
+
+	if ar.Error != "" {
+		return fmt.Errorf("client: server error: %s", ar.Error)
+	}
+
+	return nil
+}
+
+// UpdateTransaction replaces the definition of an existing managed transaction
+func (c *Client) UpdateTransaction(t transaction.Config) (err error) {
+	if t.Name == "" {
+		return fmt.Errorf("client: must specify transaction name")
 	}
 
+	buf, err := json.Marshal(&t)
+	if err != nil {
+		return fmt.Errorf("client: error marshaling request: %w", err)
+	}
+
+	req, err := http.NewRequest(http.MethodPut, c.config.URL+api.EndpointTransaction+"/"+t.Name, bytes.NewReader(buf))
+	if err != nil {
+		return fmt.Errorf("client: error creating request: %w", err)
+	}
+
+	req.Header.Add("Content-Type", "application/json")
+	c.setAuth(req)
+
 	resp, err := c.http.Do(req)
 	if err != nil {
 		return fmt.Errorf("client: error sending request: %w", err)
@@ -280,9 +484,7 @@ func (c *Client) Delete(name string) (err error) {
 
 	req, err := http.NewRequest(http.MethodDelete, c.config.URL+api.EndpointTransaction+"/"+name, nil)
 	req.Header.Add("Content-Type", "application/json")
-	if c.config.Username != "" && c.config.Password != "" {
-		req.SetBasicAuth(c.config.Username, c.config.Password)
-	}
+	c.setAuth(req)
 
 	resp, err := c.http.Do(req)
 	if err != nil {
@@ -307,3 +509,13 @@ func (c *Client) Delete(name string) (err error) {
 
 	return nil
 }
+
+// setAuth sets the request credentials. Basic auth takes precedence over bearer tokens.
+func (c *Client) setAuth(req *http.Request) {
+	switch {
+	case c.config.Username != "" && c.config.Password != "":
+		req.SetBasicAuth(c.config.Username, c.config.Password)
+	case c.config.Token != "":
+		req.Header.Set("Authorization", "Bearer "+c.config.Token)
+	}
+}
@@ -0,0 +1,244 @@
+package client
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/Unbabel/replicant/api"
+	"github.com/Unbabel/replicant/internal/tlsutil"
+	"github.com/Unbabel/replicant/manager"
+	"github.com/Unbabel/replicant/server"
+	"github.com/Unbabel/replicant/store/memory"
+	"github.com/Unbabel/replicant/transaction"
+	"github.com/julienschmidt/httprouter"
+)
+
+// testCerts are the paths for a generated CA and the server and client certificates signed by it
+type testCerts struct {
+	ca, serverCert, serverKey, clientCert, clientKey string
+}
+
+func newTestCerts(t *testing.T, dir string) (certs testCerts) {
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("error generating key: %s", err)
+	}
+
+	ca := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "replicant test ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	caDER, err := x509.CreateCertificate(rand.Reader, ca, ca, &caKey.PublicKey, caKey)
+	if err != nil {
+		t.Fatalf("error creating CA certificate: %s", err)
+	}
+	certs.ca = writePEM(t, dir, "ca.pem", "CERTIFICATE", caDER)
+
+	issue := func(serial int64, name string, usage x509.ExtKeyUsage) (cert, key string) {
+		k, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err != nil {
+			t.Fatalf("error generating key: %s", err)
+		}
+
+		tmpl := &x509.Certificate{
+			SerialNumber: big.NewInt(serial),
+			Subject:      pkix.Name{CommonName: name},
+			NotBefore:    time.Now().Add(-time.Hour),
+			NotAfter:     time.Now().Add(time.Hour),
+			KeyUsage:     x509.KeyUsageDigitalSignature,
+			ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+			DNSNames:     []string{"localhost"},
+			IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		}
+
+		der, err := x509.CreateCertificate(rand.Reader, tmpl, ca, &k.PublicKey, caKey)
+		if err != nil {
+			t.Fatalf("error creating certificate: %s", err)
+		}
+
+		keyDER, err := x509.MarshalECPrivateKey(k)
+		if err != nil {
+			t.Fatalf("error marshaling key: %s", err)
+		}
+
+		return writePEM(t, dir, name+".pem", "CERTIFICATE", der),
+			writePEM(t, dir, name+"-key.pem", "EC PRIVATE KEY", keyDER)
+	}
+
+	certs.serverCert, certs.serverKey = issue(2, "server", x509.ExtKeyUsageServerAuth)
+	certs.clientCert, certs.clientKey = issue(3, "client", x509.ExtKeyUsageClientAuth)
+	return certs
+}
+
+func writePEM(t *testing.T, dir, name, typ string, der []byte) (path string) {
+	path = filepath.Join(dir, name)
+	buf := pem.EncodeToMemory(&pem.Block{Type: typ, Bytes: der})
+	if err := ioutil.WriteFile(path, buf, 0600); err != nil {
+		t.Fatalf("error writing %s: %s", name, err)
+	}
+	return path
+}
+
+// freeAddress returns a local address for the server to listen on
+func freeAddress(t *testing.T) (address string) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("error finding free port: %s", err)
+	}
+	defer l.Close()
+	return l.Addr().String()
+}
+
+func TestTLSEndToEnd(t *testing.T) {
+	dir, err := ioutil.TempDir("", "replicant-tls")
+	if err != nil {
+		t.Fatalf("error creating temp dir: %s", err)
+	}
+	defer os.RemoveAll(dir)
+
+	certs := newTestCerts(t, dir)
+
+	// executor requiring client certificates from the manager
+	executor := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var config transaction.Config
+		json.NewDecoder(r.Body).Decode(&config)
+		json.NewEncoder(w).Encode(&transaction.Result{Name: config.Name, Driver: config.Driver, Message: "ok"})
+	}))
+
+	executor.TLS, err = tlsutil.ServerConfig(certs.serverCert, certs.serverKey, certs.ca)
+	if err != nil {
+		t.Fatalf("error creating executor tls config: %s", err)
+	}
+	executor.StartTLS()
+	defer executor.Close()
+
+	executorTLS, err := tlsutil.ClientConfig(certs.ca, certs.clientCert, certs.clientKey)
+	if err != nil {
+		t.Fatalf("error creating manager tls config: %s", err)
+	}
+
+	s, _ := memory.New("")
+	rs, _ := memory.NewResultStore("")
+	m := manager.New(manager.Config{
+		ExecutorURLs: []string{executor.URL}, ExecutorTLS: executorTLS, HealthCheckInterval: time.Hour}, s, rs)
+
+	address := freeAddress(t)
+	srv, err := server.New(server.Config{
+		ListenAddress: address, TLSCertFile: certs.serverCert, TLSKeyFile: certs.serverKey}, m, httprouter.New())
+	if err != nil {
+		t.Fatalf("error creating server: %s", err)
+	}
+	api.AddAllRoutes(srv)
+	go srv.Start()
+	defer srv.Close(context.Background())
+
+	c, err := New(Config{URL: "https://" + address, CACertFile: certs.ca, Timeout: time.Second * 5})
+	if err != nil {
+		t.Fatalf("error creating client: %s", err)
+	}
+
+	config := transaction.Config{Name: "test-transaction", Driver: "javascript"}
+
+	var result transaction.Result
+	for x := 0; x < 50; x++ {
+		if result, err = c.Run(config); err == nil {
+			break
+		}
+		time.Sleep(time.Millisecond * 20)
+	}
+
+	if err != nil {
+		t.Fatalf("Client.Run() error = %s", err)
+	}
+
+	if result.Error != nil || result.Message != "ok" {
+		t.Fatalf("Client.Run() = %#v", result)
+	}
+
+	// clients not trusting the CA must fail
+	untrusted, _ := New(Config{URL: "https://" + address, Timeout: time.Second * 5})
+	if _, err = untrusted.Run(config); err == nil {
+		t.Fatalf("expected error for client without the server CA")
+	}
+
+	// managers without a client certificate must be rejected by the executor
+	noCertTLS, _ := tlsutil.ClientConfig(certs.ca, "", "")
+	s, _ = memory.New("")
+	rs, _ = memory.NewResultStore("")
+	noCert := manager.New(manager.Config{
+		ExecutorURLs: []string{executor.URL}, ExecutorTLS: noCertTLS, HealthCheckInterval: time.Hour}, s, rs)
+	defer noCert.Close()
+
+	if result = noCert.Run(config); !result.Failed || result.Error == nil {
+		t.Fatalf("expected run without client certificate to fail, got %#v", result)
+	}
+}
+
+func TestTLSClientCertificates(t *testing.T) {
+	dir, err := ioutil.TempDir("", "replicant-tls")
+	if err != nil {
+		t.Fatalf("error creating temp dir: %s", err)
+	}
+	defer os.RemoveAll(dir)
+
+	certs := newTestCerts(t, dir)
+
+	s, _ := memory.New("")
+	rs, _ := memory.NewResultStore("")
+	m := manager.New(manager.Config{HealthCheckInterval: time.Hour}, s, rs)
+
+	// server requiring client certificates from api clients
+	address := freeAddress(t)
+	srv, err := server.New(server.Config{ListenAddress: address, TLSCertFile: certs.serverCert,
+		TLSKeyFile: certs.serverKey, TLSClientCAFile: certs.ca}, m, httprouter.New())
+	if err != nil {
+		t.Fatalf("error creating server: %s", err)
+	}
+	api.AddAllRoutes(srv)
+	go srv.Start()
+	defer srv.Close(context.Background())
+
+	c, err := New(Config{URL: "https://" + address, CACertFile: certs.ca,
+		CertFile: certs.clientCert, KeyFile: certs.clientKey, Timeout: time.Second * 5})
+	if err != nil {
+		t.Fatalf("error creating client: %s", err)
+	}
+
+	for x := 0; x < 50; x++ {
+		if _, err = c.GetTransactions(); err == nil {
+			break
+		}
+		time.Sleep(time.Millisecond * 20)
+	}
+
+	if err != nil {
+		t.Fatalf("Client.GetTransactions() error = %s", err)
+	}
+
+	// clients without a client certificate must be rejected
+	noCert, _ := New(Config{URL: "https://" + address, CACertFile: certs.ca, Timeout: time.Second * 5})
+	if _, err = noCert.GetTransactions(); err == nil {
+		t.Fatalf("expected error for client without a client certificate")
+	}
+}
@@ -0,0 +1,69 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Unbabel/replicant/api"
+	"github.com/Unbabel/replicant/manager"
+	"github.com/Unbabel/replicant/server"
+	"github.com/Unbabel/replicant/store/memory"
+	"github.com/Unbabel/replicant/transaction"
+	"github.com/julienschmidt/httprouter"
+)
+
+func TestWatch(t *testing.T) {
+	s, _ := memory.New("")
+	rs, _ := memory.NewResultStore("")
+	m := manager.New(manager.Config{HealthCheckInterval: time.Hour}, s, rs)
+
+	address := freeAddress(t)
+	srv, err := server.New(server.Config{ListenAddress: address}, m, httprouter.New())
+	if err != nil {
+		t.Fatalf("error creating server: %s", err)
+	}
+	api.AddAllRoutes(srv)
+	go srv.Start()
+	defer srv.Close(context.Background())
+
+	c, err := New(Config{URL: "http://" + address, Timeout: time.Second * 5})
+	if err != nil {
+		t.Fatalf("error creating client: %s", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	// emit until the watcher is done, as it may subscribe after the first results
+	go func() {
+		for ctx.Err() == nil {
+			m.Stream().Emit(transaction.Result{Name: "other", Metadata: map[string]string{"team": "x"}})
+			m.Stream().Emit(transaction.Result{Name: "test", Metadata: map[string]string{"team": "y"}})
+			m.Stream().Emit(transaction.Result{Name: "test", Metadata: map[string]string{"team": "x"}})
+			time.Sleep(time.Millisecond * 20)
+		}
+	}()
+
+	stop := errors.New("stop")
+	var got []transaction.Result
+	err = c.Watch(ctx, "test", map[string]string{"team": "x"}, func(r transaction.Result) error {
+		got = append(got, r)
+		if len(got) == 3 {
+			return stop
+		}
+		return nil
+	})
+	cancel()
+
+	if err != stop {
+		t.Fatalf("Client.Watch() error = %v, want %v", err, stop)
+	}
+
+	for _, r := range got {
+		if r.Name != "test" || r.Metadata["team"] != "x" {
+			t.Errorf("Client.Watch() unexpected result %#v", r)
+		}
+	}
+}
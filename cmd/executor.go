@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
@@ -13,6 +14,7 @@ import (
 
 	"github.com/Unbabel/replicant/internal/cmdutil"
 	"github.com/Unbabel/replicant/internal/executor"
+	"github.com/Unbabel/replicant/internal/tlsutil"
 	"github.com/Unbabel/replicant/log"
 	"github.com/Unbabel/replicant/transaction"
 	"github.com/julienschmidt/httprouter"
@@ -24,13 +26,31 @@ func init() {
 	Executor.Flags().String("server-url", "http://127.0.0.1:8080", "Replicant server url")
 	Executor.Flags().Duration("max-runtime", time.Minute*5, "Maximum individual test runtime")
 	Executor.Flags().Bool("debug", false, "Expose a debug profile endpoint at /debug/pprof")
+	Executor.Flags().String("advertise-url", "", "URL for reaching this executor, sent to the server on registration. Defaults to the hostname and listen port")
+	Executor.Flags().Bool("register", true, "Register this executor with the replicant server")
+	Executor.Flags().Duration("register-interval", time.Second*10, "Interval for sending registration heartbeats to the replicant server")
+	Executor.Flags().String("executor-secret", "", "Secret shared with the replicant server for authenticating requests")
+	Executor.Flags().String("tls-cert-file", "", "TLS certificate file for serving https")
+	Executor.Flags().String("tls-key-file", "", "TLS key file for serving https")
+	Executor.Flags().String("tls-client-ca-file", "", "CA file for verifying client certificates. Enables mutual TLS with the replicant server")
+	Executor.Flags().String("server-ca-file", "", "CA file for verifying the replicant server certificate")
+	Executor.Flags().String("server-tls-cert-file", "", "TLS client certificate file for replicant servers requiring mutual TLS")
+	Executor.Flags().String("server-tls-key-file", "", "TLS client key file for replicant servers requiring mutual TLS")
 	Executor.Flags().String("webhook-advertise-url", "http://localhost:8080", "URL to advertise when receiving webhook based async responses")
-	Executor.Flags().String("chrome-remote-url", "http://127.0.0.1:9222", "Chrome remote debugging protocol server. For using remote chrome process instead of a local managed process")
+	Executor.Flags().StringSlice("chrome-remote-url", nil, "Chrome remote debugging protocol server. For using remote chrome processes instead of local managed processes, can be repeated or comma separated. Managed processes listen on consecutive ports from the first url, defaulting to "+defaultChromeURL+". Web transactions are only supported when chrome is enabled or configured")
 	Executor.Flags().Bool("chrome-enable-local", false, "Enable running a local chrome worker process for web transactions")
 	Executor.Flags().String("chrome-local-command", "/headless-shell/headless-shell --headless --no-zygote --no-sandbox --disable-gpu --disable-software-rasterizer --disable-dev-shm-usage --remote-debugging-address=127.0.0.1 --remote-debugging-port=9222 --incognito --disable-shared-workers --disable-remote-fonts --disable-background-networking --disable-crash-reporter --disable-default-apps --disable-domain-reliability --disable-extensions --disable-shared-workers --disable-setuid-sandbox", "Command for launching chrome with arguments included")
 	Executor.Flags().Duration("chrome-recycle-interval", time.Minute*5, "Chrome recycle interval for locally managed chrome process")
+	Executor.Flags().Int("chrome-instances", 1, "Number of locally managed chrome processes")
+	Executor.Flags().Int("chrome-max-runs", 0, "Number of transactions after which a locally managed chrome process is recycled, 0 for no limit")
+	Executor.Flags().Int("chrome-concurrency", 0, "Number of transactions running concurrently on each chrome process, 0 for no limit")
+	Executor.Flags().String("chrome-artifacts", "never", "Capture a screenshot, DOM, console messages and HAR for web transactions: never, failure or always. Artifacts are stored by the server")
+	Executor.Flags().String("js-modules-dir", "", "Directory with shared javascript modules for js2 transactions. Defaults to fetching modules from the replicant server")
 }
 
+// defaultChromeURL for locally managed chrome processes
+const defaultChromeURL = "http://127.0.0.1:9222"
+
 // Executor command
 var Executor = &cobra.Command{
 	Use:   "executor",
@@ -40,18 +60,68 @@ var Executor = &cobra.Command{
 		config := executor.Config{}
 		config.ServerURL = cmdutil.GetFlagString(cmd, "server-url")
 		config.AdvertiseURL = cmdutil.GetFlagString(cmd, "webhook-advertise-url")
+		config.ExecutorURL = cmdutil.GetFlagString(cmd, "advertise-url")
+		config.Secret = cmdutil.GetFlagString(cmd, "executor-secret")
+		config.JSModulesDir = cmdutil.GetFlagString(cmd, "js-modules-dir")
+
+		serverTLS, err := tlsutil.ClientConfig(cmdutil.GetFlagString(cmd, "server-ca-file"),
+			cmdutil.GetFlagString(cmd, "server-tls-cert-file"), cmdutil.GetFlagString(cmd, "server-tls-key-file"))
+		if err != nil {
+			die("Error creating server tls config: %s", err)
+		}
+		config.ServerTLS = serverTLS
+
+		scheme := "http://"
+		certFile := cmdutil.GetFlagString(cmd, "tls-cert-file")
+		if certFile != "" {
+			scheme = "https://"
+		}
+
+		if config.ExecutorURL == "" {
+			_, port, err := net.SplitHostPort(cmdutil.GetFlagString(cmd, "listen-address"))
+			if err != nil {
+				die("Error parsing listen address: %s", err)
+			}
 
-		// Setup chrome support for web applications
-		config.Web.ServerURL = cmdutil.GetFlagString(cmd, "chrome-remote-url")
+			hostname, err := os.Hostname()
+			if err != nil {
+				die("Error getting hostname: %s", err)
+			}
+
+			config.ExecutorURL = scheme + net.JoinHostPort(hostname, port)
+		}
+
+		// Setup chrome support for web applications, the web driver is only
+		// available when chrome is enabled or configured
+		urls := cmdutil.GetFlagStringSlice(cmd, "chrome-remote-url")
+		if len(urls) == 0 && cmdutil.GetFlagBool(cmd, "chrome-enable-local") {
+			urls = []string{defaultChromeURL}
+		}
+
+		if len(urls) > 0 {
+			config.Web.ServerURL = urls[0]
+			config.Web.ServerURLs = urls[1:]
+		}
+		config.Web.Artifacts = cmdutil.GetFlagString(cmd, "chrome-artifacts")
+		config.Web.Concurrency = cmdutil.GetFlagInt(cmd, "chrome-concurrency")
 
 		if cmdutil.GetFlagBool(cmd, "chrome-enable-local") {
 			arguments := strings.Split(cmdutil.GetFlagString(cmd, "chrome-local-command"), " ")
 			config.Web.BinaryPath = arguments[:1][0]
 			config.Web.BinaryArgs = arguments[1:]
 			config.Web.RecycleInterval = cmdutil.GetFlagDuration(cmd, "chrome-recycle-interval")
+			config.Web.Instances = cmdutil.GetFlagInt(cmd, "chrome-instances")
+			config.Web.MaxRuns = cmdutil.GetFlagInt(cmd, "chrome-max-runs")
 		}
 
 		server := &http.Server{}
+		if certFile != "" {
+			server.TLSConfig, err = tlsutil.ServerConfig(certFile,
+				cmdutil.GetFlagString(cmd, "tls-key-file"), cmdutil.GetFlagString(cmd, "tls-client-ca-file"))
+			if err != nil {
+				die("Error creating executor tls config: %s", err)
+			}
+		}
 		server.Addr = cmdutil.GetFlagString(cmd, "listen-address")
 		server.ReadTimeout = cmdutil.GetFlagDuration(cmd, "max-runtime")
 		server.WriteTimeout = cmdutil.GetFlagDuration(cmd, "max-runtime")
@@ -64,6 +134,18 @@ var Executor = &cobra.Command{
 			die("Error creating executor: %s", err)
 		}
 
+		router.Handle(http.MethodGet, "/api/v1/health", func(w http.ResponseWriter, r *http.Request, p httprouter.Params) {
+			h := e.Health()
+			buf, _ := json.Marshal(h)
+			w.Header().Set("Content-Type", "application/json; charset=utf-8")
+			if h.Status != executor.StatusOK {
+				w.WriteHeader(http.StatusServiceUnavailable)
+			} else {
+				w.WriteHeader(http.StatusOK)
+			}
+			w.Write(buf)
+		})
+
 		router.Handle(http.MethodPost, "/api/v1/run/:uuid", func(w http.ResponseWriter, r *http.Request, p httprouter.Params) {
 			defer r.Body.Close()
 
@@ -72,6 +154,11 @@ var Executor = &cobra.Command{
 			var buf []byte
 			var config transaction.Config
 
+			if !e.Authorized(r) {
+				httpError(w, uuid, config, fmt.Errorf("unauthorized"), http.StatusUnauthorized)
+				return
+			}
+
 			if buf, err = ioutil.ReadAll(r.Body); err != nil {
 				httpError(w, uuid, config, fmt.Errorf("error reading request body: %w", err), http.StatusBadRequest)
 				return
@@ -103,19 +190,33 @@ var Executor = &cobra.Command{
 			w.Write(buf)
 		})
 
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		if cmdutil.GetFlagBool(cmd, "register") {
+			go e.Register(ctx, cmdutil.GetFlagDuration(cmd, "register-interval"))
+		}
+
 		signalCh := make(chan os.Signal, 1)
 		signal.Notify(signalCh, os.Interrupt)
 
 		// listen for stop signals
 		go func() {
 			<-signalCh
+			cancel()
 			if err := server.Shutdown(context.Background()); err != nil {
 				die("Error creating stopping executor: %s", err)
 			}
 		}()
 
 		fmt.Println("Starting executor")
-		if err := server.ListenAndServe(); err != nil {
+		if server.TLSConfig != nil {
+			err = server.ListenAndServeTLS("", "")
+		} else {
+			err = server.ListenAndServe()
+		}
+
+		if err != nil {
 			die("Error starting local executor: %s", err)
 		}
 
@@ -0,0 +1,125 @@
+package cmd
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/Unbabel/replicant/internal/cmdutil"
+	"github.com/Unbabel/replicant/secret/keyring"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	Keyring.PersistentFlags().StringP("file", "f", "replicant.keyring", "Path to the keyring file")
+	Keyring.PersistentFlags().String("passphrase-env", keyring.DefaultPassphraseEnv, "Environment variable holding the keyring passphrase")
+	Keyring.AddCommand(KeyringSet)
+	Keyring.AddCommand(KeyringGet)
+	Keyring.AddCommand(KeyringDelete)
+	Keyring.AddCommand(KeyringList)
+}
+
+// Keyring command
+var Keyring = &cobra.Command{
+	Use:   "keyring",
+	Short: "Manage secrets in a local encrypted keyring file",
+	Run: func(cmd *cobra.Command, args []string) {
+		cmd.Usage()
+	},
+}
+
+// KeyringSet command
+var KeyringSet = &cobra.Command{
+	Use:   "set <key> [value]",
+	Short: "Set a secret in the keyring. The value is read from stdin if not specified",
+	Args:  cobra.RangeArgs(1, 2),
+	Run: func(cmd *cobra.Command, args []string) {
+		k := openKeyring(cmd, true)
+
+		var value string
+		switch len(args) {
+		case 2:
+			value = args[1]
+		default:
+			line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+			if err != nil && line == "" {
+				die("Error reading secret value: %s", err)
+			}
+			value = strings.TrimRight(line, "\r\n")
+		}
+
+		if err := k.Set(args[0], value); err != nil {
+			die(err.Error())
+		}
+
+		if err := k.Save(); err != nil {
+			die("Error saving keyring: %s", err)
+		}
+	},
+}
+
+// KeyringGet command
+var KeyringGet = &cobra.Command{
+	Use:   "get <key>",
+	Short: "Print a secret from the keyring",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		value, err := openKeyring(cmd, false).Get(args[0])
+		if err != nil {
+			die(err.Error())
+		}
+		fmt.Println(value)
+	},
+}
+
+// KeyringDelete command
+var KeyringDelete = &cobra.Command{
+	Use:   "delete <key>",
+	Short: "Delete a secret from the keyring",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		k := openKeyring(cmd, false)
+		if err := k.Delete(args[0]); err != nil {
+			die(err.Error())
+		}
+
+		if err := k.Save(); err != nil {
+			die("Error saving keyring: %s", err)
+		}
+	},
+}
+
+// KeyringList command
+var KeyringList = &cobra.Command{
+	Use:   "list",
+	Short: "List the secret keys in the keyring",
+	Run: func(cmd *cobra.Command, args []string) {
+		for _, key := range openKeyring(cmd, false).Keys() {
+			fmt.Println(key)
+		}
+	},
+}
+
+// openKeyring opens the keyring from the command flags, optionally creating it
+func openKeyring(cmd *cobra.Command, create bool) (k *keyring.Keyring) {
+	env := cmdutil.GetFlagString(cmd, "passphrase-env")
+	passphrase := os.Getenv(env)
+	if passphrase == "" {
+		die("No keyring passphrase found in %s", env)
+	}
+
+	path := cmdutil.GetFlagString(cmd, "file")
+	k, err := keyring.Open(path, passphrase)
+	switch {
+	case err == nil:
+		return k
+	case create && errors.Is(err, os.ErrNotExist):
+		return keyring.Create(path, passphrase)
+	default:
+		die("Error opening keyring: %s", err)
+	}
+
+	return nil
+}
@@ -15,6 +15,7 @@ func init() {
 	Root.AddCommand(Server)
 	Root.AddCommand(Executor)
 	Root.AddCommand(Txn)
+	Root.AddCommand(Keyring)
 }
 
 // Root command for replicant
@@ -10,6 +10,8 @@ import (
 	"github.com/Unbabel/replicant/internal/cmdutil"
 	"github.com/Unbabel/replicant/internal/executor"
 	"github.com/Unbabel/replicant/internal/tmpl"
+	"github.com/Unbabel/replicant/secret"
+	"github.com/Unbabel/replicant/store"
 	"github.com/Unbabel/replicant/transaction"
 	"github.com/segmentio/ksuid"
 	"github.com/spf13/cobra"
@@ -18,6 +20,10 @@ import (
 
 func init() {
 	RunLocal.Flags().String("chrome-remote-url", "http://127.0.0.1:9222", "Chrome remote debugging protocol server")
+	RunLocal.Flags().StringSlice("secret-provider", []string{"env:"}, "Secret providers for transaction templates, queried in order")
+	RunLocal.Flags().String("js-modules-dir", "", "Directory with shared javascript modules for js2 transactions")
+	RunLocal.Flags().String("chrome-artifacts", "never", "Capture a screenshot, DOM, console messages and HAR for web transactions: never, failure or always")
+	RunLocal.Flags().String("artifact-store-uri", "", "Result artifact store uri, currently supported: fs:/<path>, leveldb:/<path>, s3://<user>:<password>@<bucket>/path?region=<region>")
 }
 
 // RunLocal command
@@ -46,13 +52,22 @@ var RunLocal = &cobra.Command{
 			die("Callbacks still not supported in local runs")
 		}
 
-		tx, err = tmpl.Parse(tx)
+		secrets, err := secret.NewChain(cmdutil.GetFlagStringSlice(cmd, "secret-provider")...)
 		if err != nil {
-			die("Error parsing transaction: %s", err)
+			die("Error creating secret providers: %s", err)
+		}
+
+		var values []string
+		if tmpl.Templated(tx) {
+			if tx, values, err = tmpl.ParseWithSecrets(tx, secrets); err != nil {
+				die("Error parsing transaction: %s", err)
+			}
 		}
 
 		config := executor.Config{}
 		config.Web.ServerURL = cmdutil.GetFlagString(cmd, "chrome-remote-url")
+		config.Web.Artifacts = cmdutil.GetFlagString(cmd, "chrome-artifacts")
+		config.JSModulesDir = cmdutil.GetFlagString(cmd, "js-modules-dir")
 		e, err := executor.New(config)
 		if err != nil {
 			die("Error creating local executor: %s", err)
@@ -60,7 +75,28 @@ var RunLocal = &cobra.Command{
 
 		result, err := e.Run(ksuid.New().String(), tx)
 		if err != nil {
-			die("Error running transaction: %s", err)
+			die("Error running transaction: %s", secret.Redact(err.Error(), values))
+		}
+
+		result.Message = secret.Redact(result.Message, values)
+		result.Data = secret.Redact(result.Data, values)
+
+		// store artifacts and report their references, or only their metadata
+		var as store.ArtifactStore
+		if uri := cmdutil.GetFlagString(cmd, "artifact-store-uri"); uri != "" {
+			if as, err = store.NewArtifactStore(uri); err != nil {
+				die("Error creating artifact store: %s", err)
+			}
+			defer as.Close()
+		}
+
+		for x := range result.Artifacts {
+			if as != nil {
+				if result.Artifacts[x].Ref, err = as.Put(result.UUID, result.Artifacts[x]); err != nil {
+					die("Error storing artifact: %s", err)
+				}
+			}
+			result.Artifacts[x].Data = nil
 		}
 
 		switch cmdutil.GetFlagString(cmd, "output") {
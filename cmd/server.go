@@ -10,32 +10,62 @@ import (
 	"github.com/Unbabel/replicant/emitter/prometheus"
 	"github.com/Unbabel/replicant/emitter/stdout"
 	"github.com/Unbabel/replicant/internal/cmdutil"
+	"github.com/Unbabel/replicant/internal/executor"
+	"github.com/Unbabel/replicant/internal/tlsutil"
 	"github.com/Unbabel/replicant/internal/webhook"
 	"github.com/Unbabel/replicant/log"
 	"github.com/Unbabel/replicant/manager"
+	"github.com/Unbabel/replicant/notifier"
+	"github.com/Unbabel/replicant/secret"
 	"github.com/Unbabel/replicant/server"
 	"github.com/Unbabel/replicant/store"
 	"github.com/Unbabel/replicant/transaction/callback"
+	"github.com/Unbabel/replicant/transaction/validate"
 	"github.com/julienschmidt/httprouter"
 	"github.com/spf13/cobra"
 
 	// Load store and callback drivers
+	_ "github.com/Unbabel/replicant/store/fs"
 	_ "github.com/Unbabel/replicant/store/leveldb"
 	_ "github.com/Unbabel/replicant/store/memory"
 	_ "github.com/Unbabel/replicant/store/s3"
+
+	// Load secret providers
+	_ "github.com/Unbabel/replicant/secret/env"
+	_ "github.com/Unbabel/replicant/secret/file"
+	_ "github.com/Unbabel/replicant/secret/keyring"
 )
 
 func init() {
 	Server.Flags().String("listen-address", "0.0.0.0:8080", "Address to for server to listen on")
 	Server.Flags().Duration("max-runtime", time.Minute*5, "Maximum individual test runtime")
 	Server.Flags().String("store-uri", "memory:-", "store uri, currently supported: memory:-, leveldb:/<path>, s3://<user>:<password>@<bucket>/path?region=<region>")
-	Server.Flags().String("executor-url", "http://localhost:8081", "Replicant executor url")
+	Server.Flags().String("result-store-uri", "memory:-", "result store uri, currently supported: memory:-, memory:<max results per transaction>, leveldb:/<path>")
+	Server.Flags().Duration("result-retention", 0, "Time after which stored results are removed. Results are kept indefinitely if not set, or up to the memory store limit")
+	Server.Flags().String("artifact-store-uri", "", "result artifact store uri, currently supported: fs:/<path>, leveldb:/<path>, s3://<user>:<password>@<bucket>/path?region=<region>. Artifacts are discarded if not set")
+	Server.Flags().Int("artifact-max-size", manager.DefaultArtifactMaxSize, "Maximum size in bytes of result artifacts, larger artifacts are discarded")
+	Server.Flags().Duration("artifact-retention", 0, "Time after which stored result artifacts are removed. Artifacts are kept indefinitely if not set")
+	Server.Flags().StringSlice("executor-url", []string{"http://localhost:8081"}, "Static replicant executor urls, can be repeated or comma separated. Executors can also register themselves, an empty value disables static executors")
+	Server.Flags().Duration("executor-health-check-interval", manager.DefaultHealthCheckInterval, "Interval between executor health checks")
+	Server.Flags().Duration("executor-ttl", manager.DefaultExecutorTTL, "Time after which registered executors without heartbeats are removed")
+	Server.Flags().String("executor-secret", "", "Secret shared with executors for authenticating requests")
+	Server.Flags().String("htpasswd-file", "", "File with basic auth users in the user:hash[:role] format. Supported hashes are bcrypt and {SHA}, roles are reader (default) or operator")
+	Server.Flags().String("token-file", "", "File with bearer tokens in the name:token:role format. Roles are reader, operator or executor")
+	Server.Flags().String("tls-cert-file", "", "TLS certificate file for serving https")
+	Server.Flags().String("tls-key-file", "", "TLS key file for serving https")
+	Server.Flags().String("tls-client-ca-file", "", "CA file for verifying api client certificates. Enables mutual TLS for api clients, including executors")
+	Server.Flags().String("executor-ca-file", "", "CA file for verifying executor certificates")
+	Server.Flags().String("executor-tls-cert-file", "", "TLS client certificate file for mutual TLS with executors")
+	Server.Flags().String("executor-tls-key-file", "", "TLS client key file for mutual TLS with executors")
+	Server.Flags().StringSlice("secret-provider", []string{"env:"}, "Secret providers for transaction templates, queried in order. Currently supported: env:[prefix], file:/<directory>, keyring:/<path>[?passphrase-env=<variable>]")
+	Server.Flags().String("notifier-config", "", "Notifier config file for sending notifications on transaction state changes")
 	Server.Flags().Bool("emit-stdout", true, "Emit json structured results to standard output")
 	Server.Flags().Bool("emit-stdout-pretty", false, "Pretty print stdout json output")
 	Server.Flags().Bool("emit-prometheus", true, "Expose a prometheus exporter for emitting result data at /metrics")
 	Server.Flags().String("webhook-advertise-url", "http://localhost:8080", "URL to advertise when receiving webhook based async responses")
 	Server.Flags().String("webhook-path-prefix", "/callback", "Path prefix to receive callbacks on, eg: /<path-prefix>/<transaction-uuid>")
 	Server.Flags().Bool("debug", false, "Expose a debug profile endpoint at /debug/pprof")
+	Server.Flags().String("js-modules-dir", "", "Directory with shared javascript modules served to executors for js2 transactions")
 
 }
 
@@ -55,9 +85,64 @@ var Server = &cobra.Command{
 			os.Exit(1)
 		}
 
+		// Setup result store
+		resultStoreURI := cmdutil.GetFlagString(cmd, "result-store-uri")
+		rs, err := store.NewResultStore(resultStoreURI)
+		if err != nil {
+			log.Error("could not initialize result store").String("error", err.Error()).Log()
+			os.Exit(1)
+		}
+
+		// Setup artifact store
+		var as store.ArtifactStore
+		if artifactStoreURI := cmdutil.GetFlagString(cmd, "artifact-store-uri"); artifactStoreURI != "" {
+			as, err = store.NewArtifactStore(artifactStoreURI)
+			if err != nil {
+				log.Error("could not initialize artifact store").String("error", err.Error()).Log()
+				os.Exit(1)
+			}
+		}
+
 		// Setup manager
-		executorURL := cmdutil.GetFlagString(cmd, "executor-url")
-		m := manager.New(st, executorURL)
+		executorTLS, err := tlsutil.ClientConfig(
+			cmdutil.GetFlagString(cmd, "executor-ca-file"),
+			cmdutil.GetFlagString(cmd, "executor-tls-cert-file"),
+			cmdutil.GetFlagString(cmd, "executor-tls-key-file"))
+		if err != nil {
+			log.Error("could not initialize executor tls config").String("error", err.Error()).Log()
+			os.Exit(1)
+		}
+
+		secrets, err := secret.NewChain(cmdutil.GetFlagStringSlice(cmd, "secret-provider")...)
+		if err != nil {
+			log.Error("could not initialize secret providers").String("error", err.Error()).Log()
+			os.Exit(1)
+		}
+
+		m := manager.New(manager.Config{
+			ExecutorURLs:        cmdutil.GetFlagStringSlice(cmd, "executor-url"),
+			HealthCheckInterval: cmdutil.GetFlagDuration(cmd, "executor-health-check-interval"),
+			ExecutorTTL:         cmdutil.GetFlagDuration(cmd, "executor-ttl"),
+			ExecutorSecret:      cmdutil.GetFlagString(cmd, "executor-secret"),
+			ArtifactMaxSize:     int64(cmdutil.GetFlagInt(cmd, "artifact-max-size")),
+			ArtifactRetention:   cmdutil.GetFlagDuration(cmd, "artifact-retention"),
+			ResultRetention:     cmdutil.GetFlagDuration(cmd, "result-retention"),
+			ExecutorTLS:         executorTLS,
+			Secrets:             secrets},
+			st, rs)
+
+		if as != nil {
+			m.SetArtifactStore(as)
+		}
+
+		// Setup transaction validation with the executor drivers. Transaction scripts
+		// are only checked and never run within the server
+		checkers, err := executor.Checkers()
+		if err != nil {
+			log.Error("could not initialize transaction validation").String("error", err.Error()).Log()
+			os.Exit(1)
+		}
+		m.SetValidator(validate.New(validate.Config{Drivers: checkers}))
 
 		emitStdout := cmdutil.GetFlagBool(cmd, "emit-stdout")
 		if emitStdout {
@@ -74,6 +159,22 @@ var Server = &cobra.Command{
 			m.AddEmitter(e)
 		}
 
+		notifierConfig := cmdutil.GetFlagString(cmd, "notifier-config")
+		if notifierConfig != "" {
+			c, err := notifier.LoadConfig(notifierConfig)
+			if err != nil {
+				log.Error("failed to load notifier config").String("error", err.Error()).Log()
+				os.Exit(1)
+			}
+
+			n, err := notifier.New(c)
+			if err != nil {
+				log.Error("failed to create notifier").String("error", err.Error()).Log()
+				os.Exit(1)
+			}
+			m.AddEmitter(n)
+		}
+
 		// Setup webhook based callbacks
 		webhookURL := cmdutil.GetFlagString(cmd, "webhook-advertise-url")
 		webhookPrefix := cmdutil.GetFlagString(cmd, "webhook-path-prefix")
@@ -93,7 +194,10 @@ var Server = &cobra.Command{
 			ListenAddress:     address,
 			ReadTimeout:       timeout,
 			WriteTimeout:      timeout,
-			ReadHeaderTimeout: timeout},
+			ReadHeaderTimeout: timeout,
+			TLSCertFile:       cmdutil.GetFlagString(cmd, "tls-cert-file"),
+			TLSKeyFile:        cmdutil.GetFlagString(cmd, "tls-key-file"),
+			TLSClientCAFile:   cmdutil.GetFlagString(cmd, "tls-client-ca-file")},
 			m, router)
 
 		if err != nil {
@@ -101,6 +205,34 @@ var Server = &cobra.Command{
 			os.Exit(1)
 		}
 
+		// Setup authentication if any credentials are configured
+		authConfig := server.AuthConfig{
+			HtpasswdFile:   cmdutil.GetFlagString(cmd, "htpasswd-file"),
+			TokenFile:      cmdutil.GetFlagString(cmd, "token-file"),
+			ExecutorSecret: cmdutil.GetFlagString(cmd, "executor-secret")}
+
+		if authConfig.HtpasswdFile != "" || authConfig.TokenFile != "" || authConfig.ExecutorSecret != "" {
+			auth, err := server.NewAuth(authConfig)
+			if err != nil {
+				log.Error("failed to setup authentication").String("error", err.Error()).Log()
+				os.Exit(1)
+			}
+
+			// the executor secret alone only authenticates executor requests
+			if authConfig.HtpasswdFile == "" && authConfig.TokenFile == "" {
+				log.Info("api clients are not authenticated, only executor requests are").Log()
+				srv.SetExecutorAuthenticator(auth)
+			} else {
+				srv.SetAuthenticator(auth)
+			}
+		}
+
+		// Shared javascript modules
+		if dir := cmdutil.GetFlagString(cmd, "js-modules-dir"); dir != "" {
+			log.Info("serving shared javascript modules").String("directory", dir).Log()
+			api.AddModuleRoutes(srv, dir)
+		}
+
 		// Debugging endpoints
 		if cmdutil.GetFlagBool(cmd, "debug") {
 			log.Info("adding debug api routes for runtime profiling data").Log()
@@ -11,14 +11,21 @@ func init() {
 	Txn.PersistentFlags().String("name", "", "Name of managed server transaction")
 	Txn.PersistentFlags().StringP("username", "u", "", "Replicant server username")
 	Txn.PersistentFlags().StringP("password", "p", "", "Replicant server password")
+	Txn.PersistentFlags().String("token", "", "Replicant server bearer token")
 	Txn.PersistentFlags().StringP("file", "f", "", "Path to transaction definition file")
 	Txn.PersistentFlags().Bool("insecure", false, "Skip server certificate verification")
+	Txn.PersistentFlags().String("ca-cert-file", "", "CA file for verifying the server certificate")
+	Txn.PersistentFlags().String("cert-file", "", "TLS client certificate file for servers requiring mutual TLS")
+	Txn.PersistentFlags().String("key-file", "", "TLS client key file for servers requiring mutual TLS")
 	Txn.PersistentFlags().StringP("output", "o", "", "Detailed output format yaml or json")
 	Txn.PersistentFlags().DurationP("timeout", "t", 5*time.Minute, "Replicant server timeout for running transactions")
 	Txn.AddCommand(Add)
+	Txn.AddCommand(Apply)
 	Txn.AddCommand(Get)
 	Txn.AddCommand(Run)
 	Txn.AddCommand(Delete)
+	Txn.AddCommand(Validate)
+	Txn.AddCommand(Watch)
 	Txn.AddCommand(RunLocal)
 }
 
@@ -0,0 +1,76 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/Unbabel/replicant/client"
+	"github.com/Unbabel/replicant/internal/cmdutil"
+	"github.com/Unbabel/replicant/transaction"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+)
+
+// Apply command
+var Apply = &cobra.Command{
+	Use:   "apply",
+	Short: "Create or update a transaction on a replicant server",
+	Run: func(cmd *cobra.Command, args []string) {
+		var err error
+		var tx transaction.Config
+
+		file := cmdutil.GetFlagString(cmd, "file")
+		if file == "" {
+			die("Transaction file must be specified")
+		}
+
+		buf, err := ioutil.ReadFile(file)
+		if err != nil {
+			die("Error reading transaction: %s", err)
+		}
+
+		if err = yaml.Unmarshal(buf, &tx); err != nil {
+			die("Error reading transaction: %s", err)
+		}
+
+		if tx.Name == "" {
+			die("Transaction name must be specified in the transaction file")
+		}
+
+		c, err := client.New(client.Config{
+			URL:                cmdutil.GetFlagString(cmd, "server-url"),
+			Username:           cmdutil.GetFlagString(cmd, "username"),
+			Password:           cmdutil.GetFlagString(cmd, "password"),
+			Token:              cmdutil.GetFlagString(cmd, "token"),
+			Timeout:            cmdutil.GetFlagDuration(cmd, "timeout"),
+			InsecureSkipVerify: cmdutil.GetFlagBool(cmd, "insecure"),
+			CACertFile:         cmdutil.GetFlagString(cmd, "ca-cert-file"),
+			CertFile:           cmdutil.GetFlagString(cmd, "cert-file"),
+			KeyFile:            cmdutil.GetFlagString(cmd, "key-file"),
+		})
+
+		if err != nil {
+			die("Error creating client: %s", err)
+		}
+
+		// update the transaction if already managed by the server, create otherwise
+		_, err = c.GetTransaction(tx.Name)
+		if err != nil && !errors.Is(err, client.ErrTransactionNotFound) {
+			die(err.Error())
+		}
+
+		if err != nil {
+			if err = c.Add(tx); err != nil {
+				die(err.Error())
+			}
+			fmt.Printf("transaction %s created\n", tx.Name)
+			return
+		}
+
+		if err = c.UpdateTransaction(tx); err != nil {
+			die(err.Error())
+		}
+		fmt.Printf("transaction %s updated\n", tx.Name)
+	},
+}
@@ -22,8 +22,12 @@ var Delete = &cobra.Command{
 			URL:                cmdutil.GetFlagString(cmd, "server-url"),
 			Username:           cmdutil.GetFlagString(cmd, "username"),
 			Password:           cmdutil.GetFlagString(cmd, "password"),
+			Token:              cmdutil.GetFlagString(cmd, "token"),
 			Timeout:            cmdutil.GetFlagDuration(cmd, "timeout"),
 			InsecureSkipVerify: cmdutil.GetFlagBool(cmd, "insecure"),
+			CACertFile:         cmdutil.GetFlagString(cmd, "ca-cert-file"),
+			CertFile:           cmdutil.GetFlagString(cmd, "cert-file"),
+			KeyFile:            cmdutil.GetFlagString(cmd, "key-file"),
 		})
 
 		if err != nil {
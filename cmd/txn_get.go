@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"text/tabwriter"
+	"time"
 
 	"github.com/Unbabel/replicant/client"
 	"github.com/Unbabel/replicant/internal/cmdutil"
@@ -15,6 +16,9 @@ import (
 
 func init() {
 	Get.Flags().Bool("results", false, "Get transaction results")
+	Get.Flags().String("from", "", "Get the result history from the given time, in RFC3339 format or as a duration relative to now, eg: 12h")
+	Get.Flags().String("to", "", "Get the result history up to the given time, in RFC3339 format or as a duration relative to now, eg: 1h")
+	Get.Flags().Int("limit", 0, "Limit the number of results in the result history")
 }
 
 // Get command
@@ -27,8 +31,12 @@ var Get = &cobra.Command{
 			URL:                cmdutil.GetFlagString(cmd, "server-url"),
 			Username:           cmdutil.GetFlagString(cmd, "username"),
 			Password:           cmdutil.GetFlagString(cmd, "password"),
+			Token:              cmdutil.GetFlagString(cmd, "token"),
 			Timeout:            cmdutil.GetFlagDuration(cmd, "timeout"),
 			InsecureSkipVerify: cmdutil.GetFlagBool(cmd, "insecure"),
+			CACertFile:         cmdutil.GetFlagString(cmd, "ca-cert-file"),
+			CertFile:           cmdutil.GetFlagString(cmd, "cert-file"),
+			KeyFile:            cmdutil.GetFlagString(cmd, "key-file"),
 		})
 
 		if err != nil {
@@ -100,15 +108,39 @@ func getResults(cmd *cobra.Command, c *client.Client) {
 	output := cmdutil.GetFlagString(cmd, "output")
 
 	var rs []transaction.Result
-	switch name != "" {
-	case true:
+	from := cmdutil.GetFlagString(cmd, "from")
+	to := cmdutil.GetFlagString(cmd, "to")
+	limit := cmdutil.GetFlagInt(cmd, "limit")
+
+	switch {
+	case name != "" && (from != "" || to != "" || limit != 0):
+		fromTime, err := parseTime(from)
+		if err != nil {
+			die("Invalid from time: %s", err)
+		}
+
+		toTime, err := parseTime(to)
+		if err != nil {
+			die("Invalid to time: %s", err)
+		}
+
+		rs, err = c.GetResultHistory(name, fromTime, toTime, limit)
+		if err != nil {
+			die(err.Error())
+		}
+
+	case name != "":
 		r, err := c.GetResult(name)
 		if err != nil {
 			die(err.Error())
 		}
 		rs = append(rs, r)
 
-	case false:
+	default:
+		if from != "" || to != "" || limit != 0 {
+			die("Transaction name must be specified for getting the result history")
+		}
+
 		rs, err = c.GetResults()
 		if err != nil {
 			die(err.Error())
@@ -144,3 +176,16 @@ func getResults(cmd *cobra.Command, c *client.Client) {
 		w.Flush()
 	}
 }
+
+// parseTime parses the given value as a RFC3339 time or as a duration relative to now
+func parseTime(value string) (t time.Time, err error) {
+	if value == "" {
+		return t, nil
+	}
+
+	if d, err := time.ParseDuration(value); err == nil {
+		return time.Now().Add(-d), nil
+	}
+
+	return time.Parse(time.RFC3339, value)
+}
@@ -0,0 +1,81 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/Unbabel/replicant/internal/cmdutil"
+	"github.com/Unbabel/replicant/internal/executor"
+	"github.com/Unbabel/replicant/transaction"
+	"github.com/Unbabel/replicant/transaction/validate"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+)
+
+// Validate command
+var Validate = &cobra.Command{
+	Use:   "validate",
+	Short: "Validate a transaction definition locally without storing or running it",
+	Run: func(cmd *cobra.Command, args []string) {
+		var err error
+		var tx transaction.Config
+
+		file := cmdutil.GetFlagString(cmd, "file")
+		if file == "" {
+			die("Transaction file must be specified")
+		}
+
+		buf, err := ioutil.ReadFile(file)
+		if err != nil {
+			die("Error reading transaction: %s", err)
+		}
+
+		if err = yaml.Unmarshal(buf, &tx); err != nil {
+			die("Error reading transaction: %s", err)
+		}
+
+		// Transaction scripts are only checked, so no chrome server is needed
+		checkers, err := executor.Checkers()
+		if err != nil {
+			die("Error creating transaction drivers: %s", err)
+		}
+
+		// Callback listeners are only registered within the replicant server
+		v := validate.New(validate.Config{Drivers: checkers, SkipCallbacks: true})
+
+		err = v.Validate(tx)
+		var errs validate.Errors
+		if err != nil && !errors.As(err, &errs) {
+			die("Error validating transaction: %s", err)
+		}
+
+		switch cmdutil.GetFlagString(cmd, "output") {
+		case "":
+			for _, fe := range errs {
+				fmt.Printf("%s: %s\n", fe.Field, fe.Message)
+			}
+			if len(errs) == 0 {
+				fmt.Printf("transaction %s is valid\n", tx.Name)
+			}
+		case "json":
+			buf, err := json.MarshalIndent(&errs, "", "  ")
+			if err != nil {
+				die(err.Error())
+			}
+			fmt.Printf("%s\n", buf)
+		case "yaml":
+			buf, err := yaml.Marshal(&errs)
+			if err != nil {
+				die(err.Error())
+			}
+			fmt.Printf("%s\n", buf)
+		}
+
+		if len(errs) > 0 {
+			os.Exit(1)
+		}
+	},
+}
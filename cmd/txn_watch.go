@@ -0,0 +1,101 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/Unbabel/replicant/client"
+	"github.com/Unbabel/replicant/internal/cmdutil"
+	"github.com/Unbabel/replicant/transaction"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+)
+
+func init() {
+	Watch.Flags().StringSlice("label", nil, "Watch only results with the given metadata labels, as key:value")
+}
+
+// Watch command
+var Watch = &cobra.Command{
+	Use:   "watch",
+	Short: "Watch transaction results as they are produced",
+	Run: func(cmd *cobra.Command, args []string) {
+
+		c, err := client.New(client.Config{
+			URL:                cmdutil.GetFlagString(cmd, "server-url"),
+			Username:           cmdutil.GetFlagString(cmd, "username"),
+			Password:           cmdutil.GetFlagString(cmd, "password"),
+			Token:              cmdutil.GetFlagString(cmd, "token"),
+			Timeout:            cmdutil.GetFlagDuration(cmd, "timeout"),
+			InsecureSkipVerify: cmdutil.GetFlagBool(cmd, "insecure"),
+			CACertFile:         cmdutil.GetFlagString(cmd, "ca-cert-file"),
+			CertFile:           cmdutil.GetFlagString(cmd, "cert-file"),
+			KeyFile:            cmdutil.GetFlagString(cmd, "key-file"),
+		})
+
+		if err != nil {
+			die("Error creating client: %s", err)
+		}
+
+		labels := map[string]string{}
+		for _, label := range cmdutil.GetFlagStringSlice(cmd, "label") {
+			kv := strings.SplitN(label, ":", 2)
+			if len(kv) != 2 {
+				die("Invalid label: %s", label)
+			}
+			labels[kv[0]] = kv[1]
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		signalCh := make(chan os.Signal, 1)
+		signal.Notify(signalCh, syscall.SIGINT, syscall.SIGTERM)
+		go func() {
+			<-signalCh
+			cancel()
+		}()
+
+		output := cmdutil.GetFlagString(cmd, "output")
+		if output == "" {
+			fmt.Printf("%-32s %-10s %-7s %-9s %-8s %s\n", "NAME", "DRIVER", "FAILED", "DURATION", "RETRIES", "TIME")
+		}
+
+		err = c.Watch(ctx, cmdutil.GetFlagString(cmd, "name"), labels, func(r transaction.Result) error {
+			return printWatchResult(output, r)
+		})
+
+		if err != nil && err != context.Canceled {
+			die(err.Error())
+		}
+	},
+}
+
+// printWatchResult prints a result as a table row, or as a json line or yaml document
+func printWatchResult(output string, r transaction.Result) (err error) {
+	switch output {
+	case "json":
+		buf, err := json.Marshal(&r)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("%s\n", buf)
+
+	case "yaml":
+		buf, err := yaml.Marshal(&r)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("---\n%s", buf)
+
+	default:
+		t, _ := r.Time.MarshalText()
+		fmt.Printf("%-32s %-10s %-7t %-9.2f %-8d %s\n",
+			r.Name, r.Driver, r.Failed, r.DurationSeconds, r.RetryCount, t)
+	}
+
+	return nil
+}
@@ -0,0 +1,117 @@
+// Package declarative implements the driver and transaction handling shared by
+// drivers whose transaction scripts are yaml or json documents, eg: http, grpc, tls, dns and tcp.
+package declarative
+
+/*
+   Copyright 2019 Bruno Moura <brunotm@gmail.com>
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Unbabel/replicant/transaction"
+	"gopkg.in/yaml.v2"
+)
+
+// NewFunc creates a transaction from its config
+type NewFunc func(config transaction.Config) (tx transaction.Transaction, err error)
+
+// Driver for declarative transactions. Creating transactions only parses
+// their config, so checking them doesn't require network or file access.
+type Driver struct {
+	name string
+	new  NewFunc
+}
+
+// NewDriver creates a declarative driver with the given type and transaction constructor
+func NewDriver(name string, new NewFunc) (d *Driver) {
+	return &Driver{name: name, new: new}
+}
+
+// Type returns this driver type
+func (d *Driver) Type() (t string) {
+	return d.name
+}
+
+// New creates a transaction from the given config
+func (d *Driver) New(config transaction.Config) (tx transaction.Transaction, err error) {
+	return d.new(config)
+}
+
+// Check parses the transaction config
+func (d *Driver) Check(config transaction.Config) (err error) {
+	_, err = d.new(config)
+	return err
+}
+
+// Transaction holds the config and timeout common to declarative transactions
+type Transaction struct {
+	driver  string
+	config  transaction.Config
+	timeout time.Duration
+}
+
+// Parse the transaction timeout and decode the transaction script into the given value.
+// Declarative transactions do not support callbacks.
+func Parse(driver string, config transaction.Config, script interface{}) (t Transaction, err error) {
+	t.driver = driver
+	t.config = config
+
+	if config.Timeout != "" {
+		if t.timeout, err = time.ParseDuration(config.Timeout); err != nil {
+			return t, fmt.Errorf("driver/%s: error parsing timeout: %w", driver, err)
+		}
+	}
+
+	if config.CallBack != nil {
+		return t, fmt.Errorf("driver/%s: callbacks are not supported", driver)
+	}
+
+	if err = yaml.Unmarshal([]byte(config.Script), script); err != nil {
+		return t, fmt.Errorf("driver/%s: error parsing transaction script: %w", driver, err)
+	}
+
+	return t, nil
+}
+
+// Config returns the transaction config
+func (t Transaction) Config() (config transaction.Config) {
+	return t.config
+}
+
+// Result returns a new result for the transaction
+func (t Transaction) Result() (result transaction.Result) {
+	result.Name = t.config.Name
+	result.Driver = t.driver
+	result.Metadata = t.config.Metadata
+	return result
+}
+
+// Context returns a context bound to the transaction timeout, or to the given
+// default timeout when the transaction has none. A zero default timeout leaves
+// the context unbounded.
+func (t Transaction) Context(ctx context.Context, defaultTimeout time.Duration) (c context.Context, cancel context.CancelFunc) {
+	timeout := t.timeout
+	if timeout == 0 {
+		timeout = defaultTimeout
+	}
+
+	if timeout == 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, timeout)
+}
@@ -0,0 +1,96 @@
+package declarative
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Unbabel/replicant/transaction"
+	"github.com/Unbabel/replicant/transaction/callback"
+)
+
+type check struct {
+	Address string `yaml:"address"`
+}
+
+func TestParse(t *testing.T) {
+	config := transaction.Config{
+		Name:     "check",
+		Timeout:  "5s",
+		Metadata: map[string]string{"environment": "production"},
+		Script:   "address: localhost:25",
+	}
+
+	var c check
+	txn, err := Parse("test", config, &c)
+	if err != nil {
+		t.Fatalf("Parse() error = %s", err)
+	}
+
+	if c.Address != "localhost:25" || txn.timeout != 5*time.Second {
+		t.Fatalf("Parse() = %#v, %#v", txn, c)
+	}
+
+	result := txn.Result()
+	if result.Name != "check" || result.Driver != "test" || result.Metadata["environment"] != "production" {
+		t.Fatalf("Result() = %#v", result)
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		config transaction.Config
+	}{
+		{name: "invalid timeout", config: transaction.Config{Timeout: "soon", Script: "address: localhost:25"}},
+		{name: "callback", config: transaction.Config{Script: "address: localhost:25", CallBack: &callback.Config{}}},
+		{name: "invalid script", config: transaction.Config{Script: "address: [localhost"}},
+	}
+
+	d := NewDriver("test", func(config transaction.Config) (tx transaction.Transaction, err error) {
+		_, err = Parse("test", config, &check{})
+		return nil, err
+	})
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := d.Check(tt.config); err == nil {
+				t.Fatalf("Check() expected error")
+			}
+		})
+	}
+}
+
+func TestContext(t *testing.T) {
+	tests := []struct {
+		name           string
+		timeout        time.Duration
+		defaultTimeout time.Duration
+		deadline       bool
+	}{
+		{name: "timeout", timeout: time.Second, defaultTimeout: time.Minute, deadline: true},
+		{name: "default timeout", defaultTimeout: time.Minute, deadline: true},
+		{name: "no timeout"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := Transaction{timeout: tt.timeout}.Context(context.Background(), tt.defaultTimeout)
+			defer cancel()
+
+			deadline, ok := ctx.Deadline()
+			if ok != tt.deadline {
+				t.Fatalf("Context() deadline = %t, want %t", ok, tt.deadline)
+			}
+
+			want := tt.timeout
+			if want == 0 {
+				want = tt.defaultTimeout
+			}
+
+			if ok && time.Until(deadline) > want {
+				t.Fatalf("Context() deadline = %s, want within %s", deadline, want)
+			}
+		})
+	}
+}
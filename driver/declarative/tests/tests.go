@@ -0,0 +1,66 @@
+package tests
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/Unbabel/replicant/driver"
+	"github.com/Unbabel/replicant/transaction"
+)
+
+// Case is a transaction test case for declarative drivers
+type Case struct {
+	Name    string
+	Script  string
+	Failed  bool
+	Error   bool
+	Message string
+}
+
+// Run creates and runs the test case transaction, checking the result error, failure and message.
+// The result is returned for driver specific checks.
+func Run(t *testing.T, d driver.Driver, c Case) (result transaction.Result) {
+	txn, err := d.New(transaction.Config{Name: c.Name, Driver: d.Type(), Timeout: "5s", Script: c.Script})
+	if err != nil {
+		t.Fatalf("error creating transaction: %s", err)
+	}
+
+	result = txn.Run(context.Background())
+	if (result.Error != nil) != c.Error {
+		t.Fatalf("Run() error = %v, want error %t", result.Error, c.Error)
+	}
+
+	if result.Failed != c.Failed {
+		t.Fatalf("Run() failed = %t, want %t: %s: %s", result.Failed, c.Failed, result.Message, result.Data)
+	}
+
+	if !strings.Contains(result.Message, c.Message) {
+		t.Fatalf("Run() message = %s, want %s", result.Message, c.Message)
+	}
+
+	return result
+}
+
+// NewInvalid checks that creating and checking transactions from each of
+// the named scripts fails with a driver error
+func NewInvalid(t *testing.T, d driver.Driver, scripts map[string]string) {
+	prefix := "driver/" + d.Type() + ":"
+
+	for name, script := range scripts {
+		t.Run(name, func(t *testing.T) {
+			config := transaction.Config{Name: name, Driver: d.Type(), Script: script}
+
+			_, err := d.New(config)
+			if err == nil || !strings.HasPrefix(err.Error(), prefix) {
+				t.Fatalf("New() error = %v, want driver error", err)
+			}
+
+			if c, ok := d.(driver.Checker); ok {
+				if err = c.Check(config); err == nil {
+					t.Fatalf("Check() expected error")
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,231 @@
+package dnsd
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/Unbabel/replicant/driver/declarative/tests"
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+// zone of the test server by name and type
+var zone = map[string]map[dnsmessage.Type][]dnsmessage.Resource{
+	"replicant.test.": {
+		dnsmessage.TypeA: {a("replicant.test.", 300, 10, 0, 0, 1), a("replicant.test.", 300, 10, 0, 0, 2)},
+		dnsmessage.TypeMX: {{Header: header("replicant.test.", dnsmessage.TypeMX, 3600),
+			Body: &dnsmessage.MXResource{Pref: 10, MX: dnsmessage.MustNewName("mail.replicant.test.")}}},
+		dnsmessage.TypeTXT: {{Header: header("replicant.test.", dnsmessage.TypeTXT, 60),
+			Body: &dnsmessage.TXTResource{TXT: []string{"v=spf1 ", "-all"}}}},
+	},
+	"www.replicant.test.": {
+		dnsmessage.TypeA: {
+			{Header: header("www.replicant.test.", dnsmessage.TypeCNAME, 300),
+				Body: &dnsmessage.CNAMEResource{CNAME: dnsmessage.MustNewName("replicant.test.")}},
+			a("replicant.test.", 300, 10, 0, 0, 1)},
+	},
+	"_api._tcp.replicant.test.": {
+		dnsmessage.TypeSRV: {{Header: header("_api._tcp.replicant.test.", dnsmessage.TypeSRV, 30),
+			Body: &dnsmessage.SRVResource{Priority: 1, Weight: 5, Port: 8080, Target: dnsmessage.MustNewName("api.replicant.test.")}}},
+	},
+}
+
+// truncated names are only fully answered over tcp
+var truncated = "big.replicant.test."
+
+func init() {
+	zone[truncated] = map[dnsmessage.Type][]dnsmessage.Resource{
+		dnsmessage.TypeAAAA: {{Header: header(truncated, dnsmessage.TypeAAAA, 60),
+			Body: &dnsmessage.AAAAResource{AAAA: [16]byte{0x20, 0x01, 0x0d, 0xb8, 15: 1}}}},
+	}
+}
+
+func header(name string, t dnsmessage.Type, ttl uint32) (h dnsmessage.ResourceHeader) {
+	return dnsmessage.ResourceHeader{Name: dnsmessage.MustNewName(name), Type: t, Class: dnsmessage.ClassINET, TTL: ttl}
+}
+
+func a(name string, ttl uint32, ip ...byte) (r dnsmessage.Resource) {
+	var addr [4]byte
+	copy(addr[:], ip)
+	return dnsmessage.Resource{Header: header(name, dnsmessage.TypeA, ttl), Body: &dnsmessage.AResource{A: addr}}
+}
+
+// answer a packed query from the test zone
+func answerQuery(query []byte, tcp bool) (response []byte) {
+	var m dnsmessage.Message
+	if err := m.Unpack(query); err != nil || len(m.Questions) != 1 {
+		return nil
+	}
+
+	q := m.Questions[0]
+	m.Header.Response = true
+	m.Header.RecursionAvailable = true
+
+	records, ok := zone[q.Name.String()]
+	switch {
+	case !ok:
+		m.Header.RCode = dnsmessage.RCodeNameError
+	case q.Name.String() == truncated && !tcp:
+		m.Header.Truncated = true
+	default:
+		m.Answers = records[q.Type]
+	}
+
+	response, _ = m.Pack()
+	return response
+}
+
+// newServer starts udp and tcp dns servers on the same local port
+func newServer(t *testing.T) (address string, stop func()) {
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("error listening on udp: %s", err)
+	}
+
+	l, err := net.Listen("tcp", pc.LocalAddr().String())
+	if err != nil {
+		pc.Close()
+		t.Fatalf("error listening on tcp: %s", err)
+	}
+
+	go func() {
+		buf := make([]byte, 512)
+		for {
+			n, addr, err := pc.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+			pc.WriteTo(answerQuery(buf[:n], false), addr)
+		}
+	}()
+
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+
+			var length [2]byte
+			io.ReadFull(conn, length[:])
+			query := make([]byte, binary.BigEndian.Uint16(length[:]))
+			io.ReadFull(conn, query)
+
+			response := answerQuery(query, true)
+			binary.BigEndian.PutUint16(length[:], uint16(len(response)))
+			conn.Write(append(length[:], response...))
+			conn.Close()
+		}
+	}()
+
+	return pc.LocalAddr().String(), func() {
+		pc.Close()
+		l.Close()
+	}
+}
+
+func TestDriverTransaction(t *testing.T) {
+	address, stop := newServer(t)
+	defer stop()
+
+	cases := []struct {
+		tests.Case
+		protocol string
+	}{
+		{Case: tests.Case{Name: "a records", Script: `
+query: replicant.test
+expect:
+  answers: [10.0.0.1, 10.0.0.2]
+  exact: true
+  min_ttl: 60
+  max_ttl: 3600
+  max_duration: 5s`}},
+		{Case: tests.Case{Name: "missing answer", Failed: true, Message: "answer 10.0.0.3 not found", Script: `
+query: replicant.test
+expect:
+  answers: [10.0.0.3]`}},
+		{Case: tests.Case{Name: "not exact", Failed: true, Message: "expected exactly", Script: `
+query: replicant.test
+expect:
+  answers: [10.0.0.1]
+  exact: true`}},
+		{Case: tests.Case{Name: "ttl bounds", Failed: true, Message: "expected at most 60", Script: `
+query: replicant.test
+expect:
+  max_ttl: 60`}},
+		{Case: tests.Case{Name: "cname chain", Script: `
+query: www.replicant.test.
+expect:
+  answers: [10.0.0.1]`}},
+		{Case: tests.Case{Name: "no answers", Failed: true, Message: "not found", Script: `
+query: www.replicant.test
+type: CNAME
+expect:
+  answers: [replicant.test]`}},
+		{Case: tests.Case{Name: "mx", Script: `
+query: replicant.test
+type: mx
+expect:
+  answers: ["10 mail.replicant.test."]`}},
+		{Case: tests.Case{Name: "txt", Script: `
+query: replicant.test
+type: TXT
+expect:
+  answers: ["v=spf1 -all"]`}},
+		{Case: tests.Case{Name: "srv", Script: `
+query: _api._tcp.replicant.test
+type: SRV
+expect:
+  answers: ["1 5 8080 api.replicant.test"]`}},
+		{Case: tests.Case{Name: "nxdomain", Script: `
+query: missing.replicant.test
+expect:
+  rcode: NXDOMAIN`}},
+		{Case: tests.Case{Name: "unexpected rcode", Failed: true, Message: "rcode is NXDOMAIN, expected NOERROR", Script: `
+query: missing.replicant.test`}},
+		{Case: tests.Case{Name: "truncated", Script: `
+query: big.replicant.test
+type: AAAA
+expect:
+  answers: ["2001:db8::1"]`}, protocol: "tcp"},
+		{Case: tests.Case{Name: "tcp", Script: `
+query: replicant.test
+protocol: tcp
+expect:
+  answers: [10.0.0.2]`}, protocol: "tcp"},
+	}
+
+	d, err := New()
+	if err != nil {
+		t.Fatalf("error creating driver: %s", err)
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			tt.Script += "\nresolver: " + address
+			result := tests.Run(t, d, tt.Case)
+
+			var r report
+			if err := json.Unmarshal([]byte(result.Data), &r); err != nil {
+				t.Fatalf("Run() data = %s, want report", result.Data)
+			}
+
+			if tt.protocol != "" && r.Protocol != tt.protocol {
+				t.Fatalf("Run() protocol = %s, want %s", r.Protocol, tt.protocol)
+			}
+		})
+	}
+}
+
+func TestDriverNewInvalid(t *testing.T) {
+	d, _ := New()
+	tests.NewInvalid(t, d, map[string]string{
+		"no query":         `type: A`,
+		"unsupported type": "query: a.test\ntype: PTR",
+		"unknown rcode":    "query: a.test\nexpect:\n  rcode: NOPE",
+		"unknown protocol": "query: a.test\nprotocol: quic",
+		"invalid duration": "query: a.test\nexpect:\n  max_duration: fast",
+	})
+}
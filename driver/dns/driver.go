@@ -0,0 +1,220 @@
+// Package dnsd implements a DNS resolution checking driver.
+package dnsd
+
+/*
+   Copyright 2019 Bruno Moura <brunotm@gmail.com>
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+import (
+	"bufio"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/Unbabel/replicant/driver"
+	"github.com/Unbabel/replicant/driver/declarative"
+	"github.com/Unbabel/replicant/transaction"
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+// Config for DNS transactions, specified as yaml or json in the transaction script
+type Config struct {
+	// Query name, eg: example.com
+	Query string `json:"query" yaml:"query"`
+
+	// Type of the records to query: A, AAAA, CNAME, MX, TXT or SRV. Defaults to A.
+	Type string `json:"type" yaml:"type"`
+
+	// Resolver address as host[:port], defaults to the first nameserver in /etc/resolv.conf
+	Resolver string `json:"resolver" yaml:"resolver"`
+
+	// Protocol for queries, udp or tcp. Truncated udp responses are retried over tcp. Defaults to udp.
+	Protocol string `json:"protocol" yaml:"protocol"`
+
+	// Expect assertions for the response
+	Expect Expect `json:"expect" yaml:"expect"`
+}
+
+// Expect assertions for DNS responses
+type Expect struct {
+	// RCode is the expected response code: NOERROR, FORMERR, SERVFAIL, NXDOMAIN, NOTIMP or REFUSED.
+	// Defaults to NOERROR.
+	RCode string `json:"rcode" yaml:"rcode"`
+
+	// Answers that must be present for the queried type. Values are addresses for A and AAAA records,
+	// names for CNAME, "<preference> <host>" for MX, the joined strings for TXT and
+	// "<priority> <weight> <port> <target>" for SRV records.
+	Answers []string `json:"answers" yaml:"answers"`
+
+	// Exact requires the answers to be exactly the expected ones
+	Exact bool `json:"exact" yaml:"exact"`
+
+	// MinTTL and MaxTTL bounds in seconds for the answers of the queried type
+	MinTTL uint32 `json:"min_ttl" yaml:"min_ttl"`
+	MaxTTL uint32 `json:"max_ttl" yaml:"max_ttl"`
+
+	// MaxDuration of the response, eg: 200ms
+	MaxDuration string `json:"max_duration" yaml:"max_duration"`
+}
+
+// New creates a new DNS driver
+func New() (d driver.Driver, err error) {
+	return declarative.NewDriver("dns", newTransaction), nil
+}
+
+// newTransaction creates a DNS transaction
+func newTransaction(config transaction.Config) (tx transaction.Transaction, err error) {
+	txn := &Transaction{}
+
+	if txn.Transaction, err = declarative.Parse("dns", config, &txn.check); err != nil {
+		return nil, err
+	}
+
+	if txn.check.Query == "" {
+		return nil, fmt.Errorf("driver/dns: no query specified")
+	}
+
+	if txn.name, err = dnsmessage.NewName(fqdn(txn.check.Query)); err != nil {
+		return nil, fmt.Errorf("driver/dns: invalid query name: %w", err)
+	}
+
+	if txn.qtype, err = parseType(txn.check.Type); err != nil {
+		return nil, err
+	}
+
+	if txn.rcode, err = parseRCode(txn.check.Expect.RCode); err != nil {
+		return nil, err
+	}
+
+	switch txn.check.Protocol {
+	case "":
+		txn.check.Protocol = "udp"
+	case "udp", "tcp":
+	default:
+		return nil, fmt.Errorf("driver/dns: unknown protocol %s", txn.check.Protocol)
+	}
+
+	if txn.check.Expect.MaxDuration != "" {
+		if txn.maxDuration, err = time.ParseDuration(txn.check.Expect.MaxDuration); err != nil {
+			return nil, fmt.Errorf("driver/dns: invalid max_duration: %w", err)
+		}
+	}
+
+	return txn, nil
+}
+
+// fqdn returns the fully qualified form of the name
+func fqdn(name string) (f string) {
+	if strings.HasSuffix(name, ".") {
+		return name
+	}
+	return name + "."
+}
+
+var types = map[string]dnsmessage.Type{
+	"A":     dnsmessage.TypeA,
+	"AAAA":  dnsmessage.TypeAAAA,
+	"CNAME": dnsmessage.TypeCNAME,
+	"MX":    dnsmessage.TypeMX,
+	"TXT":   dnsmessage.TypeTXT,
+	"SRV":   dnsmessage.TypeSRV,
+}
+
+func parseType(name string) (t dnsmessage.Type, err error) {
+	if name == "" {
+		return dnsmessage.TypeA, nil
+	}
+
+	t, ok := types[strings.ToUpper(name)]
+	if !ok {
+		return t, fmt.Errorf("driver/dns: unsupported record type %s", name)
+	}
+	return t, nil
+}
+
+func typeName(t dnsmessage.Type) (name string) {
+	for name, typ := range types {
+		if typ == t {
+			return name
+		}
+	}
+	return fmt.Sprintf("TYPE%d", t)
+}
+
+var rcodes = map[string]dnsmessage.RCode{
+	"NOERROR":  dnsmessage.RCodeSuccess,
+	"FORMERR":  dnsmessage.RCodeFormatError,
+	"SERVFAIL": dnsmessage.RCodeServerFailure,
+	"NXDOMAIN": dnsmessage.RCodeNameError,
+	"NOTIMP":   dnsmessage.RCodeNotImplemented,
+	"REFUSED":  dnsmessage.RCodeRefused,
+}
+
+func parseRCode(name string) (rcode dnsmessage.RCode, err error) {
+	if name == "" {
+		return dnsmessage.RCodeSuccess, nil
+	}
+
+	rcode, ok := rcodes[strings.ToUpper(name)]
+	if !ok {
+		return rcode, fmt.Errorf("driver/dns: unknown rcode %s", name)
+	}
+	return rcode, nil
+}
+
+func rcodeName(rcode dnsmessage.RCode) (name string) {
+	for name, rc := range rcodes {
+		if rc == rcode {
+			return name
+		}
+	}
+	return fmt.Sprintf("RCODE%d", rcode)
+}
+
+// resolver returns the resolver address with the default port, falling back to
+// the first nameserver in /etc/resolv.conf when unspecified
+func resolver(address string) (r string, err error) {
+	if address == "" {
+		if address, err = systemResolver("/etc/resolv.conf"); err != nil {
+			return "", err
+		}
+	}
+
+	if _, _, err = net.SplitHostPort(address); err != nil {
+		address = net.JoinHostPort(strings.Trim(address, "[]"), "53")
+	}
+
+	return address, nil
+}
+
+func systemResolver(path string) (address string, err error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", fmt.Errorf("driver/dns: error reading system resolver: %w", err)
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) > 1 && fields[0] == "nameserver" {
+			return fields[1], nil
+		}
+	}
+
+	return "", fmt.Errorf("driver/dns: no nameserver found in %s", path)
+}
@@ -0,0 +1,282 @@
+package dnsd
+
+/*
+   Copyright 2019 Bruno Moura <brunotm@gmail.com>
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/Unbabel/replicant/driver/declarative"
+	"github.com/Unbabel/replicant/transaction"
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+const (
+	// defaultTimeout for queries when the transaction has no timeout
+	defaultTimeout = time.Second * 10
+
+	// MetricResponseSeconds is the response time of the resolver
+	MetricResponseSeconds = "dns_response_seconds"
+)
+
+// Transaction is a pre-configured replicant transaction for DNS checks
+type Transaction struct {
+	declarative.Transaction
+	check       Config
+	maxDuration time.Duration
+	name        dnsmessage.Name
+	qtype       dnsmessage.Type
+	rcode       dnsmessage.RCode
+}
+
+// answer record in reports
+type answer struct {
+	Name  string `json:"name"`
+	Type  string `json:"type"`
+	TTL   uint32 `json:"ttl"`
+	Value string `json:"value"`
+}
+
+// report of the query, included in the result data
+type report struct {
+	Query      string   `json:"query"`
+	Type       string   `json:"type"`
+	Resolver   string   `json:"resolver"`
+	Protocol   string   `json:"protocol"`
+	RCode      string   `json:"rcode"`
+	DurationMs int64    `json:"duration_ms"`
+	Answers    []answer `json:"answers"`
+}
+
+// Run executes the DNS check
+func (t *Transaction) Run(ctx context.Context) (result transaction.Result) {
+	result = t.Result()
+
+	ctx, cancel := t.Context(ctx, defaultTimeout)
+	defer cancel()
+
+	address, err := resolver(t.check.Resolver)
+	if err != nil {
+		result.Failed = true
+		result.Error = err
+		return result
+	}
+
+	r := report{Query: t.name.String(), Type: typeName(t.qtype), Resolver: address, Protocol: t.check.Protocol}
+
+	start := time.Now()
+	msg, err := t.exchange(ctx, address, &r)
+	duration := time.Since(start)
+	if err != nil {
+		result.Failed = true
+		result.Error = err
+		result.Message = fmt.Sprintf("error querying %s", address)
+		return result
+	}
+
+	r.RCode = rcodeName(msg.RCode)
+	r.DurationMs = duration.Milliseconds()
+
+	var values []string
+	var ttls []uint32
+	for _, rr := range msg.Answers {
+		a := answer{Name: rr.Header.Name.String(), Type: typeName(rr.Header.Type), TTL: rr.Header.TTL, Value: value(rr.Body)}
+		r.Answers = append(r.Answers, a)
+
+		if rr.Header.Type == t.qtype {
+			values = append(values, a.Value)
+			ttls = append(ttls, a.TTL)
+		}
+	}
+
+	buf, _ := json.Marshal(&r)
+	result.Data = string(buf)
+	result.Metrics = map[string]float64{MetricResponseSeconds: duration.Seconds()}
+
+	if failure := t.assert(msg.RCode, values, ttls, duration); failure != "" {
+		result.Failed = true
+		result.Message = fmt.Sprintf("%s %s: %s", r.Query, r.Type, failure)
+		return result
+	}
+
+	result.Message = fmt.Sprintf("%s %s: %s with %d answers in %s", r.Query, r.Type, r.RCode, len(values), duration)
+	return result
+}
+
+// assert the expectations on the response, returning a description of the first failure
+func (t *Transaction) assert(rcode dnsmessage.RCode, values []string, ttls []uint32, duration time.Duration) (failure string) {
+	expect := t.check.Expect
+
+	if rcode != t.rcode {
+		return fmt.Sprintf("rcode is %s, expected %s", rcodeName(rcode), rcodeName(t.rcode))
+	}
+
+	present := make(map[string]bool, len(values))
+	for _, v := range values {
+		present[strings.ToLower(v)] = true
+	}
+
+	for _, v := range expect.Answers {
+		if !present[strings.ToLower(strings.TrimSuffix(v, "."))] {
+			return fmt.Sprintf("answer %s not found in %v", v, values)
+		}
+	}
+
+	if expect.Exact && len(present) != len(expect.Answers) {
+		return fmt.Sprintf("answers are %v, expected exactly %v", values, expect.Answers)
+	}
+
+	for x, ttl := range ttls {
+		if expect.MinTTL > 0 && ttl < expect.MinTTL {
+			return fmt.Sprintf("ttl of %s is %d, expected at least %d", values[x], ttl, expect.MinTTL)
+		}
+
+		if expect.MaxTTL > 0 && ttl > expect.MaxTTL {
+			return fmt.Sprintf("ttl of %s is %d, expected at most %d", values[x], ttl, expect.MaxTTL)
+		}
+	}
+
+	if t.maxDuration > 0 && duration > t.maxDuration {
+		return fmt.Sprintf("response took %s, expected at most %s", duration, t.maxDuration)
+	}
+
+	return ""
+}
+
+// exchange sends the query to the resolver, retrying truncated udp responses over tcp
+func (t *Transaction) exchange(ctx context.Context, address string, r *report) (msg dnsmessage.Message, err error) {
+	var id [2]byte
+	if _, err = rand.Read(id[:]); err != nil {
+		return msg, fmt.Errorf("driver/dns: error generating query id: %w", err)
+	}
+
+	query := dnsmessage.Message{
+		Header: dnsmessage.Header{ID: binary.BigEndian.Uint16(id[:]), RecursionDesired: true},
+		Questions: []dnsmessage.Question{
+			{Name: t.name, Type: t.qtype, Class: dnsmessage.ClassINET},
+		},
+	}
+
+	buf, err := query.Pack()
+	if err != nil {
+		return msg, fmt.Errorf("driver/dns: error packing query: %w", err)
+	}
+
+	if msg, err = send(ctx, r.Protocol, address, buf, query.ID); err != nil {
+		return msg, err
+	}
+
+	if msg.Truncated && r.Protocol == "udp" {
+		r.Protocol = "tcp"
+		return send(ctx, r.Protocol, address, buf, query.ID)
+	}
+
+	return msg, nil
+}
+
+// send the packed query and read the response with the matching id
+func send(ctx context.Context, network, address string, query []byte, id uint16) (msg dnsmessage.Message, err error) {
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, network, address)
+	if err != nil {
+		return msg, fmt.Errorf("driver/dns: error connecting: %w", err)
+	}
+	defer conn.Close()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+
+	var buf []byte
+	switch network {
+	case "tcp":
+		// tcp messages are prefixed by their length
+		packet := make([]byte, 2+len(query))
+		binary.BigEndian.PutUint16(packet, uint16(len(query)))
+		copy(packet[2:], query)
+
+		if _, err = conn.Write(packet); err != nil {
+			return msg, fmt.Errorf("driver/dns: error sending query: %w", err)
+		}
+
+		var length [2]byte
+		if _, err = io.ReadFull(conn, length[:]); err != nil {
+			return msg, fmt.Errorf("driver/dns: error reading response: %w", err)
+		}
+
+		buf = make([]byte, binary.BigEndian.Uint16(length[:]))
+		if _, err = io.ReadFull(conn, buf); err != nil {
+			return msg, fmt.Errorf("driver/dns: error reading response: %w", err)
+		}
+
+	default:
+		if _, err = conn.Write(query); err != nil {
+			return msg, fmt.Errorf("driver/dns: error sending query: %w", err)
+		}
+
+		buf = make([]byte, 65535)
+		for {
+			n, err := conn.Read(buf)
+			if err != nil {
+				return msg, fmt.Errorf("driver/dns: error reading response: %w", err)
+			}
+
+			// ignore stray responses for other queries
+			if n >= 2 && binary.BigEndian.Uint16(buf) == id {
+				buf = buf[:n]
+				break
+			}
+		}
+	}
+
+	if err = msg.Unpack(buf); err != nil {
+		return msg, fmt.Errorf("driver/dns: error parsing response: %w", err)
+	}
+
+	if msg.ID != id {
+		return msg, fmt.Errorf("driver/dns: response id %d does not match query id %d", msg.ID, id)
+	}
+
+	return msg, nil
+}
+
+// value formats the record data for assertions and reports
+func value(body dnsmessage.ResourceBody) (v string) {
+	switch rr := body.(type) {
+	case *dnsmessage.AResource:
+		return net.IP(rr.A[:]).String()
+	case *dnsmessage.AAAAResource:
+		return net.IP(rr.AAAA[:]).String()
+	case *dnsmessage.CNAMEResource:
+		return strings.TrimSuffix(rr.CNAME.String(), ".")
+	case *dnsmessage.MXResource:
+		return fmt.Sprintf("%d %s", rr.Pref, strings.TrimSuffix(rr.MX.String(), "."))
+	case *dnsmessage.TXTResource:
+		return strings.Join(rr.TXT, "")
+	case *dnsmessage.SRVResource:
+		return fmt.Sprintf("%d %d %d %s", rr.Priority, rr.Weight, rr.Port, strings.TrimSuffix(rr.Target.String(), "."))
+	default:
+		return ""
+	}
+}
@@ -24,3 +24,10 @@ type Driver interface {
 	Type() (t string)
 	New(c transaction.Config) (tx transaction.Transaction, err error)
 }
+
+// Checker is implemented by drivers which can check transaction configs without
+// running any of the transaction code or accessing local resources, as required
+// for validating transactions outside of executors
+type Checker interface {
+	Check(c transaction.Config) (err error)
+}
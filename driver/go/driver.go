@@ -20,12 +20,16 @@ package gd
 import (
 	"context"
 	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
 	"time"
 
 	"github.com/Unbabel/replicant/driver"
+	"github.com/Unbabel/replicant/driver/go/helpers"
 	"github.com/Unbabel/replicant/transaction"
-	"github.com/containous/yaegi/interp"
-	"github.com/containous/yaegi/stdlib"
+	"github.com/traefik/yaegi/interp"
+	"github.com/traefik/yaegi/stdlib"
 )
 
 // Driver for Go language based transactions
@@ -52,8 +56,10 @@ func (d *Driver) New(config transaction.Config) (tx transaction.Transaction, err
 		}
 	}
 
-	i := interp.New(interp.Options{})
-	i.Use(stdlib.Symbols)
+	i, err := NewInterpreter()
+	if err != nil {
+		return nil, fmt.Errorf("driver/go: error creating interpreter: %w", err)
+	}
 
 	_, err = i.Eval(config.Script)
 	if err != nil {
@@ -73,8 +79,10 @@ func (d *Driver) New(config transaction.Config) (tx transaction.Transaction, err
 	}
 
 	if config.CallBack != nil {
-		i := interp.New(interp.Options{})
-		i.Use(stdlib.Symbols)
+		i, err := NewInterpreter()
+		if err != nil {
+			return nil, fmt.Errorf("driver/go: error creating interpreter: %w", err)
+		}
 
 		_, err = i.Eval(config.CallBack.Script)
 		if err != nil {
@@ -97,3 +105,66 @@ func (d *Driver) New(config transaction.Config) (tx transaction.Transaction, err
 	txn.config = config
 	return txn, nil
 }
+
+// NewInterpreter creates a Go interpreter with the standard library,
+// the replicant helpers and the given additional symbols
+func NewInterpreter(symbols ...interp.Exports) (i *interp.Interpreter, err error) {
+	i = interp.New(interp.Options{})
+	for _, s := range append([]interp.Exports{stdlib.Symbols, helpers.Symbols}, symbols...) {
+		if err = i.Use(s); err != nil {
+			return nil, err
+		}
+	}
+
+	return i, nil
+}
+
+// Check parses the transaction and callback scripts without running them
+func (d *Driver) Check(config transaction.Config) (err error) {
+	if config.Timeout != "" {
+		if _, err = time.ParseDuration(config.Timeout); err != nil {
+			return fmt.Errorf("driver/go: error parsing timeout: %w", err)
+		}
+	}
+
+	if err = ParseScript(config.Script, "transaction", "Run", 1); err != nil {
+		return fmt.Errorf("driver/go: error parsing transaction script: %w", err)
+	}
+
+	if config.CallBack != nil {
+		if err = ParseScript(config.CallBack.Script, "callback", "Handle", 2); err != nil {
+			return fmt.Errorf("driver/go: error parsing callback script: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// ParseScript parses the Go source of a script and checks that it declares the
+// given package and entry point function taking the given number of parameters
+// and returning the (message, data string, err error) results.
+// The script is never evaluated.
+func ParseScript(src, pkg, fn string, params int) (err error) {
+	f, err := parser.ParseFile(token.NewFileSet(), pkg+".go", src, 0)
+	if err != nil {
+		return err
+	}
+
+	if f.Name.Name != pkg {
+		return fmt.Errorf("script package must be %s, got %s", pkg, f.Name.Name)
+	}
+
+	for _, decl := range f.Decls {
+		fd, ok := decl.(*ast.FuncDecl)
+		if !ok || fd.Recv != nil || fd.Name.Name != fn {
+			continue
+		}
+
+		if fd.Type.Params.NumFields() != params || fd.Type.Results.NumFields() != 3 {
+			return fmt.Errorf("%s.%s has an invalid signature", pkg, fn)
+		}
+		return nil
+	}
+
+	return fmt.Errorf("%s.%s is not declared", pkg, fn)
+}
@@ -3,6 +3,8 @@ package gd
 import (
 	"context"
 	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/Unbabel/replicant/internal/tmpl"
@@ -30,10 +32,7 @@ func TestDriverTransaction(t *testing.T) {
 		w.Write([]byte(`{"reason": "test successful"}`))
 	})
 
-	server := &http.Server{}
-	server.Addr = "localhost:8080"
-	server.Handler = mux
-	go server.ListenAndServe()
+	server := httptest.NewServer(mux)
 	defer server.Close()
 
 	d, err := New()
@@ -41,6 +40,7 @@ func TestDriverTransaction(t *testing.T) {
 		t.Fatalf("error creating driver: %s", err)
 	}
 
+	config.Inputs["url"] = server.URL + "/test"
 	cfg, err := tmpl.Parse(config)
 	if err != nil {
 		t.Fatalf("error parsing template: %s", err)
@@ -65,6 +65,66 @@ func TestDriverTransaction(t *testing.T) {
 	t.Logf("%#v", result)
 }
 
+func TestDriverHelpers(t *testing.T) {
+	d, err := New()
+	if err != nil {
+		t.Fatalf("error creating driver: %s", err)
+	}
+
+	cfg := transaction.Config{
+		Name:   "test-helpers",
+		Driver: "go",
+		Inputs: map[string]interface{}{"text": "blade runner"},
+		Script: `
+	package transaction
+	import (
+		"context"
+		"replicant"
+	)
+	func Run(ctx context.Context) (m string, d string, err error) {
+		replicant.Info(ctx, "running", "text", replicant.Input(ctx, "text"), "attempt", 1)
+		a := replicant.NewAssertions()
+		a.Equal("uuid", "test-test-test", replicant.UUID(ctx))
+		a.Contains("text", replicant.Input(ctx, "text"), "{{ .expected }}")
+		a.NoError("artifact", replicant.AddArtifact(ctx, "assertions.json", "application/json", []byte(replicant.JSON(a.List()))))
+		return a.Result("test successful")
+	}`,
+	}
+
+	tests := []struct {
+		expected string
+		failed   bool
+		message  string
+	}{
+		{"runner", false, "test successful"},
+		{"deckard", true, `text: "deckard" not found in "blade runner"`},
+	}
+
+	for _, tt := range tests {
+		c := cfg
+		c.Inputs = map[string]interface{}{"text": "blade runner", "expected": tt.expected}
+		c, err := tmpl.Parse(c)
+		if err != nil {
+			t.Fatalf("error parsing template: %s", err)
+		}
+
+		txn, err := d.New(c)
+		if err != nil {
+			t.Fatalf("error creating transaction: %s", err)
+		}
+
+		result := txn.Run(context.WithValue(context.Background(), "transaction_uuid", "test-test-test"))
+		if result.Failed != tt.failed || result.Message != tt.message {
+			t.Fatalf("unexpected result for %s:\n%#v", tt.expected, result)
+		}
+
+		if len(result.Artifacts) != 1 || result.Artifacts[0].Name != "assertions.json" ||
+			!strings.Contains(string(result.Artifacts[0].Data), `"name":"uuid"`) {
+			t.Fatalf("unexpected artifacts for %s:\n%#v", tt.expected, result.Artifacts)
+		}
+	}
+}
+
 // test transaction
 var config transaction.Config = transaction.Config{
 	Name:       "test-transaction",
@@ -121,3 +181,31 @@ var config transaction.Config = transaction.Config{
 		return "test successful", result.Reason, nil
 	}`,
 }
+
+func TestDriverCheck(t *testing.T) {
+	d, err := New()
+	if err != nil {
+		t.Fatalf("error creating driver: %s", err)
+	}
+
+	tests := []struct {
+		name   string
+		script string
+		err    bool
+	}{
+		// scripts are only parsed, init functions must not run
+		{name: "valid", script: "package transaction\n\nfunc init() { panic(\"ran\") }\n\n" +
+			"func Run(ctx context.Context) (m string, d string, err error) { return }\n"},
+		{name: "syntax", script: "package transaction\n\nfunc Run(", err: true},
+		{name: "package", script: "package main\n\nfunc Run(ctx context.Context) (m, d string, err error) { return }\n", err: true},
+		{name: "signature", script: "package transaction\n\nfunc Run() {}\n", err: true},
+		{name: "missing", script: "package transaction\n", err: true},
+	}
+
+	for _, tt := range tests {
+		err := d.(*Driver).Check(transaction.Config{Name: "test", Driver: "go", Script: tt.script})
+		if (err != nil) != tt.err {
+			t.Errorf("Driver.Check() %s error = %v, want error %v", tt.name, err, tt.err)
+		}
+	}
+}
@@ -0,0 +1,134 @@
+package helpers
+
+/*
+   Copyright 2019 Bruno Moura <brunotm@gmail.com>
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+)
+
+// ErrAssertion is returned by Assertions.Result when any assertion failed
+var ErrAssertion = errors.New("assertion failed")
+
+// Assertion is the outcome of a single named check
+type Assertion struct {
+	Name    string `json:"name"`
+	Passed  bool   `json:"passed"`
+	Message string `json:"message,omitempty"`
+}
+
+// Assertions records named checks made by a transaction and renders them
+// as the transaction message and data
+type Assertions struct {
+	assertions []Assertion
+}
+
+// NewAssertions creates a new empty set of assertions
+func NewAssertions() (a *Assertions) {
+	return &Assertions{}
+}
+
+// True asserts that the given value is true
+func (a *Assertions) True(name string, value bool) (passed bool) {
+	return a.add(name, value, "expected true, got false")
+}
+
+// Equal asserts that the actual value is deeply equal to the expected one
+func (a *Assertions) Equal(name string, expected, actual interface{}) (passed bool) {
+	return a.add(name, reflect.DeepEqual(expected, actual),
+		fmt.Sprintf("expected %#v, got %#v", expected, actual))
+}
+
+// Contains asserts that s contains substr
+func (a *Assertions) Contains(name, s, substr string) (passed bool) {
+	return a.add(name, strings.Contains(s, substr),
+		fmt.Sprintf("%q not found in %q", substr, truncate(s)))
+}
+
+// Match asserts that s matches the given regular expression
+func (a *Assertions) Match(name, pattern, s string) (passed bool) {
+	rx, err := regexp.Compile(pattern)
+	if err != nil {
+		return a.add(name, false, fmt.Sprintf("invalid pattern %q: %s", pattern, err))
+	}
+	return a.add(name, rx.MatchString(s),
+		fmt.Sprintf("%q does not match %q", truncate(s), pattern))
+}
+
+// NoError asserts that err is nil
+func (a *Assertions) NoError(name string, err error) (passed bool) {
+	if err != nil {
+		return a.add(name, false, err.Error())
+	}
+	return a.add(name, true, "")
+}
+
+// Failed returns true if any assertion failed
+func (a *Assertions) Failed() (failed bool) {
+	for x := range a.assertions {
+		if !a.assertions[x].Passed {
+			return true
+		}
+	}
+	return false
+}
+
+// List returns the recorded assertions
+func (a *Assertions) List() (assertions []Assertion) {
+	return a.assertions
+}
+
+// Result returns the values for a transaction Run or callback Handle function.
+// The data is the json serialized list of assertions. If any assertion failed
+// the message reports the failed assertions and the error wraps ErrAssertion.
+func (a *Assertions) Result(message string) (m, data string, err error) {
+	data = JSON(map[string]interface{}{"assertions": a.assertions})
+
+	var failed []string
+	for x := range a.assertions {
+		if !a.assertions[x].Passed {
+			failed = append(failed, a.assertions[x].Name+": "+a.assertions[x].Message)
+		}
+	}
+
+	if len(failed) == 0 {
+		return message, data, nil
+	}
+
+	m = strings.Join(failed, "; ")
+	return m, data, fmt.Errorf("%w: %s", ErrAssertion, m)
+}
+
+func (a *Assertions) add(name string, passed bool, message string) (ok bool) {
+	assertion := Assertion{Name: name, Passed: passed}
+	if !passed {
+		assertion.Message = message
+	}
+	a.assertions = append(a.assertions, assertion)
+	return passed
+}
+
+// truncate long values in assertion messages
+func truncate(s string) (t string) {
+	if len(s) > 128 {
+		return s[:128] + "..."
+	}
+	return s
+}
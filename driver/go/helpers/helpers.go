@@ -0,0 +1,113 @@
+// Package helpers implements the replicant helper library for Go driver transaction scripts.
+// Scripts use it through the "replicant" import path, eg:
+//
+//	import "replicant"
+//
+//	func Run(ctx context.Context) (m string, d string, err error) {
+//		replicant.Info(ctx, "starting", "callback", replicant.CallbackAddress(ctx))
+//		...
+//	}
+package helpers
+
+/*
+   Copyright 2019 Bruno Moura <brunotm@gmail.com>
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/Unbabel/replicant/transaction"
+)
+
+// Context keys set by replicant for transaction runs
+const (
+	UUIDKey            = "transaction_uuid"
+	CallbackAddressKey = "callback_address"
+	InputsKey          = "transaction_inputs"
+	ArtifactsKey       = "transaction_artifacts"
+)
+
+// Symbols are the helpers exported to the Go driver interpreter under the "replicant" import path
+var Symbols = map[string]map[string]reflect.Value{
+	"replicant/replicant": {
+		"UUID":            reflect.ValueOf(UUID),
+		"CallbackAddress": reflect.ValueOf(CallbackAddress),
+		"Inputs":          reflect.ValueOf(Inputs),
+		"Input":           reflect.ValueOf(Input),
+		"JSON":            reflect.ValueOf(JSON),
+		"AddArtifact":     reflect.ValueOf(AddArtifact),
+		"Debug":           reflect.ValueOf(Debug),
+		"Info":            reflect.ValueOf(Info),
+		"Warn":            reflect.ValueOf(Warn),
+		"Error":           reflect.ValueOf(Error),
+		"NewAssertions":   reflect.ValueOf(NewAssertions),
+		"ErrAssertion":    reflect.ValueOf(&ErrAssertion).Elem(),
+		"Assertion":       reflect.ValueOf((*Assertion)(nil)),
+		"Assertions":      reflect.ValueOf((*Assertions)(nil)),
+	},
+}
+
+// UUID returns the uuid of the running transaction
+func UUID(ctx context.Context) (uuid string) {
+	uuid, _ = ctx.Value(UUIDKey).(string)
+	return uuid
+}
+
+// CallbackAddress returns the address for asynchronous responses for transactions with callbacks,
+// or an empty string otherwise
+func CallbackAddress(ctx context.Context) (address string) {
+	address, _ = ctx.Value(CallbackAddressKey).(string)
+	return address
+}
+
+// Inputs returns the transaction inputs
+func Inputs(ctx context.Context) (inputs map[string]interface{}) {
+	inputs, _ = ctx.Value(InputsKey).(map[string]interface{})
+	if inputs == nil {
+		inputs = map[string]interface{}{}
+	}
+	return inputs
+}
+
+// Input returns the named transaction input formatted as a string,
+// or an empty string if not found
+func Input(ctx context.Context, name string) (value string) {
+	v, ok := Inputs(ctx)[name]
+	if !ok || v == nil {
+		return ""
+	}
+	return fmt.Sprint(v)
+}
+
+// JSON serializes the given value for use as the transaction data
+func JSON(v interface{}) (data string) {
+	buf, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf(`{"error": %q}`, err.Error())
+	}
+	return string(buf)
+}
+
+// AddArtifact attaches the named artifact with the given content type and data to the transaction result
+func AddArtifact(ctx context.Context, name, contentType string, data []byte) (err error) {
+	artifacts, ok := ctx.Value(ArtifactsKey).(*transaction.Artifacts)
+	if !ok {
+		return fmt.Errorf("artifacts not available for this transaction run")
+	}
+	return artifacts.Add(name, contentType, data)
+}
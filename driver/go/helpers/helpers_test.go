@@ -0,0 +1,59 @@
+package helpers
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestContextAccessors(t *testing.T) {
+	ctx := context.WithValue(context.Background(), UUIDKey, "test-test-test")
+	ctx = context.WithValue(ctx, CallbackAddressKey, "http://localhost/callback")
+	ctx = context.WithValue(ctx, InputsKey, map[string]interface{}{"text": "blade runner", "count": 2})
+
+	if UUID(ctx) != "test-test-test" {
+		t.Fatalf("unexpected uuid: %s", UUID(ctx))
+	}
+
+	if CallbackAddress(ctx) != "http://localhost/callback" {
+		t.Fatalf("unexpected callback address: %s", CallbackAddress(ctx))
+	}
+
+	if Input(ctx, "text") != "blade runner" || Input(ctx, "count") != "2" || Input(ctx, "missing") != "" {
+		t.Fatalf("unexpected inputs: %#v", Inputs(ctx))
+	}
+
+	if len(Inputs(context.Background())) != 0 || UUID(context.Background()) != "" {
+		t.Fatalf("expected empty values from empty context")
+	}
+}
+
+func TestAssertions(t *testing.T) {
+	a := NewAssertions()
+	a.Equal("status", 200, 200)
+	a.Contains("body", "replicant", "cant")
+	a.Match("id", `^[0-9]+$`, "1234")
+	a.NoError("request", nil)
+
+	m, d, err := a.Result("all good")
+	if err != nil || m != "all good" || a.Failed() {
+		t.Fatalf("unexpected result: %s, %s", m, err)
+	}
+
+	if d != `{"assertions":[{"name":"status","passed":true},{"name":"body","passed":true},`+
+		`{"name":"id","passed":true},{"name":"request","passed":true}]}` {
+		t.Fatalf("unexpected data: %s", d)
+	}
+
+	a.Equal("status", 200, 404)
+	a.True("ready", false)
+
+	m, _, err = a.Result("all good")
+	if !errors.Is(err, ErrAssertion) || !a.Failed() {
+		t.Fatalf("expected assertion error, got: %v", err)
+	}
+
+	if m != "status: expected 200, got 404; ready: expected true, got false" {
+		t.Fatalf("unexpected message: %s", m)
+	}
+}
@@ -0,0 +1,84 @@
+package helpers
+
+/*
+   Copyright 2019 Bruno Moura <brunotm@gmail.com>
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Unbabel/replicant/log"
+	blog "github.com/brunotm/log"
+)
+
+// Debug logs a message with the given key value pairs to the replicant log
+func Debug(ctx context.Context, message string, kv ...interface{}) {
+	entry(ctx, log.Debug(message), kv).Log()
+}
+
+// Info logs a message with the given key value pairs to the replicant log
+func Info(ctx context.Context, message string, kv ...interface{}) {
+	entry(ctx, log.Info(message), kv).Log()
+}
+
+// Warn logs a message with the given key value pairs to the replicant log
+func Warn(ctx context.Context, message string, kv ...interface{}) {
+	entry(ctx, log.Warn(message), kv).Log()
+}
+
+// Error logs a message with the given key value pairs to the replicant log
+func Error(ctx context.Context, message string, kv ...interface{}) {
+	entry(ctx, log.Error(message), kv).Log()
+}
+
+// entry adds the transaction uuid and the key value pairs to the log entry.
+// A key without a value is logged as null.
+func entry(ctx context.Context, e blog.Entry, kv []interface{}) (ret blog.Entry) {
+	e = e.String("driver", "go").String("uuid", UUID(ctx))
+
+	for x := 0; x < len(kv); x += 2 {
+		key := fmt.Sprint(kv[x])
+		if x+1 == len(kv) {
+			e = e.Null(key)
+			break
+		}
+
+		switch v := kv[x+1].(type) {
+		case string:
+			e = e.String(key, v)
+		case bool:
+			e = e.Bool(key, v)
+		case int:
+			e = e.Int(key, int64(v))
+		case int32:
+			e = e.Int(key, int64(v))
+		case int64:
+			e = e.Int(key, v)
+		case float32:
+			e = e.Float(key, float64(v))
+		case float64:
+			e = e.Float(key, v)
+		case error:
+			e = e.Error(key, v)
+		case nil:
+			e = e.Null(key)
+		default:
+			e = e.String(key, fmt.Sprint(v))
+		}
+	}
+
+	return e
+}
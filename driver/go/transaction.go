@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/Unbabel/replicant/driver/go/helpers"
 	"github.com/Unbabel/replicant/transaction"
 	"github.com/Unbabel/replicant/transaction/callback"
 )
@@ -54,6 +55,11 @@ func (t *Transaction) Run(ctx context.Context) (result transaction.Result) {
 
 	var err error
 	var handle *callback.Handle
+	ctx = context.WithValue(ctx, helpers.InputsKey, t.config.Inputs)
+
+	artifacts := &transaction.Artifacts{}
+	ctx = context.WithValue(ctx, helpers.ArtifactsKey, artifacts)
+	defer func() { result.Artifacts = artifacts.List() }()
 
 	// If dealing with async responses for this transaction, we must first get a Listener and Handle
 	if t.config.CallBack != nil {
@@ -70,7 +76,7 @@ func (t *Transaction) Run(ctx context.Context) (result transaction.Result) {
 			return result
 		}
 
-		ctx = context.WithValue(ctx, "callback_address", handle.Address)
+		ctx = context.WithValue(ctx, helpers.CallbackAddressKey, handle.Address)
 	}
 
 	m, d, err := t.transaction(ctx)
@@ -0,0 +1,223 @@
+// Package grpcd implements a gRPC transaction driver.
+package grpcd
+
+/*
+   Copyright 2019 Bruno Moura <brunotm@gmail.com>
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"strconv"
+	"strings"
+
+	"github.com/Unbabel/replicant/driver"
+	"github.com/Unbabel/replicant/driver/declarative"
+	"github.com/Unbabel/replicant/transaction"
+	"github.com/golang/protobuf/proto"
+	dpb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"github.com/jhump/protoreflect/desc"
+	"google.golang.org/grpc/codes"
+)
+
+// Config for gRPC transactions, specified as yaml or json in the transaction script
+type Config struct {
+	// Target address of the gRPC server, eg: localhost:50051
+	Target string `json:"target" yaml:"target"`
+
+	// Method to call as <package>.<service>/<method>, eg: grpc.health.v1.Health/Check
+	Method string `json:"method" yaml:"method"`
+
+	// Request message in the protobuf json format
+	Request string `json:"request" yaml:"request"`
+
+	// Metadata headers sent with the request
+	Metadata map[string]string `json:"metadata" yaml:"metadata"`
+
+	// TLS config for the connection, plaintext is used if unspecified
+	TLS *TLSConfig `json:"tls" yaml:"tls"`
+
+	// DescriptorSet is a base64 encoded FileDescriptorSet with the service definition
+	// including imports, as generated by protoc --include_imports --descriptor_set_out.
+	// Server reflection is used for resolving the service when no descriptor set is specified.
+	DescriptorSet string `json:"descriptor_set" yaml:"descriptor_set"`
+
+	// DescriptorSetFile is the path of a FileDescriptorSet in the executor
+	DescriptorSetFile string `json:"descriptor_set_file" yaml:"descriptor_set_file"`
+
+	// Expect assertions for the response
+	Expect Expect `json:"expect" yaml:"expect"`
+}
+
+// TLSConfig for gRPC connections
+type TLSConfig struct {
+	CAFile             string `json:"ca_file" yaml:"ca_file"`
+	CertFile           string `json:"cert_file" yaml:"cert_file"`
+	KeyFile            string `json:"key_file" yaml:"key_file"`
+	ServerName         string `json:"server_name" yaml:"server_name"`
+	InsecureSkipVerify bool   `json:"insecure_skip_verify" yaml:"insecure_skip_verify"`
+}
+
+// Expect assertions for gRPC responses
+type Expect struct {
+	// Code is the expected status code name, eg: OK, NOT_FOUND. Defaults to OK.
+	Code string `json:"code" yaml:"code"`
+
+	// Fields maps response field paths to their expected values.
+	// Paths are original field names in a simple JSONPath form, eg: $.items[0].name or items.0.name.
+	Fields map[string]interface{} `json:"fields" yaml:"fields"`
+}
+
+// Driver for gRPC transactions
+type Driver struct{}
+
+// New creates a new gRPC driver
+func New() (d driver.Driver, err error) {
+	return &Driver{}, nil
+}
+
+// Type returns this driver type
+func (d *Driver) Type() (t string) {
+	return "grpc"
+}
+
+// New creates a gRPC transaction
+func (d *Driver) New(config transaction.Config) (tx transaction.Transaction, err error) {
+	return d.newTransaction(config, true)
+}
+
+// Check parses the transaction script without reading descriptor set files,
+// which are only available to executors
+func (d *Driver) Check(config transaction.Config) (err error) {
+	_, err = d.newTransaction(config, false)
+	return err
+}
+
+// newTransaction parses the transaction script and loads the service descriptors
+func (d *Driver) newTransaction(config transaction.Config, readFiles bool) (txn *Transaction, err error) {
+	txn = &Transaction{}
+
+	if txn.Transaction, err = declarative.Parse("grpc", config, &txn.request); err != nil {
+		return nil, err
+	}
+
+	if txn.request.Target == "" {
+		return nil, fmt.Errorf("driver/grpc: no target specified")
+	}
+
+	txn.service, txn.method, err = splitMethod(txn.request.Method)
+	if err != nil {
+		return nil, err
+	}
+
+	if txn.code, err = parseCode(txn.request.Expect.Code); err != nil {
+		return nil, err
+	}
+
+	if !readFiles {
+		txn.request.DescriptorSetFile = ""
+	}
+
+	files, err := loadDescriptorSet(txn.request)
+	if err != nil {
+		return nil, err
+	}
+
+	if files != nil {
+		if txn.descriptor, err = findMethod(files, txn.service, txn.method); err != nil {
+			return nil, err
+		}
+	}
+
+	return txn, nil
+}
+
+// splitMethod splits a <package>.<service>/<method> name
+func splitMethod(name string) (service, method string, err error) {
+	name = strings.TrimPrefix(name, "/")
+	idx := strings.LastIndex(name, "/")
+	if idx < 1 || idx == len(name)-1 {
+		return "", "", fmt.Errorf("driver/grpc: invalid method %q, must be <package>.<service>/<method>", name)
+	}
+	return name[:idx], name[idx+1:], nil
+}
+
+// parseCode parses a status code name in either the canonical, eg: NOT_FOUND,
+// or Go form, eg: NotFound, or its number. Defaults to OK if empty.
+func parseCode(name string) (code codes.Code, err error) {
+	if name == "" {
+		return codes.OK, nil
+	}
+
+	if n, err := strconv.ParseUint(name, 10, 32); err == nil && n <= uint64(codes.Unauthenticated) {
+		return codes.Code(n), nil
+	}
+
+	normalized := strings.Replace(name, "_", "", -1)
+	for c := codes.OK; c <= codes.Unauthenticated; c++ {
+		if strings.EqualFold(c.String(), normalized) {
+			return c, nil
+		}
+	}
+
+	return code, fmt.Errorf("driver/grpc: unknown status code %s", name)
+}
+
+// loadDescriptorSet loads the file descriptors from the configured descriptor set, if any
+func loadDescriptorSet(c Config) (files map[string]*desc.FileDescriptor, err error) {
+	var buf []byte
+
+	switch {
+	case c.DescriptorSet != "":
+		if buf, err = base64.StdEncoding.DecodeString(c.DescriptorSet); err != nil {
+			return nil, fmt.Errorf("driver/grpc: error decoding descriptor set: %w", err)
+		}
+	case c.DescriptorSetFile != "":
+		if buf, err = ioutil.ReadFile(c.DescriptorSetFile); err != nil {
+			return nil, fmt.Errorf("driver/grpc: error reading descriptor set: %w", err)
+		}
+	default:
+		return nil, nil
+	}
+
+	var set dpb.FileDescriptorSet
+	if err = proto.Unmarshal(buf, &set); err != nil {
+		return nil, fmt.Errorf("driver/grpc: error parsing descriptor set: %w", err)
+	}
+
+	if files, err = desc.CreateFileDescriptorsFromSet(&set); err != nil {
+		return nil, fmt.Errorf("driver/grpc: error loading descriptor set: %w", err)
+	}
+
+	return files, nil
+}
+
+// findMethod finds the method descriptor within the given files
+func findMethod(files map[string]*desc.FileDescriptor, service, method string) (md *desc.MethodDescriptor, err error) {
+	for _, fd := range files {
+		sd := fd.FindService(service)
+		if sd == nil {
+			continue
+		}
+
+		if md = sd.FindMethodByName(method); md == nil {
+			return nil, fmt.Errorf("driver/grpc: method %s not found in service %s", method, service)
+		}
+		return md, nil
+	}
+
+	return nil, fmt.Errorf("driver/grpc: service %s not found in descriptor set", service)
+}
@@ -0,0 +1,156 @@
+package grpcd
+
+import (
+	"context"
+	"encoding/base64"
+	"net"
+	"testing"
+
+	"github.com/Unbabel/replicant/driver/declarative/tests"
+	"github.com/Unbabel/replicant/transaction"
+	"github.com/golang/protobuf/proto"
+	"github.com/jhump/protoreflect/desc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
+)
+
+// newServer starts an in-process gRPC server with the health service,
+// optionally with server reflection
+func newServer(t *testing.T, reflect bool) (address string, stop func()) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("error listening: %s", err)
+	}
+
+	hs := health.NewServer()
+	hs.SetServingStatus("replicant", healthpb.HealthCheckResponse_SERVING)
+	hs.SetServingStatus("down", healthpb.HealthCheckResponse_NOT_SERVING)
+
+	s := grpc.NewServer(grpc.UnaryInterceptor(requireToken))
+	healthpb.RegisterHealthServer(s, hs)
+	if reflect {
+		reflection.Register(s)
+	}
+
+	go s.Serve(l)
+	return l.Addr().String(), s.Stop
+}
+
+// requireToken rejects health checks for the secured service without the expected token
+func requireToken(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (interface{}, error) {
+
+	if r, ok := req.(*healthpb.HealthCheckRequest); ok && r.Service == "replicant" {
+		md, _ := metadata.FromIncomingContext(ctx)
+		if v := md.Get("x-token"); len(v) == 0 || v[0] != "secret" {
+			return nil, status.Error(codes.Unauthenticated, "missing token")
+		}
+	}
+	return handler(ctx, req)
+}
+
+func healthDescriptorSet(t *testing.T) (set string) {
+	fd, err := desc.LoadFileDescriptor("grpc/health/v1/health.proto")
+	if err != nil {
+		t.Fatalf("error loading health descriptor: %s", err)
+	}
+
+	buf, err := proto.Marshal(desc.ToFileDescriptorSet(fd))
+	if err != nil {
+		t.Fatalf("error marshaling descriptor set: %s", err)
+	}
+
+	return base64.StdEncoding.EncodeToString(buf)
+}
+
+func TestDriverTransaction(t *testing.T) {
+	address, stop := newServer(t, true)
+	defer stop()
+
+	plainAddress, plainStop := newServer(t, false)
+	defer plainStop()
+
+	cases := []tests.Case{
+		{Name: "reflection", Script: `
+target: ` + address + `
+method: grpc.health.v1.Health/Check
+request: '{"service": "replicant"}'
+metadata:
+  x-token: secret
+expect:
+  fields:
+    status: SERVING`},
+		{Name: "field mismatch", Failed: true, Script: `
+target: ` + address + `
+method: grpc.health.v1.Health/Check
+request: '{"service": "down"}'
+expect:
+  fields:
+    status: SERVING`},
+		{Name: "expected status code", Script: `
+target: ` + address + `
+method: grpc.health.v1.Health/Check
+request: '{"service": "unknown"}'
+expect:
+  code: NOT_FOUND`},
+		{Name: "unexpected status code", Failed: true, Script: `
+target: ` + address + `
+method: grpc.health.v1.Health/Check
+request: '{"service": "replicant"}'`},
+		{Name: "descriptor set", Script: `
+target: ` + plainAddress + `
+method: grpc.health.v1.Health/Check
+descriptor_set: ` + healthDescriptorSet(t) + `
+expect:
+  fields:
+    status: SERVING`},
+		{Name: "no reflection", Failed: true, Error: true, Script: `
+target: ` + plainAddress + `
+method: grpc.health.v1.Health/Check`},
+	}
+
+	d, err := New()
+	if err != nil {
+		t.Fatalf("error creating driver: %s", err)
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			tests.Run(t, d, tt)
+		})
+	}
+}
+
+func TestDriverNewInvalid(t *testing.T) {
+	d, _ := New()
+	tests.NewInvalid(t, d, map[string]string{
+		"no target":       `method: grpc.health.v1.Health/Check`,
+		"invalid method":  "target: localhost:1\nmethod: Check",
+		"unknown code":    "target: localhost:1\nmethod: a.B/C\nexpect:\n  code: NOPE",
+		"unknown service": "target: localhost:1\nmethod: a.B/C\ndescriptor_set: " + healthDescriptorSet(t),
+	})
+}
+
+func TestDriverCheck(t *testing.T) {
+	d, _ := New()
+	config := transaction.Config{Name: "check", Script: "target: localhost:1\nmethod: a.B/C\ndescriptor_set_file: /nonexistent"}
+
+	if _, err := d.New(config); err == nil {
+		t.Fatalf("New() expected error reading descriptor set file")
+	}
+
+	// descriptor set files are never read when checking
+	if err := d.(*Driver).Check(config); err != nil {
+		t.Fatalf("Check() error = %s", err)
+	}
+
+	config.Script = "target: localhost:1\nmethod: Check"
+	if err := d.(*Driver).Check(config); err == nil {
+		t.Fatalf("Check() expected error")
+	}
+}
@@ -0,0 +1,203 @@
+package grpcd
+
+/*
+   Copyright 2019 Bruno Moura <brunotm@gmail.com>
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/Unbabel/replicant/driver/declarative"
+	"github.com/Unbabel/replicant/internal/jsonpath"
+	"github.com/Unbabel/replicant/internal/tlsutil"
+	"github.com/Unbabel/replicant/transaction"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/jhump/protoreflect/desc"
+	"github.com/jhump/protoreflect/dynamic"
+	"github.com/jhump/protoreflect/dynamic/grpcdynamic"
+	"github.com/jhump/protoreflect/grpcreflect"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	rpb "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+	"google.golang.org/grpc/status"
+)
+
+// Transaction is a pre-configured replicant transaction for gRPC services
+type Transaction struct {
+	declarative.Transaction
+	request    Config
+	service    string
+	method     string
+	code       codes.Code
+	descriptor *desc.MethodDescriptor
+}
+
+// Run executes the gRPC transaction
+func (t *Transaction) Run(ctx context.Context) (result transaction.Result) {
+	result = t.Result()
+
+	ctx, cancel := t.Context(ctx, 0)
+	defer cancel()
+
+	conn, err := t.dial(ctx)
+	if err != nil {
+		result.Failed = true
+		result.Error = err
+		return result
+	}
+	defer conn.Close()
+
+	md := t.descriptor
+	if md == nil {
+		if md, err = t.resolve(ctx, conn); err != nil {
+			result.Failed = true
+			result.Error = err
+			return result
+		}
+	}
+
+	if md.IsClientStreaming() || md.IsServerStreaming() {
+		result.Failed = true
+		result.Error = fmt.Errorf("driver/grpc: streaming method %s is not supported", md.GetFullyQualifiedName())
+		return result
+	}
+
+	req := dynamic.NewMessage(md.GetInputType())
+	if strings.TrimSpace(t.request.Request) != "" {
+		if err = req.UnmarshalJSON([]byte(t.request.Request)); err != nil {
+			result.Failed = true
+			result.Error = fmt.Errorf("driver/grpc: error parsing request: %w", err)
+			return result
+		}
+	}
+
+	if len(t.request.Metadata) > 0 {
+		ctx = metadata.NewOutgoingContext(ctx, metadata.New(t.request.Metadata))
+	}
+
+	resp, err := grpcdynamic.NewStub(conn).InvokeRpc(ctx, md, req)
+	st := status.Convert(err)
+
+	result.Message = fmt.Sprintf("%s/%s returned %s", t.service, t.method, st.Code())
+	if st.Code() != t.code {
+		result.Failed = true
+		result.Message = fmt.Sprintf("%s/%s returned %s, expected %s", t.service, t.method, st.Code(), t.code)
+		result.Data = st.Message()
+		return result
+	}
+
+	if err != nil {
+		result.Data = st.Message()
+		return result
+	}
+
+	dm, err := dynamic.AsDynamicMessage(resp)
+	if err != nil {
+		result.Failed = true
+		result.Error = fmt.Errorf("driver/grpc: error reading response: %w", err)
+		return result
+	}
+
+	buf, err := dm.MarshalJSONPB(&jsonpb.Marshaler{OrigName: true, EmitDefaults: true})
+	if err != nil {
+		result.Failed = true
+		result.Error = fmt.Errorf("driver/grpc: error serializing response: %w", err)
+		return result
+	}
+	result.Data = string(buf)
+
+	if msg, ok := assertFields(buf, t.request.Expect.Fields); !ok {
+		result.Failed = true
+		result.Message = msg
+	}
+
+	return result
+}
+
+// dial creates a client connection to the target
+func (t *Transaction) dial(ctx context.Context) (conn *grpc.ClientConn, err error) {
+	opts := []grpc.DialOption{grpc.WithInsecure()}
+
+	if c := t.request.TLS; c != nil {
+		tc, err := tlsutil.ClientConfig(c.CAFile, c.CertFile, c.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("driver/grpc: %w", err)
+		}
+		tc.ServerName = c.ServerName
+		tc.InsecureSkipVerify = c.InsecureSkipVerify
+		opts = []grpc.DialOption{grpc.WithTransportCredentials(credentials.NewTLS(tc))}
+	}
+
+	if conn, err = grpc.DialContext(ctx, t.request.Target, opts...); err != nil {
+		return nil, fmt.Errorf("driver/grpc: error connecting to %s: %w", t.request.Target, err)
+	}
+
+	return conn, nil
+}
+
+// resolve the method descriptor through server reflection
+func (t *Transaction) resolve(ctx context.Context, conn *grpc.ClientConn) (md *desc.MethodDescriptor, err error) {
+	rc := grpcreflect.NewClient(ctx, rpb.NewServerReflectionClient(conn))
+	defer rc.Reset()
+
+	sd, err := rc.ResolveService(t.service)
+	if err != nil {
+		return nil, fmt.Errorf("driver/grpc: error resolving service %s through reflection: %w", t.service, err)
+	}
+
+	if md = sd.FindMethodByName(t.method); md == nil {
+		return nil, fmt.Errorf("driver/grpc: method %s not found in service %s", t.method, t.service)
+	}
+
+	return md, nil
+}
+
+// assertFields checks the expected field values against the json response,
+// returning a message describing the first mismatch
+func assertFields(response []byte, fields map[string]interface{}) (msg string, ok bool) {
+	if len(fields) == 0 {
+		return "", true
+	}
+
+	data, err := jsonpath.Decode(response)
+	if err != nil {
+		return fmt.Sprintf("error decoding response: %s", err), false
+	}
+
+	// check paths in order for stable messages
+	paths := make([]string, 0, len(fields))
+	for path := range fields {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	for _, path := range paths {
+		value, found := jsonpath.Lookup(data, path)
+		if !found {
+			return fmt.Sprintf("field %s not found in response", path), false
+		}
+
+		if got, want := jsonpath.Format(value), fmt.Sprint(fields[path]); got != want {
+			return fmt.Sprintf("field %s is %s, expected %s", path, got, want), false
+		}
+	}
+
+	return "", true
+}
@@ -0,0 +1,180 @@
+// Package httpd implements a declarative HTTP transaction driver.
+package httpd
+
+/*
+   Copyright 2019 Bruno Moura <brunotm@gmail.com>
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/Unbabel/replicant/driver"
+	"github.com/Unbabel/replicant/driver/declarative"
+	"github.com/Unbabel/replicant/transaction"
+)
+
+// Step is a request with assertions on its response. Captured values from previous
+// steps can be referenced as ${name} in the url, headers and body.
+type Step struct {
+	// Name of the step, defaults to its position
+	Name string `json:"name" yaml:"name"`
+
+	// Method of the request, defaults to GET
+	Method string `json:"method" yaml:"method"`
+
+	// URL of the request
+	URL string `json:"url" yaml:"url"`
+
+	// Headers of the request
+	Headers map[string]string `json:"headers" yaml:"headers"`
+
+	// Body of the request
+	Body string `json:"body" yaml:"body"`
+
+	// Expect assertions for the response
+	Expect Expect `json:"expect" yaml:"expect"`
+
+	// Capture values from the response for use in the following steps
+	Capture map[string]Capture `json:"capture" yaml:"capture"`
+}
+
+// Expect assertions for a step response
+type Expect struct {
+	// Status code of the response, any 2xx status if unspecified
+	Status int `json:"status" yaml:"status"`
+
+	// Headers maps response headers to regular expressions their values must match
+	Headers map[string]string `json:"headers" yaml:"headers"`
+
+	// JSON maps JSONPath expressions, eg: $.items[0].id, to their expected values
+	JSON map[string]interface{} `json:"json" yaml:"json"`
+
+	// Body regular expressions the response body must match
+	Body []string `json:"body" yaml:"body"`
+
+	// MaxDuration for the response, eg: 500ms
+	MaxDuration string `json:"max_duration" yaml:"max_duration"`
+}
+
+// Capture a value from the response from only one of a JSONPath expression,
+// a header or the first submatch of a regular expression on the body.
+// Secret captures, eg: session tokens, are redacted from the result.
+type Capture struct {
+	JSON   string `json:"json" yaml:"json"`
+	Header string `json:"header" yaml:"header"`
+	Regex  string `json:"regex" yaml:"regex"`
+	Secret bool   `json:"secret" yaml:"secret"`
+}
+
+// New creates a new HTTP driver
+func New() (d driver.Driver, err error) {
+	return declarative.NewDriver("http", newTransaction), nil
+}
+
+// newTransaction creates a HTTP transaction from the list of steps in the transaction script
+func newTransaction(config transaction.Config) (tx transaction.Transaction, err error) {
+	txn := &Transaction{}
+
+	var steps []Step
+	if txn.Transaction, err = declarative.Parse("http", config, &steps); err != nil {
+		return nil, err
+	}
+
+	if len(steps) == 0 {
+		return nil, fmt.Errorf("driver/http: no steps specified")
+	}
+
+	for x := range steps {
+		s, err := newStep(x, steps[x])
+		if err != nil {
+			return nil, err
+		}
+		txn.steps = append(txn.steps, s)
+	}
+
+	return txn, nil
+}
+
+// step is a validated step with its compiled expressions
+type step struct {
+	Step
+	maxDuration time.Duration
+	headers     map[string]*regexp.Regexp
+	body        []*regexp.Regexp
+	captures    map[string]*regexp.Regexp
+}
+
+func newStep(idx int, c Step) (s *step, err error) {
+	s = &step{Step: c}
+	if s.Name == "" {
+		s.Name = fmt.Sprintf("%d", idx+1)
+	}
+
+	if s.URL == "" {
+		return nil, fmt.Errorf("driver/http: step %s: no url specified", s.Name)
+	}
+
+	s.Method = strings.ToUpper(s.Method)
+	if s.Method == "" {
+		s.Method = http.MethodGet
+	}
+
+	if c.Expect.MaxDuration != "" {
+		if s.maxDuration, err = time.ParseDuration(c.Expect.MaxDuration); err != nil {
+			return nil, fmt.Errorf("driver/http: step %s: invalid max_duration: %w", s.Name, err)
+		}
+	}
+
+	s.headers = make(map[string]*regexp.Regexp, len(c.Expect.Headers))
+	for name, expr := range c.Expect.Headers {
+		if s.headers[name], err = regexp.Compile(expr); err != nil {
+			return nil, fmt.Errorf("driver/http: step %s: invalid expression for header %s: %w", s.Name, name, err)
+		}
+	}
+
+	for _, expr := range c.Expect.Body {
+		rx, err := regexp.Compile(expr)
+		if err != nil {
+			return nil, fmt.Errorf("driver/http: step %s: invalid body expression: %w", s.Name, err)
+		}
+		s.body = append(s.body, rx)
+	}
+
+	s.captures = make(map[string]*regexp.Regexp)
+	for name, capture := range c.Capture {
+		sources := 0
+		for _, v := range []string{capture.JSON, capture.Header, capture.Regex} {
+			if v != "" {
+				sources++
+			}
+		}
+
+		if sources != 1 {
+			return nil, fmt.Errorf("driver/http: step %s: capture %s must have one of json, header or regex", s.Name, name)
+		}
+
+		if capture.Regex != "" {
+			if s.captures[name], err = regexp.Compile(capture.Regex); err != nil {
+				return nil, fmt.Errorf("driver/http: step %s: invalid expression for capture %s: %w", s.Name, name, err)
+			}
+		}
+	}
+
+	return s, nil
+}
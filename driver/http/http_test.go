@@ -0,0 +1,181 @@
+package httpd
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Unbabel/replicant/driver/declarative/tests"
+	"github.com/Unbabel/replicant/secret"
+)
+
+func newServer() (s *httptest.Server) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/login", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Request-Id", "req-1")
+		http.SetCookie(w, &http.Cookie{Name: "session", Value: "s3ss10n"})
+		w.Write([]byte(`{"token": "t0k3n", "user": {"id": 42, "roles": ["admin"]}}`))
+	})
+
+	mux.HandleFunc("/orders", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer t0k3n" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"orders": [{"id": "a1", "total": 10.5}], "owner": ` + r.URL.Query().Get("user") + `}`))
+	})
+
+	mux.HandleFunc("/slow", func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(50 * time.Millisecond)
+		w.Write([]byte("done"))
+	})
+
+	return httptest.NewServer(mux)
+}
+
+func TestDriverTransaction(t *testing.T) {
+	server := newServer()
+	defer server.Close()
+
+	cases := []tests.Case{
+		{Name: "chained steps", Script: `
+- name: login
+  method: post
+  url: ` + server.URL + `/login
+  body: '{"user": "joi"}'
+  expect:
+    status: 200
+    headers:
+      Content-Type: ^application/json
+    json:
+      $.user.roles[0]: admin
+  capture:
+    token:
+      json: $.token
+    user:
+      json: user.id
+- name: orders
+  url: ` + server.URL + `/orders?user=${user}
+  headers:
+    Authorization: Bearer ${token}
+  expect:
+    json:
+      $.orders[0].id: a1
+      $.orders[0].total: 10.5
+      owner: 42
+    body:
+      - '"id":\s*"a1"'
+    max_duration: 5s`},
+		{Name: "status", Failed: true, Message: "step 1: status is 401, expected 2xx", Script: `
+- url: ` + server.URL + `/orders`},
+		{Name: "json value", Failed: true, Message: "step login: json $.token is t0k3n, expected nope", Script: `
+- name: login
+  method: POST
+  url: ` + server.URL + `/login
+  expect:
+    json:
+      $.token: nope`},
+		{Name: "header", Failed: true, Message: `step 1: header X-Request-Id is "req-1", expected to match ^req-2$`, Script: `
+- method: POST
+  url: ` + server.URL + `/login
+  expect:
+    headers:
+      X-Request-Id: ^req-2$`},
+		{Name: "max duration", Failed: true, Script: `
+- url: ` + server.URL + `/slow
+  expect:
+    max_duration: 1ms`},
+		{Name: "capture regex", Script: `
+- method: POST
+  url: ` + server.URL + `/login
+  capture:
+    token:
+      regex: '"token":\s*"(\w+)"'
+- url: ` + server.URL + `/orders?user=1
+  headers:
+    Authorization: Bearer ${token}`},
+		{Name: "undefined variable", Failed: true, Error: true, Message: "step 1: undefined variable token", Script: `
+- url: ` + server.URL + `/orders?token=${token}`},
+	}
+
+	d, err := New()
+	if err != nil {
+		t.Fatalf("error creating driver: %s", err)
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			result := tests.Run(t, d, tt)
+
+			var reports []report
+			if err := json.Unmarshal([]byte(result.Data), &reports); err != nil || len(reports) == 0 {
+				t.Fatalf("Run() data = %s, want step reports", result.Data)
+			}
+
+			if last := reports[len(reports)-1]; tt.Failed && last.Failure == "" && last.Error == "" {
+				t.Fatalf("Run() last step report has no failure: %#v", last)
+			}
+		})
+	}
+}
+
+func TestDriverRedactReports(t *testing.T) {
+	server := newServer()
+	defer server.Close()
+
+	d, _ := New()
+	result := tests.Run(t, d, tests.Case{Name: "redact", Script: `
+- method: POST
+  url: ` + server.URL + `/login
+  capture:
+    token:
+      json: $.token
+      secret: true
+    user:
+      json: $.user.id
+- url: ` + server.URL + `/orders?user=${user}
+  headers:
+    Authorization: Bearer ${token}`})
+
+	// session cookies and secret captures are not reported
+	for _, value := range []string{"s3ss10n", "t0k3n"} {
+		if strings.Contains(result.Data, value) {
+			t.Fatalf("Run() data = %s, contains %s", result.Data, value)
+		}
+	}
+
+	var reports []report
+	if err := json.Unmarshal([]byte(result.Data), &reports); err != nil {
+		t.Fatalf("Run() data = %s, want step reports", result.Data)
+	}
+
+	if reports[0].Headers["Set-Cookie"] != secret.Redacted || reports[0].Headers["X-Request-Id"] != "req-1" {
+		t.Fatalf("Run() headers = %v, want redacted cookies", reports[0].Headers)
+	}
+
+	// other captures are kept
+	if !strings.HasSuffix(reports[1].URL, "/orders?user=42") || !strings.Contains(reports[1].Body, `"owner": 42`) {
+		t.Fatalf("Run() report = %#v, want visible user capture", reports[1])
+	}
+}
+
+func TestDriverNewInvalid(t *testing.T) {
+	d, _ := New()
+	tests.NewInvalid(t, d, map[string]string{
+		"no steps":                  `[]`,
+		"no url":                    `- method: GET`,
+		"invalid header expression": "- url: http://x\n  expect:\n    headers:\n      A: '('",
+		"invalid duration":          "- url: http://x\n  expect:\n    max_duration: fast",
+		"capture without source":    "- url: http://x\n  capture:\n    a: {}",
+		"capture with many sources": "- url: http://x\n  capture:\n    a: {json: $.a, header: A}",
+	})
+}
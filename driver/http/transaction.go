@@ -0,0 +1,324 @@
+package httpd
+
+/*
+   Copyright 2019 Bruno Moura <brunotm@gmail.com>
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/cookiejar"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Unbabel/replicant/driver/declarative"
+	"github.com/Unbabel/replicant/internal/jsonpath"
+	"github.com/Unbabel/replicant/secret"
+	"github.com/Unbabel/replicant/transaction"
+)
+
+const (
+	// maxBodySize read from responses
+	maxBodySize = 10 << 20
+
+	// maxReportBodySize of response bodies included in step reports
+	maxReportBodySize = 4 << 10
+)
+
+// variable references in steps, eg: ${token}
+var variableRx = regexp.MustCompile(`\$\{([^}]+)\}`)
+
+// Transaction is a pre-configured replicant transaction for declarative HTTP checks
+type Transaction struct {
+	declarative.Transaction
+	steps []*step
+}
+
+// report of a step execution, included in the result data
+type report struct {
+	Name       string            `json:"name"`
+	Method     string            `json:"method"`
+	URL        string            `json:"url"`
+	Status     int               `json:"status"`
+	DurationMs int64             `json:"duration_ms"`
+	Headers    map[string]string `json:"headers,omitempty"`
+	Body       string            `json:"body,omitempty"`
+	Failure    string            `json:"failure,omitempty"`
+	Error      string            `json:"error,omitempty"`
+}
+
+// Run executes the transaction steps in order, stopping on the first failure
+func (t *Transaction) Run(ctx context.Context) (result transaction.Result) {
+	result = t.Result()
+
+	ctx, cancel := t.Context(ctx, 0)
+	defer cancel()
+
+	// steps share cookies, eg: for sessions created in a login step
+	jar, _ := cookiejar.New(nil)
+	client := &http.Client{Jar: jar}
+
+	vars := make(map[string]string)
+	var reports []report
+	defer func() {
+		// secret captured values, eg: session tokens, are not reported
+		var values []string
+		for _, s := range t.steps {
+			for name, capture := range s.Capture {
+				if v, ok := vars[name]; ok && capture.Secret {
+					values = append(values, v)
+				}
+			}
+		}
+
+		for x := range reports {
+			reports[x].redact(values)
+		}
+		result.Message = secret.Redact(result.Message, values)
+
+		buf, _ := json.Marshal(reports)
+		result.Data = string(buf)
+	}()
+
+	for _, s := range t.steps {
+		r, err := s.run(ctx, client, vars)
+		reports = append(reports, r)
+
+		if err != nil {
+			result.Failed = true
+			result.Error = fmt.Errorf("driver/http: step %s: %w", s.Name, err)
+			result.Message = fmt.Sprintf("step %s: %s", s.Name, err)
+			return result
+		}
+
+		if r.Failure != "" {
+			result.Failed = true
+			result.Message = fmt.Sprintf("step %s: %s", s.Name, r.Failure)
+			return result
+		}
+	}
+
+	result.Message = fmt.Sprintf("%d steps passed", len(t.steps))
+	return result
+}
+
+// run the step with the given captured variables, adding the values it captures.
+// Errors are returned for requests that could not be made, while failed assertions
+// are reported in the step report.
+func (s *step) run(ctx context.Context, client *http.Client, vars map[string]string) (r report, err error) {
+	r.Name = s.Name
+	r.Method = s.Method
+
+	if r.URL, err = expand(s.URL, vars); err != nil {
+		r.Error = err.Error()
+		return r, err
+	}
+
+	body, err := expand(s.Body, vars)
+	if err != nil {
+		r.Error = err.Error()
+		return r, err
+	}
+
+	var reader io.Reader
+	if body != "" {
+		reader = strings.NewReader(body)
+	}
+
+	req, err := http.NewRequest(s.Method, r.URL, reader)
+	if err != nil {
+		r.Error = err.Error()
+		return r, err
+	}
+	req = req.WithContext(ctx)
+
+	for name, value := range s.Headers {
+		if value, err = expand(value, vars); err != nil {
+			r.Error = err.Error()
+			return r, err
+		}
+		req.Header.Set(name, value)
+	}
+
+	start := time.Now()
+	resp, err := client.Do(req)
+	if err != nil {
+		r.Error = err.Error()
+		return r, err
+	}
+	defer resp.Body.Close()
+
+	buf, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxBodySize))
+	duration := time.Since(start)
+	if err != nil {
+		r.Error = err.Error()
+		return r, err
+	}
+
+	r.Status = resp.StatusCode
+	r.DurationMs = duration.Milliseconds()
+	r.Headers = make(map[string]string, len(resp.Header))
+	for name := range resp.Header {
+		r.Headers[name] = resp.Header.Get(name)
+		if secret.SensitiveHeader(name) {
+			r.Headers[name] = secret.Redacted
+		}
+	}
+
+	r.Body = string(buf)
+	if len(r.Body) > maxReportBodySize {
+		r.Body = r.Body[:maxReportBodySize] + "..."
+	}
+
+	r.Failure = s.check(resp, buf, duration, vars)
+	return r, nil
+}
+
+// redact the given values from the report
+func (r *report) redact(values []string) {
+	r.URL = secret.Redact(r.URL, values)
+	r.Body = secret.Redact(r.Body, values)
+	r.Failure = secret.Redact(r.Failure, values)
+	r.Error = secret.Redact(r.Error, values)
+	for name, value := range r.Headers {
+		r.Headers[name] = secret.Redact(value, values)
+	}
+}
+
+// check the step assertions and capture values from the response,
+// returning a description of the first failure
+func (s *step) check(resp *http.Response, body []byte, duration time.Duration, vars map[string]string) (failure string) {
+	switch {
+	case s.Expect.Status == 0 && (resp.StatusCode < 200 || resp.StatusCode > 299):
+		return fmt.Sprintf("status is %d, expected 2xx", resp.StatusCode)
+	case s.Expect.Status != 0 && resp.StatusCode != s.Expect.Status:
+		return fmt.Sprintf("status is %d, expected %d", resp.StatusCode, s.Expect.Status)
+	}
+
+	for _, name := range sortedKeys(s.headers) {
+		if value := resp.Header.Get(name); !s.headers[name].MatchString(value) {
+			return fmt.Sprintf("header %s is %q, expected to match %s", name, value, s.headers[name])
+		}
+	}
+
+	for _, rx := range s.body {
+		if !rx.Match(body) {
+			return fmt.Sprintf("body does not match %s", rx)
+		}
+	}
+
+	// decode json bodies only when needed
+	var data interface{}
+	var decodeErr error
+	decode := func() (interface{}, error) {
+		if data == nil && decodeErr == nil {
+			data, decodeErr = jsonpath.Decode(body)
+		}
+		return data, decodeErr
+	}
+
+	for _, path := range sortedKeys(s.Expect.JSON) {
+		d, err := decode()
+		if err != nil {
+			return fmt.Sprintf("error decoding json body: %s", err)
+		}
+
+		value, ok := jsonpath.Lookup(d, path)
+		if !ok {
+			return fmt.Sprintf("json %s not found", path)
+		}
+
+		if got, want := jsonpath.Format(value), fmt.Sprint(s.Expect.JSON[path]); got != want {
+			return fmt.Sprintf("json %s is %s, expected %s", path, got, want)
+		}
+	}
+
+	if s.maxDuration > 0 && duration > s.maxDuration {
+		return fmt.Sprintf("duration is %s, expected at most %s", duration, s.maxDuration)
+	}
+
+	for _, name := range sortedKeys(s.Capture) {
+		capture := s.Capture[name]
+
+		switch {
+		case capture.JSON != "":
+			d, err := decode()
+			if err != nil {
+				return fmt.Sprintf("capture %s: error decoding json body: %s", name, err)
+			}
+
+			value, ok := jsonpath.Lookup(d, capture.JSON)
+			if !ok {
+				return fmt.Sprintf("capture %s: json %s not found", name, capture.JSON)
+			}
+			vars[name] = jsonpath.Format(value)
+
+		case capture.Header != "":
+			value := resp.Header.Get(capture.Header)
+			if value == "" {
+				return fmt.Sprintf("capture %s: header %s not found", name, capture.Header)
+			}
+			vars[name] = value
+
+		default:
+			m := s.captures[name].FindSubmatch(body)
+			if len(m) < 2 {
+				return fmt.Sprintf("capture %s: body does not match %s", name, s.captures[name])
+			}
+			vars[name] = string(m[1])
+		}
+	}
+
+	return ""
+}
+
+// expand variable references with the captured values
+func expand(s string, vars map[string]string) (e string, err error) {
+	e = variableRx.ReplaceAllStringFunc(s, func(ref string) string {
+		name := variableRx.FindStringSubmatch(ref)[1]
+		value, ok := vars[name]
+		if !ok && err == nil {
+			err = fmt.Errorf("undefined variable %s", name)
+		}
+		return value
+	})
+	return e, err
+}
+
+// sortedKeys returns the keys of the given map in order, for stable failure messages
+func sortedKeys(m interface{}) (keys []string) {
+	switch v := m.(type) {
+	case map[string]*regexp.Regexp:
+		for k := range v {
+			keys = append(keys, k)
+		}
+	case map[string]interface{}:
+		for k := range v {
+			keys = append(keys, k)
+		}
+	case map[string]Capture:
+		for k := range v {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,212 @@
+package javascript
+
+/*
+   Copyright 2019 Bruno Moura <brunotm@gmail.com>
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/Unbabel/replicant/log"
+	"github.com/Unbabel/replicant/transaction"
+	"github.com/robertkrimen/otto"
+)
+
+// Bindings are the native functions backing the replicant javascript object in ReplicantJS,
+// bound to the context of a transaction run. Values are exchanged as strings and json
+// documents so they can be registered in any javascript engine under the names
+// used by ReplicantJS, eg: replicant_http_do for HTTPDo.
+type Bindings struct {
+	ctx       context.Context
+	http      *httpSession
+	ws        *wsSessions
+	artifacts transaction.Artifacts
+}
+
+// NewBindings creates native functions bound to the given context
+func NewBindings(ctx context.Context) (b *Bindings) {
+	return &Bindings{ctx: ctx, http: newHTTPSession(ctx), ws: newWSSessions(ctx)}
+}
+
+// Log logs a message from a transaction script, registered as replicant_log
+func (b *Bindings) Log(message string) {
+	log.Info(message).String("driver", "javascript").Log()
+}
+
+// Sleep for the given milliseconds or until the context is done, registered as replicant_sleep
+func (b *Bindings) Sleep(ms int64) {
+	log.Debug("sleeping").String("driver", "javascript").Int("milliseconds", ms).Log()
+
+	timer := time.NewTimer(time.Duration(ms) * time.Millisecond)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+	case <-b.ctx.Done():
+	}
+}
+
+// HTTPDo performs the json encoded request and returns the json encoded response,
+// registered as replicant_http_do
+func (b *Bindings) HTTPDo(request string) (response string) {
+	var resp *httpResponse
+	hro := httpRequest{}
+
+	switch {
+	case request == "" || request == "undefined":
+		resp = &httpResponse{Error: fmt.Errorf("no http request was specified")}
+	default:
+		if err := json.Unmarshal([]byte(request), &hro); err != nil {
+			resp = &httpResponse{Error: fmt.Errorf("error deserializing request: %w", err)}
+			break
+		}
+		resp = b.http.do(hro)
+	}
+
+	buf, _ := json.Marshal(resp)
+	return string(buf)
+}
+
+// WSConnect opens a websocket with the json encoded headers, registered as replicant_ws_connect
+func (b *Bindings) WSConnect(url, headers string) (response string) {
+	header := http.Header{}
+	h := map[string]string{}
+
+	if headers != "" && headers != "undefined" {
+		if err := json.Unmarshal([]byte(headers), &h); err != nil {
+			return wsJSON(wsResponse{Error: fmt.Sprintf("error deserializing headers: %s", err)})
+		}
+	}
+
+	for k, v := range h {
+		header.Set(k, v)
+	}
+
+	return wsJSON(b.ws.connect(url, header))
+}
+
+// WSSend sends a text message, registered as replicant_ws_send
+func (b *Bindings) WSSend(id int64, data string) (response string) {
+	return wsJSON(b.ws.send(int(id), data))
+}
+
+// WSReceive receives the next message waiting at most the given milliseconds, or until
+// the context is done if 0, registered as replicant_ws_receive
+func (b *Bindings) WSReceive(id, ms int64) (response string) {
+	return wsJSON(b.ws.receive(int(id), time.Duration(ms)*time.Millisecond))
+}
+
+// WSClose closes the websocket, registered as replicant_ws_close
+func (b *Bindings) WSClose(id int64) (response string) {
+	return wsJSON(b.ws.close(int(id)))
+}
+
+// AddArtifact attaches an artifact to the transaction result, registered as replicant_add_artifact.
+// Data is decoded from base64 if the encoding is base64. An error message is returned on failure.
+func (b *Bindings) AddArtifact(name, contentType, data, encoding string) (err string) {
+	buf := []byte(data)
+
+	switch encoding {
+	case "", "undefined", "text":
+	case "base64":
+		var e error
+		if buf, e = base64.StdEncoding.DecodeString(data); e != nil {
+			return fmt.Sprintf("error decoding artifact %s: %s", name, e)
+		}
+	default:
+		return fmt.Sprintf("unsupported artifact encoding: %s", encoding)
+	}
+
+	if contentType == "undefined" {
+		contentType = ""
+	}
+
+	if e := b.artifacts.Add(name, contentType, buf); e != nil {
+		return e.Error()
+	}
+	return ""
+}
+
+// Artifacts returns the artifacts attached by the transaction run
+func (b *Bindings) Artifacts() (artifacts []transaction.Artifact) {
+	return b.artifacts.List()
+}
+
+// Close releases the connections left open by the transaction run
+func (b *Bindings) Close() {
+	b.ws.closeAll()
+	b.http.close()
+}
+
+// register the bindings in the given otto vm
+func (b *Bindings) register(vm *otto.Otto) (err error) {
+	functions := map[string]func(call otto.FunctionCall) string{
+		"replicant_http_do": func(call otto.FunctionCall) string {
+			return b.HTTPDo(call.Argument(0).String())
+		},
+		"replicant_ws_connect": func(call otto.FunctionCall) string {
+			return b.WSConnect(call.Argument(0).String(), call.Argument(1).String())
+		},
+		"replicant_ws_send": func(call otto.FunctionCall) string {
+			id, _ := call.Argument(0).ToInteger()
+			return b.WSSend(id, call.Argument(1).String())
+		},
+		"replicant_ws_receive": func(call otto.FunctionCall) string {
+			id, _ := call.Argument(0).ToInteger()
+			ms, _ := call.Argument(1).ToInteger()
+			return b.WSReceive(id, ms)
+		},
+		"replicant_ws_close": func(call otto.FunctionCall) string {
+			id, _ := call.Argument(0).ToInteger()
+			return b.WSClose(id)
+		},
+		"replicant_add_artifact": func(call otto.FunctionCall) string {
+			return b.AddArtifact(call.Argument(0).String(), call.Argument(1).String(),
+				call.Argument(2).String(), call.Argument(3).String())
+		},
+	}
+
+	for name, fn := range functions {
+		fn := fn
+		err = vm.Set(name, func(call otto.FunctionCall) otto.Value {
+			v, _ := vm.ToValue(fn(call))
+			return v
+		})
+		if err != nil {
+			return fmt.Errorf("driver/javascript: error setting %s: %w", name, err)
+		}
+	}
+
+	err = vm.Set("replicant_sleep", func(call otto.FunctionCall) otto.Value {
+		ms, _ := call.Argument(0).ToInteger()
+		b.Sleep(ms)
+		return otto.Value{}
+	})
+	if err != nil {
+		return fmt.Errorf("driver/javascript: error setting replicant_sleep: %w", err)
+	}
+
+	return nil
+}
+
+func wsJSON(r wsResponse) (response string) {
+	buf, _ := json.Marshal(&r)
+	return string(buf)
+}
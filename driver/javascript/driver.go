@@ -2,19 +2,14 @@
 package javascript
 
 import (
-	"crypto/tls"
 	"encoding/json"
 	"fmt"
-	"io"
-	"io/ioutil"
-	"net/http"
-	"net/url"
-	"strings"
 	"time"
 
 	"github.com/Unbabel/replicant/log"
 	"github.com/Unbabel/replicant/transaction"
 	"github.com/robertkrimen/otto"
+	"github.com/robertkrimen/otto/parser"
 )
 
 // Driver for javascript language based transactions
@@ -36,116 +31,8 @@ func New() (d *Driver, err error) {
 		return nil, fmt.Errorf("driver/javascript: error setting replicant_log: %w", err)
 	}
 
-	// add sleep to js vm
-	err = d.vm.Set("replicant_sleep", func(call otto.FunctionCall) otto.Value {
-		ms, _ := call.Argument(0).ToInteger()
-		log.Debug("sleeping").String("driver", "javascript").Int("milliseconds", ms).Log()
-		time.Sleep(time.Duration(ms) * time.Millisecond)
-		return otto.Value{}
-	})
-	if err != nil {
-		return nil, fmt.Errorf("driver/javascript: error setting replicant_sleep: %w", err)
-	}
-
-	// add http request capabilities to js vm
-	err = d.vm.Set("replicant_http_do", func(call otto.FunctionCall) otto.Value {
-		jsonHRO := call.Argument(0).String()
-		if jsonHRO == "undefined" {
-			r, _ := d.toJSvalue(&httpResponse{Error: fmt.Errorf("no http request was specified")})
-			return r
-		}
-		hro := httpRequest{}
-
-		if err := json.Unmarshal([]byte(jsonHRO), &hro); err != nil {
-			r, _ := d.toJSvalue(&httpResponse{Error: fmt.Errorf("error deserializing request: %w", err)})
-			return r
-		}
-
-		// handle form data if specified
-		formData := url.Values{}
-		for k, v := range hro.FormData {
-			formData.Set(k, v)
-		}
-
-		// handle request body if specified
-		// if both are specified request body have precedence
-		var body io.Reader
-		if hro.Body != "" {
-			body = strings.NewReader(hro.Body)
-		} else if len(hro.FormData) > 0 {
-			body = strings.NewReader(formData.Encode())
-		}
-
-		u, err := url.ParseRequestURI(hro.URL)
-		if err != nil {
-			r, _ := d.toJSvalue(&httpResponse{Error: fmt.Errorf("error parsing request url: %w", err)})
-			return r
-		}
-
-		// handle url query parameters
-		if len(hro.Params) > 0 {
-			q, _ := url.ParseQuery(u.RawQuery)
-			for k, v := range hro.Params {
-				q.Add(k, v)
-			}
-			u.RawQuery = q.Encode()
-		}
-
-		req, err := http.NewRequest(hro.Method, u.String(), body)
-		if err != nil {
-			r, _ := d.toJSvalue(&httpResponse{Error: fmt.Errorf("error creating http request: %w", err)})
-			return r
-		}
-		req.Close = true
-
-		for k, v := range hro.Header {
-			req.Header.Set(k, v)
-		}
-
-		tr := &http.Transport{TLSClientConfig: &tls.Config{}}
-		if hro.SSLSkipVerify {
-			tr.TLSClientConfig.InsecureSkipVerify = true
-		}
-
-		client := &http.Client{Transport: tr}
-		defer client.CloseIdleConnections()
-
-		resp, err := client.Do(req)
-		log.Debug("http request").String("url", hro.URL).
-			String("method", hro.Method).Bool("skip_ssl_verify", hro.SSLSkipVerify).
-			String("status", resp.Status).Error("error", err).Log()
-
-		if err != nil {
-			r, _ := d.toJSvalue(&httpResponse{Error: fmt.Errorf("error performing request: %w", err)})
-			return r
-		}
-		defer resp.Body.Close()
-
-		jsResp := httpResponse{}
-		jsResp.Status = resp.Status
-		jsResp.StatusCode = resp.StatusCode
-		jsResp.Protocol = resp.Request.Proto
-		jsResp.Header = make(map[string]string)
-		for k, v := range resp.Header {
-			jsResp.Header[k] = v[0]
-		}
-
-		b, err := ioutil.ReadAll(resp.Body)
-		if err != nil {
-			r, _ := d.toJSvalue(&httpResponse{Error: fmt.Errorf("error reading response body: %w", err)})
-			return r
-		}
-		jsResp.Body = string(b)
-
-		r, _ := d.toJSvalue(jsResp)
-		return r
-	})
-	if err != nil {
-		return nil, fmt.Errorf("driver/javascript: error setting replicant_http_do: %w", err)
-	}
-
 	// load replicant javascript utils
-	if _, err = d.vm.Run(replicantJS); err != nil {
+	if _, err = d.vm.Run(ReplicantJS); err != nil {
 		return nil, fmt.Errorf("driver/javascript: error initializing replicant core objects: %w", err)
 	}
 
@@ -161,6 +48,13 @@ func (d *Driver) Type() (t string) {
 func (d *Driver) New(config transaction.Config) (tx transaction.Transaction, err error) {
 	txn := &Transaction{}
 	txn.config = config
+	txn.timeout = maxTimeout
+
+	if config.Timeout != "" {
+		if txn.timeout, err = time.ParseDuration(config.Timeout); err != nil {
+			return nil, fmt.Errorf("driver/javascript: error parsing timeout: %w", err)
+		}
+	}
 
 	txn.vm = d.vm.Copy()
 	if _, err = txn.vm.Run(config.Script); err != nil {
@@ -176,31 +70,50 @@ func (d *Driver) New(config transaction.Config) (tx transaction.Transaction, err
 	return txn, nil
 }
 
-func (d *Driver) toJSvalue(v interface{}) (o otto.Value, err error) {
-	b, err := json.Marshal(v)
-	if err != nil {
-		return o, err
+// Check parses the transaction and callback scripts without running them
+func (d *Driver) Check(config transaction.Config) (err error) {
+	if config.Timeout != "" {
+		if _, err = time.ParseDuration(config.Timeout); err != nil {
+			return fmt.Errorf("driver/javascript: error parsing timeout: %w", err)
+		}
+	}
+
+	if _, err = parser.ParseFile(nil, config.Name, config.Script, 0); err != nil {
+		return fmt.Errorf("driver/javascript: error parsing transaction script: %w", err)
 	}
 
-	return d.vm.ToValue(string(b))
+	if config.CallBack != nil {
+		if _, err = parser.ParseFile(nil, config.Name+"-callback", config.CallBack.Script, 0); err != nil {
+			return fmt.Errorf("driver/javascript: error parsing callback handling script: %w", err)
+		}
+	}
+
+	return nil
 }
 
 type httpRequest struct {
-	URL           string            `json:"URL"`
-	Method        string            `json:"Method"`
-	Body          string            `json:"Body"`
-	Header        map[string]string `json:"Header"`
-	Params        map[string]string `json:"Params"`
-	FormData      map[string]string `json:"FormData"`
-	SSLSkipVerify bool              `json:"SSLSkipVerify"`
+	URL             string            `json:"URL"`
+	Method          string            `json:"Method"`
+	Body            string            `json:"Body"`
+	Header          map[string]string `json:"Header"`
+	Params          map[string]string `json:"Params"`
+	FormData        map[string]string `json:"FormData"`
+	SSLSkipVerify   bool              `json:"SSLSkipVerify"`
+	FollowRedirects *bool             `json:"FollowRedirects"`
+	ClientCert      string            `json:"ClientCert"`
+	ClientKey       string            `json:"ClientKey"`
+	Proxy           string            `json:"Proxy"`
 }
 type httpResponse struct {
-	Status     string            `json:"Status"`
-	StatusCode int               `json:"StatusCode"`
-	Protocol   string            `json:"Protocol"`
-	Body       string            `json:"Body"`
-	Header     map[string]string `json:"Header"`
-	Error      error             `json:"Error"`
+	URL          string              `json:"URL"`
+	Status       string              `json:"Status"`
+	StatusCode   int                 `json:"StatusCode"`
+	Protocol     string              `json:"Protocol"`
+	Body         string              `json:"Body"`
+	Header       map[string]string   `json:"Header"`
+	HeaderValues map[string][]string `json:"HeaderValues"`
+	Timings      *httpTimings        `json:"Timings"`
+	Error        error               `json:"Error"`
 }
 
 type jsResult struct {
@@ -247,7 +160,9 @@ func (r *httpResponse) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-const replicantJS = `
+// ReplicantJS is the javascript source of the replicant object exposed to transaction scripts.
+// It is backed by the native functions in Bindings.
+const ReplicantJS = `
 var replicant = {};
 replicant.http = {};
 
@@ -275,6 +190,10 @@ replicant.http.NewRequest = function () {
 		Params: {},
 		FormData: {},
 		SSLSkipVerify: false,
+		FollowRedirects: true,
+		ClientCert: "",
+		ClientKey: "",
+		Proxy: "",
 		JSON: function () {
 			return JSON.stringify(this)
 		}
@@ -290,7 +209,31 @@ replicant.http.Do = function (request) {
 	return r;
 };
 
+replicant.ws = {};
+
+replicant.ws.Connect = function (url, headers) {
+	var r = JSON.parse(replicant_ws_connect(url, JSON.stringify(headers || {})));
+	var id = r.ID;
+	return {
+		Status: r.Status,
+		Error: r.Error,
+		Send: function (data) {
+			return JSON.parse(replicant_ws_send(id, data)).Error;
+		},
+		Receive: function (timeoutMs) {
+			return JSON.parse(replicant_ws_receive(id, timeoutMs || 0));
+		},
+		Close: function () {
+			return JSON.parse(replicant_ws_close(id)).Error;
+		}
+	};
+};
+
 replicant.Sleep = function(milliseconds) {
   replicant_sleep(milliseconds)
 };
+
+replicant.AddArtifact = function (name, contentType, data, encoding) {
+	return replicant_add_artifact(name, contentType || "text/plain", data, encoding || "text");
+};
 `
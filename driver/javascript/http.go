@@ -0,0 +1,259 @@
+package javascript
+
+/*
+   Copyright 2019 Bruno Moura <brunotm@gmail.com>
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptrace"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Unbabel/replicant/log"
+)
+
+// transportKey identifies the transports with the same connection settings
+type transportKey struct {
+	skipVerify bool
+	proxy      string
+	cert       string
+	key        string
+}
+
+// httpSession is the http client state shared by the requests performed
+// during a transaction run: cookies and reusable connections
+type httpSession struct {
+	ctx        context.Context
+	jar        http.CookieJar
+	mtx        sync.Mutex
+	transports map[transportKey]*http.Transport
+}
+
+func newHTTPSession(ctx context.Context) (s *httpSession) {
+	// cookiejar.New only fails with invalid options
+	jar, _ := cookiejar.New(nil)
+	return &httpSession{ctx: ctx, jar: jar, transports: make(map[transportKey]*http.Transport)}
+}
+
+// do performs the http request
+func (s *httpSession) do(hro httpRequest) (jsResp *httpResponse) {
+	// handle form data if specified
+	formData := url.Values{}
+	for k, v := range hro.FormData {
+		formData.Set(k, v)
+	}
+
+	// handle request body if specified
+	// if both are specified request body have precedence
+	var body io.Reader
+	if hro.Body != "" {
+		body = strings.NewReader(hro.Body)
+	} else if len(hro.FormData) > 0 {
+		body = strings.NewReader(formData.Encode())
+	}
+
+	u, err := url.ParseRequestURI(hro.URL)
+	if err != nil {
+		return &httpResponse{Error: fmt.Errorf("error parsing request url: %w", err)}
+	}
+
+	// handle url query parameters
+	if len(hro.Params) > 0 {
+		q, _ := url.ParseQuery(u.RawQuery)
+		for k, v := range hro.Params {
+			q.Add(k, v)
+		}
+		u.RawQuery = q.Encode()
+	}
+
+	tr, err := s.transport(hro)
+	if err != nil {
+		return &httpResponse{Error: err}
+	}
+
+	timings := &httpTimings{}
+	ctx := httptrace.WithClientTrace(s.ctx, timings.trace())
+
+	req, err := http.NewRequestWithContext(ctx, hro.Method, u.String(), body)
+	if err != nil {
+		return &httpResponse{Error: fmt.Errorf("error creating http request: %w", err)}
+	}
+
+	for k, v := range hro.Header {
+		req.Header.Set(k, v)
+	}
+
+	client := &http.Client{Transport: tr, Jar: s.jar}
+	if hro.FollowRedirects != nil && !*hro.FollowRedirects {
+		client.CheckRedirect = func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		}
+	}
+
+	timings.start = time.Now()
+	resp, err := client.Do(req)
+	if err != nil {
+		log.Debug("http request").String("url", hro.URL).
+			String("method", hro.Method).Bool("skip_ssl_verify", hro.SSLSkipVerify).
+			Error("error", err).Log()
+		return &httpResponse{Error: fmt.Errorf("error performing request: %w", err)}
+	}
+	defer resp.Body.Close()
+
+	log.Debug("http request").String("url", hro.URL).
+		String("method", hro.Method).Bool("skip_ssl_verify", hro.SSLSkipVerify).
+		String("status", resp.Status).Log()
+
+	jsResp = &httpResponse{}
+	jsResp.URL = resp.Request.URL.String()
+	jsResp.Status = resp.Status
+	jsResp.StatusCode = resp.StatusCode
+	jsResp.Protocol = resp.Request.Proto
+	jsResp.Header = make(map[string]string)
+	jsResp.HeaderValues = resp.Header
+	for k, v := range resp.Header {
+		jsResp.Header[k] = v[0]
+	}
+
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return &httpResponse{Error: fmt.Errorf("error reading response body: %w", err)}
+	}
+	jsResp.Body = string(b)
+	jsResp.Timings = timings.done()
+
+	return jsResp
+}
+
+// transport returns a transport for the request connection settings,
+// reusing the ones created earlier in this session
+func (s *httpSession) transport(hro httpRequest) (tr *http.Transport, err error) {
+	key := transportKey{skipVerify: hro.SSLSkipVerify, proxy: hro.Proxy, cert: hro.ClientCert, key: hro.ClientKey}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if tr, ok := s.transports[key]; ok {
+		return tr, nil
+	}
+
+	tr = &http.Transport{TLSClientConfig: &tls.Config{}}
+	tr.TLSClientConfig.InsecureSkipVerify = hro.SSLSkipVerify
+
+	if hro.ClientCert != "" || hro.ClientKey != "" {
+		cert, err := tls.X509KeyPair([]byte(hro.ClientCert), []byte(hro.ClientKey))
+		if err != nil {
+			return nil, fmt.Errorf("error loading client certificate: %w", err)
+		}
+		tr.TLSClientConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	if hro.Proxy != "" {
+		proxy, err := url.Parse(hro.Proxy)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing proxy url: %w", err)
+		}
+		tr.Proxy = http.ProxyURL(proxy)
+	}
+
+	s.transports[key] = tr
+	return tr, nil
+}
+
+// close the idle connections left by this session
+func (s *httpSession) close() {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	for _, tr := range s.transports {
+		tr.CloseIdleConnections()
+	}
+}
+
+// httpTimings is the timing breakdown of a request in milliseconds.
+// DNS, Connect and TLS are zero for reused connections and are summed across redirects.
+type httpTimings struct {
+	DNS       float64 `json:"DNS"`
+	Connect   float64 `json:"Connect"`
+	TLS       float64 `json:"TLS"`
+	FirstByte float64 `json:"FirstByte"`
+	Total     float64 `json:"Total"`
+
+	mtx          sync.Mutex
+	start        time.Time
+	dnsStart     time.Time
+	connectStart time.Time
+	tlsStart     time.Time
+}
+
+func (t *httpTimings) trace() (trace *httptrace.ClientTrace) {
+	return &httptrace.ClientTrace{
+		DNSStart: func(httptrace.DNSStartInfo) {
+			t.mtx.Lock()
+			t.dnsStart = time.Now()
+			t.mtx.Unlock()
+		},
+		DNSDone: func(httptrace.DNSDoneInfo) {
+			t.mtx.Lock()
+			t.DNS += milliseconds(time.Since(t.dnsStart))
+			t.mtx.Unlock()
+		},
+		ConnectStart: func(string, string) {
+			t.mtx.Lock()
+			t.connectStart = time.Now()
+			t.mtx.Unlock()
+		},
+		ConnectDone: func(string, string, error) {
+			t.mtx.Lock()
+			t.Connect += milliseconds(time.Since(t.connectStart))
+			t.mtx.Unlock()
+		},
+		TLSHandshakeStart: func() {
+			t.mtx.Lock()
+			t.tlsStart = time.Now()
+			t.mtx.Unlock()
+		},
+		TLSHandshakeDone: func(tls.ConnectionState, error) {
+			t.mtx.Lock()
+			t.TLS += milliseconds(time.Since(t.tlsStart))
+			t.mtx.Unlock()
+		},
+		GotFirstResponseByte: func() {
+			t.mtx.Lock()
+			t.FirstByte = milliseconds(time.Since(t.start))
+			t.mtx.Unlock()
+		},
+	}
+}
+
+func (t *httpTimings) done() (timings *httpTimings) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+	t.Total = milliseconds(time.Since(t.start))
+	return t
+}
+
+func milliseconds(d time.Duration) (ms float64) {
+	return float64(d) / float64(time.Millisecond)
+}
@@ -0,0 +1,190 @@
+package tests
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Unbabel/replicant/driver"
+	"github.com/Unbabel/replicant/transaction"
+)
+
+// newClientCert generates a self signed client certificate and key in pem format
+func newClientCert(t *testing.T) (cert, key string) {
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("error generating key: %s", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "replicant"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &priv.PublicKey, priv)
+	if err != nil {
+		t.Fatalf("error creating certificate: %s", err)
+	}
+
+	keyDER, err := x509.MarshalECPrivateKey(priv)
+	if err != nil {
+		t.Fatalf("error marshaling key: %s", err)
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}))
+}
+
+// jsString quotes the value as a javascript string literal
+func jsString(v string) (s string) {
+	b, _ := json.Marshal(v)
+	return string(b)
+}
+
+// DriverHTTPSession test
+func DriverHTTPSession(d driver.Driver) func(t *testing.T) {
+	return func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.HandleFunc("/login", func(w http.ResponseWriter, r *http.Request) {
+			http.SetCookie(w, &http.Cookie{Name: "session", Value: "deckard", Path: "/"})
+			w.Header().Add("X-Replicant", "nexus-6")
+			w.Header().Add("X-Replicant", "nexus-7")
+		})
+		mux.HandleFunc("/me", func(w http.ResponseWriter, r *http.Request) {
+			c, err := r.Cookie("session")
+			if err != nil {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			w.Write([]byte(c.Value))
+		})
+		mux.HandleFunc("/redirect", func(w http.ResponseWriter, r *http.Request) {
+			http.Redirect(w, r, "/me", http.StatusFound)
+		})
+
+		server := httptest.NewServer(mux)
+		defer server.Close()
+
+		// proxy answering any request
+		proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte("proxied " + r.URL.String()))
+		}))
+		defer proxy.Close()
+
+		// tls server requiring client certificates
+		mtls := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(r.TLS.PeerCertificates[0].Subject.CommonName))
+		}))
+		mtls.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert}
+		mtls.StartTLS()
+		defer mtls.Close()
+
+		cert, key := newClientCert(t)
+
+		tests := []struct {
+			name    string
+			body    string
+			message string
+		}{
+			{name: "cookies", message: "deckard", body: `
+	req = replicant.http.NewRequest()
+	req.Method = "GET"
+	req.URL = "` + server.URL + `/login"
+	replicant.http.Do(req)
+	req.URL = "` + server.URL + `/me"
+	resp = replicant.http.Do(req)
+	rr.Message = resp.Body`},
+			{name: "header values", message: "nexus-6,nexus-7", body: `
+	req = replicant.http.NewRequest()
+	req.Method = "GET"
+	req.URL = "` + server.URL + `/login"
+	resp = replicant.http.Do(req)
+	rr.Message = resp.HeaderValues["X-Replicant"].join(",")`},
+			{name: "follow redirects", message: "200 " + server.URL + "/me", body: `
+	req = replicant.http.NewRequest()
+	req.Method = "GET"
+	req.URL = "` + server.URL + `/login"
+	replicant.http.Do(req)
+	req.URL = "` + server.URL + `/redirect"
+	resp = replicant.http.Do(req)
+	rr.Message = resp.StatusCode + " " + resp.URL`},
+			{name: "no redirects", message: "302 /me", body: `
+	req = replicant.http.NewRequest()
+	req.Method = "GET"
+	req.URL = "` + server.URL + `/redirect"
+	req.FollowRedirects = false
+	resp = replicant.http.Do(req)
+	rr.Message = resp.StatusCode + " " + resp.Header["Location"]`},
+			{name: "timings", message: "true", body: `
+	req = replicant.http.NewRequest()
+	req.Method = "GET"
+	req.URL = "` + mtls.URL + `"
+	req.SSLSkipVerify = true
+	req.ClientCert = ` + jsString(cert) + `
+	req.ClientKey = ` + jsString(key) + `
+	resp = replicant.http.Do(req)
+	t = resp.Timings
+	rr.Message = "" + (t.Connect > 0 && t.TLS > 0 && t.FirstByte >= t.TLS && t.Total >= t.FirstByte)`},
+			{name: "client certificate", message: "replicant", body: `
+	req = replicant.http.NewRequest()
+	req.Method = "GET"
+	req.URL = "` + mtls.URL + `"
+	req.SSLSkipVerify = true
+	req.ClientCert = ` + jsString(cert) + `
+	req.ClientKey = ` + jsString(key) + `
+	resp = replicant.http.Do(req)
+	rr.Message = resp.Body + resp.error`},
+			{name: "invalid client certificate", message: "error loading client certificate", body: `
+	req = replicant.http.NewRequest()
+	req.Method = "GET"
+	req.URL = "` + mtls.URL + `"
+	req.ClientCert = "invalid"
+	resp = replicant.http.Do(req)
+	rr.Message = resp.error`},
+			{name: "proxy", message: "proxied http://replicant.test/", body: `
+	req = replicant.http.NewRequest()
+	req.Method = "GET"
+	req.URL = "http://replicant.test/"
+	req.Proxy = "` + proxy.URL + `"
+	resp = replicant.http.Do(req)
+	rr.Message = resp.Body + resp.error`},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				script := "function Run(ctx) {\n\trr = replicant.NewResult()" + tt.body + "\n\treturn rr.JSON()\n}"
+				txn, err := d.New(transaction.Config{Name: tt.name, Driver: d.Type(), Script: script})
+				if err != nil {
+					t.Fatalf("error creating transaction: %s", err)
+				}
+
+				ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+				defer cancel()
+
+				result := txn.Run(context.WithValue(ctx, "transaction_uuid", "test-test-test"))
+				if result.Error != nil {
+					t.Fatalf("Run() error = %s", result.Error)
+				}
+
+				if !strings.Contains(result.Message, tt.message) {
+					t.Fatalf("Run() message = %s, want %s", result.Message, tt.message)
+				}
+			})
+		}
+	}
+}
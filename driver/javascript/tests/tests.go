@@ -0,0 +1,222 @@
+// Package tests implements the test suite shared by the javascript drivers
+package tests
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Unbabel/replicant/driver"
+	"github.com/Unbabel/replicant/internal/tmpl"
+	"github.com/Unbabel/replicant/transaction"
+)
+
+// Run the javascript driver test suite
+func Run(t *testing.T, d driver.Driver) {
+	t.Run("DriverTransaction", DriverTransaction(d))
+	t.Run("DriverTransactionCancel", DriverTransactionCancel(d))
+	t.Run("DriverArtifacts", DriverArtifacts(d))
+	t.Run("DriverHTTPSession", DriverHTTPSession(d))
+	t.Run("DriverWebSocket", DriverWebSocket(d))
+	t.Run("DriverWebSocketCancel", DriverWebSocketCancel(d))
+}
+
+// NewServer starts a server answering requests with the q=blade runner
+// query parameter and the X-Auth: Joi header
+func NewServer() (server *httptest.Server) {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("q") != "blade runner" {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"reason": "query parameter q not found"}`))
+			return
+		}
+
+		if r.Header.Get("X-Auth") != "Joi" {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"reason": "request header X-Auth not found"}`))
+			return
+		}
+
+		w.WriteHeader(200)
+		w.Write([]byte(`{"reason": "test successful"}`))
+	}))
+}
+
+// DriverTransaction test
+func DriverTransaction(d driver.Driver) func(t *testing.T) {
+	return func(t *testing.T) {
+		server := NewServer()
+		defer server.Close()
+
+		cfg, err := tmpl.Parse(transaction.Config{
+			Name:       "test-transaction",
+			Driver:     d.Type(),
+			Schedule:   "@every 60s",
+			Timeout:    "5s",
+			RetryCount: 1,
+			Inputs: map[string]interface{}{
+				"url":   server.URL + "/test",
+				"text":  "blade runner",
+				"xauth": "Joi",
+			},
+			Metadata: map[string]string{
+				"transaction": "api-test",
+				"application": "test",
+				"environment": "test",
+				"component":   "api",
+			},
+			Script: `function Run(ctx) {
+	req = replicant.http.NewRequest()
+	req.Method = "GET"
+	req.URL = "{{ index . "url" }}"
+	req.Params.q = "{{ index . "text" }}"
+	req.Header["X-Auth"] = "{{ index . "xauth" }}"
+	resp = replicant.http.Do(req)
+	data = JSON.parse(resp.Body)
+	rr = replicant.NewResult()
+	rr.Message = resp.Status
+	switch(resp.StatusCode > 200) {
+		case true:
+		rr.Error = data.reason
+		rr.Failed = true
+		break
+	case false:
+		rr.Data = data.reason
+		rr.Failed = false
+		break
+	}
+	return rr.JSON()
+}`,
+		})
+		if err != nil {
+			t.Fatalf("error parsing template: %s", err)
+		}
+
+		txn, err := d.New(cfg)
+		if err != nil {
+			t.Fatalf("error creating transaction: %s\n, %#v", err, cfg)
+		}
+
+		ctx := context.WithValue(context.Background(), "transaction_uuid", "test-test-test")
+		result := txn.Run(ctx)
+		if result.Error != nil {
+			t.Fatalf("error running transaction: %s", result.Error)
+		}
+
+		if result.Failed || result.Message != "200 OK" || result.Data != "test successful" {
+			t.Fatalf("transaction failed:\n%#v", result)
+		}
+	}
+}
+
+// DriverTransactionCancel test
+func DriverTransactionCancel(d driver.Driver) func(t *testing.T) {
+	return func(t *testing.T) {
+		// server that only responds when the client goes away
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			<-r.Context().Done()
+		}))
+		defer server.Close()
+
+		tests := []struct {
+			name   string
+			script string
+		}{
+			{name: "sleep", script: `function Run(ctx) {
+	replicant.Sleep(60000)
+	return replicant.NewResult().JSON()
+}`},
+			{name: "http", script: `function Run(ctx) {
+	req = replicant.http.NewRequest()
+	req.Method = "GET"
+	req.URL = "` + server.URL + `"
+	replicant.http.Do(req)
+	return replicant.NewResult().JSON()
+}`},
+			{name: "loop", script: `function Run(ctx) {
+	while (true) {}
+}`},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				Cancel(t, d, tt.script)
+			})
+		}
+	}
+}
+
+// Cancel runs the script with a short timeout, checking that it is reported as timed out
+// and that no goroutines are left running
+func Cancel(t *testing.T, d driver.Driver, script string) {
+	txn, err := d.New(transaction.Config{Name: t.Name(), Driver: d.Type(), Timeout: "200ms", Script: script})
+	if err != nil {
+		t.Fatalf("error creating transaction: %s", err)
+	}
+
+	baseline := runtime.NumGoroutine()
+	ctx := context.WithValue(context.Background(), "transaction_uuid", "test-test-test")
+
+	start := time.Now()
+	result := txn.Run(ctx)
+	if !result.Failed || result.Error == nil || !strings.Contains(result.Error.Error(), "timed out") {
+		t.Fatalf("Run() expected timeout error: %#v", result)
+	}
+
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Fatalf("Run() took %s after the transaction timeout", elapsed)
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for runtime.NumGoroutine() > baseline {
+		if time.Now().After(deadline) {
+			buf := make([]byte, 1<<20)
+			t.Fatalf("leaked %d goroutines:\n%s", runtime.NumGoroutine()-baseline, buf[:runtime.Stack(buf, true)])
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// DriverArtifacts test
+func DriverArtifacts(d driver.Driver) func(t *testing.T) {
+	return func(t *testing.T) {
+		txn, err := d.New(transaction.Config{Name: "artifacts", Driver: d.Type(), Timeout: "5s",
+			Script: `function Run(ctx) {
+	rr = replicant.NewResult()
+	replicant.AddArtifact("response.json", "application/json", '{"reason": "test successful"}')
+	replicant.AddArtifact("pixel.gif", "image/gif", "R0lGODlh", "base64")
+	rr.Message = replicant.AddArtifact("pixel.gif", "image/gif", "")
+	return rr.JSON()
+}`})
+		if err != nil {
+			t.Fatalf("error creating transaction: %s", err)
+		}
+
+		ctx := context.WithValue(context.Background(), "transaction_uuid", "test-test-test")
+		result := txn.Run(ctx)
+		if result.Error != nil {
+			t.Fatalf("error running transaction: %s", result.Error)
+		}
+
+		if result.Message != "artifact pixel.gif already added" {
+			t.Fatalf("expected duplicate artifact error, got: %q", result.Message)
+		}
+
+		if len(result.Artifacts) != 2 {
+			t.Fatalf("expected 2 artifacts, got: %#v", result.Artifacts)
+		}
+
+		if a := result.Artifacts[0]; a.Name != "response.json" || a.ContentType != "application/json" ||
+			string(a.Data) != `{"reason": "test successful"}` {
+			t.Fatalf("unexpected artifact: %#v", a)
+		}
+
+		if a := result.Artifacts[1]; a.Name != "pixel.gif" || a.Size != 6 || string(a.Data) != "GIF89a" {
+			t.Fatalf("unexpected artifact: %#v", a)
+		}
+	}
+}
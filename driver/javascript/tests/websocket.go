@@ -0,0 +1,176 @@
+package tests
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Unbabel/replicant/driver"
+	"github.com/Unbabel/replicant/transaction"
+	"github.com/gorilla/websocket"
+)
+
+// newWSServer starts an echo websocket server requiring the X-Auth header,
+// signaling on closed when a connection is terminated
+func newWSServer(t *testing.T) (server *httptest.Server, closed chan struct{}) {
+	closed = make(chan struct{}, 1)
+	upgrader := websocket.Upgrader{}
+
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Auth") != "Joi" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			t.Logf("error upgrading connection: %s", err)
+			return
+		}
+		defer conn.Close()
+
+		for {
+			mt, data, err := conn.ReadMessage()
+			if err != nil {
+				select {
+				case closed <- struct{}{}:
+				default:
+				}
+				return
+			}
+
+			if string(data) == "silence" {
+				continue
+			}
+
+			if err = conn.WriteMessage(mt, data); err != nil {
+				return
+			}
+		}
+	}))
+
+	return server, closed
+}
+
+// DriverWebSocket test
+func DriverWebSocket(d driver.Driver) func(t *testing.T) {
+	return func(t *testing.T) {
+		server, _ := newWSServer(t)
+		defer server.Close()
+
+		url := "ws" + strings.TrimPrefix(server.URL, "http")
+
+		tests := []struct {
+			name    string
+			timeout string
+			script  string
+			failed  bool
+			message string
+		}{
+			{name: "echo", timeout: "5s", message: "blade runner", script: `function Run(ctx) {
+	rr = replicant.NewResult()
+	ws = replicant.ws.Connect("` + url + `", {"X-Auth": "Joi"})
+	if (ws.Error) {
+		rr.Failed = true
+		rr.Message = ws.Error
+		return rr.JSON()
+	}
+	ws.Send("blade runner")
+	msg = ws.Receive(1000)
+	ws.Close()
+	rr.Failed = msg.Error != ""
+	rr.Message = msg.Data + msg.Error
+	return rr.JSON()
+}`},
+			{name: "unauthorized", timeout: "5s", failed: true, message: "401", script: `function Run(ctx) {
+	rr = replicant.NewResult()
+	ws = replicant.ws.Connect("` + url + `")
+	rr.Failed = ws.Error != ""
+	rr.Message = ws.Status + " " + ws.Error
+	return rr.JSON()
+}`},
+			{name: "receive timeout", timeout: "5s", failed: true, message: "timed out waiting for message", script: `function Run(ctx) {
+	rr = replicant.NewResult()
+	ws = replicant.ws.Connect("` + url + `", {"X-Auth": "Joi"})
+	ws.Send("silence")
+	msg = ws.Receive(100)
+	ws.Close()
+	rr.Failed = msg.Error != ""
+	rr.Message = msg.Error
+	return rr.JSON()
+}`},
+			{name: "closed", timeout: "5s", failed: true, message: "websocket is closed", script: `function Run(ctx) {
+	rr = replicant.NewResult()
+	ws = replicant.ws.Connect("` + url + `", {"X-Auth": "Joi"})
+	ws.Close()
+	err = ws.Send("blade runner")
+	rr.Failed = err != ""
+	rr.Message = err
+	return rr.JSON()
+}`},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				txn, err := d.New(transaction.Config{Name: tt.name, Driver: d.Type(), Timeout: tt.timeout, Script: tt.script})
+				if err != nil {
+					t.Fatalf("error creating transaction: %s", err)
+				}
+
+				ctx := context.WithValue(context.Background(), "transaction_uuid", "test-test-test")
+				result := txn.Run(ctx)
+				if result.Error != nil {
+					t.Fatalf("Run() error = %s", result.Error)
+				}
+
+				if result.Failed != tt.failed {
+					t.Fatalf("Run() failed = %t, want %t: %s", result.Failed, tt.failed, result.Message)
+				}
+
+				if !strings.Contains(result.Message, tt.message) {
+					t.Fatalf("Run() message = %s, want %s", result.Message, tt.message)
+				}
+			})
+		}
+	}
+}
+
+// DriverWebSocketCancel test
+func DriverWebSocketCancel(d driver.Driver) func(t *testing.T) {
+	return func(t *testing.T) {
+		server, closed := newWSServer(t)
+		defer server.Close()
+
+		url := "ws" + strings.TrimPrefix(server.URL, "http")
+
+		txn, err := d.New(transaction.Config{Name: "cancel", Driver: d.Type(), Script: `function Run(ctx) {
+	ws = replicant.ws.Connect("` + url + `", {"X-Auth": "Joi"})
+	ws.Send("silence")
+	msg = ws.Receive()
+	rr = replicant.NewResult()
+	rr.Message = msg.Error
+	return rr.JSON()
+}`})
+		if err != nil {
+			t.Fatalf("error creating transaction: %s", err)
+		}
+
+		ctx := context.WithValue(context.Background(), "transaction_uuid", "test-test-test")
+		ctx, cancel := context.WithTimeout(ctx, 500*time.Millisecond)
+		defer cancel()
+
+		result := txn.Run(ctx)
+		if result.Error == nil {
+			t.Fatalf("Run() expected error on cancellation: %#v", result)
+		}
+
+		select {
+		case <-closed:
+		case <-time.After(5 * time.Second):
+			t.Fatalf("websocket was not closed on cancellation")
+		}
+	}
+}
@@ -31,8 +31,9 @@ const maxTimeout = 10 * time.Minute
 
 // Transaction is a pre-compiled replicant transaction for javascript based transactions
 type Transaction struct {
-	vm     *otto.Otto
-	config transaction.Config
+	vm      *otto.Otto
+	config  transaction.Config
+	timeout time.Duration
 }
 
 // Config returns the transaction config
@@ -72,6 +73,9 @@ func (t *Transaction) Run(ctx context.Context) (result transaction.Result) {
 	result.Driver = "javascript"
 	result.Metadata = t.config.Metadata
 
+	ctx, cancel := context.WithTimeout(ctx, t.timeout)
+	defer cancel()
+
 	// copy the vm to avoid problems such as cancellation and GC
 	// Use a channel to read the values of execution from the running
 	// goroutine needed due to the otto cancelation mechanism which needs a panic
@@ -79,6 +83,15 @@ func (t *Transaction) Run(ctx context.Context) (result transaction.Result) {
 	vm.Interrupt = make(chan func(), 1)
 	respCh := make(chan transaction.Result, 1)
 
+	// native functions are bound to this run and interrupted on cancellation
+	bindings := NewBindings(ctx)
+	defer bindings.Close()
+	if err = bindings.register(vm); err != nil {
+		result.Failed = true
+		result.Error = err
+		return result
+	}
+
 	// Run in a service goroutine and recover in case
 	// of cancellation
 	go func() {
@@ -217,5 +230,6 @@ func (t *Transaction) Run(ctx context.Context) (result transaction.Result) {
 		result.Error = fmt.Errorf("driver/javascript: timed out running transaction")
 	}
 
+	result.Artifacts = bindings.Artifacts()
 	return result
 }
@@ -0,0 +1,198 @@
+package javascript
+
+/*
+   Copyright 2019 Bruno Moura <brunotm@gmail.com>
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/Unbabel/replicant/log"
+	"github.com/gorilla/websocket"
+)
+
+// wsResponse is returned to javascript as json for websocket operations
+type wsResponse struct {
+	ID     int    `json:"ID,omitempty"`
+	Status int    `json:"Status,omitempty"`
+	Data   string `json:"Data"`
+	Error  string `json:"Error"`
+}
+
+// wsConn is a websocket connection opened by a transaction script.
+// Messages are read in a service goroutine so that receive timeouts
+// do not break the underlying connection.
+type wsConn struct {
+	conn     *websocket.Conn
+	messages chan string
+	done     chan struct{}
+	once     sync.Once
+	err      error
+}
+
+func (c *wsConn) read() {
+	defer close(c.messages)
+
+	for {
+		_, data, err := c.conn.ReadMessage()
+		if err != nil {
+			c.err = err
+			return
+		}
+
+		select {
+		case c.messages <- string(data):
+		case <-c.done:
+			return
+		}
+	}
+}
+
+func (c *wsConn) close() {
+	c.once.Do(func() {
+		close(c.done)
+		c.conn.WriteControl(websocket.CloseMessage,
+			websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""), time.Now().Add(time.Second))
+		c.conn.Close()
+	})
+}
+
+// wsSessions holds the websocket connections opened during a transaction run,
+// bound to the transaction context
+type wsSessions struct {
+	ctx   context.Context
+	mtx   sync.Mutex
+	next  int
+	conns map[int]*wsConn
+}
+
+func newWSSessions(ctx context.Context) (s *wsSessions) {
+	return &wsSessions{ctx: ctx, conns: make(map[int]*wsConn)}
+}
+
+func (s *wsSessions) connect(url string, header http.Header) (r wsResponse) {
+	conn, resp, err := websocket.DefaultDialer.DialContext(s.ctx, url, header)
+	if resp != nil {
+		r.Status = resp.StatusCode
+	}
+	log.Debug("websocket connect").String("url", url).Int("status", int64(r.Status)).Error("error", err).Log()
+
+	if err != nil {
+		r.Error = fmt.Sprintf("error connecting: %s", err)
+		return r
+	}
+
+	c := &wsConn{conn: conn, messages: make(chan string, 16), done: make(chan struct{})}
+	go c.read()
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	// the transaction may have been cancelled while connecting
+	if s.ctx.Err() != nil {
+		c.close()
+		r.Error = fmt.Sprintf("error connecting: %s", s.ctx.Err())
+		return r
+	}
+
+	s.next++
+	s.conns[s.next] = c
+	r.ID = s.next
+	return r
+}
+
+func (s *wsSessions) get(id int) (c *wsConn, ok bool) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	c, ok = s.conns[id]
+	return c, ok
+}
+
+func (s *wsSessions) send(id int, data string) (r wsResponse) {
+	c, ok := s.get(id)
+	if !ok {
+		r.Error = "websocket is closed"
+		return r
+	}
+
+	if deadline, ok := s.ctx.Deadline(); ok {
+		c.conn.SetWriteDeadline(deadline)
+	}
+
+	if err := c.conn.WriteMessage(websocket.TextMessage, []byte(data)); err != nil {
+		r.Error = fmt.Sprintf("error sending message: %s", err)
+	}
+	return r
+}
+
+// receive the next message, waiting at most for the given timeout or
+// until the transaction context is done when the timeout is 0
+func (s *wsSessions) receive(id int, timeout time.Duration) (r wsResponse) {
+	c, ok := s.get(id)
+	if !ok {
+		r.Error = "websocket is closed"
+		return r
+	}
+
+	var expired <-chan time.Time
+	if timeout > 0 {
+		timer := time.NewTimer(timeout)
+		defer timer.Stop()
+		expired = timer.C
+	}
+
+	select {
+	case data, ok := <-c.messages:
+		if !ok {
+			r.Error = fmt.Sprintf("error receiving message: %s", c.err)
+			return r
+		}
+		r.Data = data
+	case <-expired:
+		r.Error = fmt.Sprintf("timed out waiting for message after %s", timeout)
+	case <-s.ctx.Done():
+		r.Error = fmt.Sprintf("error receiving message: %s", s.ctx.Err())
+	}
+
+	return r
+}
+
+func (s *wsSessions) close(id int) (r wsResponse) {
+	s.mtx.Lock()
+	c, ok := s.conns[id]
+	delete(s.conns, id)
+	s.mtx.Unlock()
+
+	if ok {
+		c.close()
+	}
+	return r
+}
+
+// closeAll closes all connections left open by the transaction
+func (s *wsSessions) closeAll() {
+	s.mtx.Lock()
+	conns := s.conns
+	s.conns = make(map[int]*wsConn)
+	s.mtx.Unlock()
+
+	for _, c := range conns {
+		c.close()
+	}
+}
@@ -0,0 +1,92 @@
+// Package js2 implements a javascript transaction driver on an ES2015+ engine.
+package js2
+
+/*
+   Copyright 2019 Bruno Moura <brunotm@gmail.com>
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Unbabel/replicant/driver/javascript"
+	"github.com/Unbabel/replicant/transaction"
+	"github.com/dop251/goja"
+)
+
+// maxTimeout for transactions without a timeout
+const maxTimeout = 10 * time.Minute
+
+// Config for the js2 driver
+type Config struct {
+	// Modules loads the shared modules required by transaction scripts.
+	// If nil, require() fails for every module.
+	Modules ModuleLoader
+}
+
+// Driver for javascript transactions with support for ES2015+ features
+// such as arrow functions, let/const, promises and async/await
+type Driver struct {
+	config    Config
+	replicant *goja.Program
+}
+
+// New creates a new js2 transaction driver
+func New(config Config) (d *Driver, err error) {
+	d = &Driver{config: config}
+
+	if d.replicant, err = goja.Compile("replicant.js", javascript.ReplicantJS, false); err != nil {
+		return nil, fmt.Errorf("driver/js2: error compiling replicant core objects: %w", err)
+	}
+
+	return d, nil
+}
+
+// Type returns this driver type
+func (d *Driver) Type() (t string) {
+	return "js2"
+}
+
+// New creates a new js2 transaction
+func (d *Driver) New(config transaction.Config) (tx transaction.Transaction, err error) {
+	txn := &Transaction{}
+	txn.config = config
+	txn.driver = d
+	txn.timeout = maxTimeout
+
+	if config.Timeout != "" {
+		if txn.timeout, err = time.ParseDuration(config.Timeout); err != nil {
+			return nil, fmt.Errorf("driver/js2: error parsing timeout: %w", err)
+		}
+	}
+
+	if txn.program, err = goja.Compile(config.Name, config.Script, false); err != nil {
+		return nil, fmt.Errorf("driver/js2: error compiling transaction script: %w", err)
+	}
+
+	if config.CallBack != nil {
+		if txn.callback, err = goja.Compile(config.Name+"-callback", config.CallBack.Script, false); err != nil {
+			return nil, fmt.Errorf("driver/js2: error compiling callback handling script: %w", err)
+		}
+	}
+
+	return txn, nil
+}
+
+// Check compiles the transaction and callback scripts without running them
+func (d *Driver) Check(config transaction.Config) (err error) {
+	_, err = d.New(config)
+	return err
+}
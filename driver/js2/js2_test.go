@@ -0,0 +1,228 @@
+package js2
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Unbabel/replicant/driver/javascript/tests"
+	"github.com/Unbabel/replicant/internal/tmpl"
+	"github.com/Unbabel/replicant/transaction"
+	"github.com/Unbabel/replicant/transaction/callback"
+)
+
+func TestDriver(t *testing.T) {
+	d, err := New(Config{})
+	if err != nil {
+		t.Fatalf("error creating driver: %s", err)
+	}
+
+	tests.Run(t, d)
+}
+
+func TestDriverTransaction(t *testing.T) {
+	server := tests.NewServer()
+	defer server.Close()
+
+	dir, err := ioutil.TempDir("", "replicant-js2")
+	if err != nil {
+		t.Fatalf("error creating temp dir: %s", err)
+	}
+	defer os.RemoveAll(dir)
+
+	module := `const auth = require("auth");
+exports.search = async (url, text) => {
+	const req = replicant.http.NewRequest();
+	req.Method = "GET";
+	req.URL = url;
+	req.Params.q = text;
+	req.Header["X-Auth"] = auth.token;
+	return replicant.http.Do(req);
+};`
+	if err := ioutil.WriteFile(filepath.Join(dir, "search.js"), []byte(module), 0600); err != nil {
+		t.Fatalf("error writing module: %s", err)
+	}
+
+	if err := ioutil.WriteFile(filepath.Join(dir, "auth.js"), []byte(`module.exports = {token: "Joi"};`), 0600); err != nil {
+		t.Fatalf("error writing module: %s", err)
+	}
+
+	cases := []struct {
+		name    string
+		script  string
+		failed  bool
+		error   string
+		message string
+	}{
+		{name: "async", message: "test successful", script: `async function Run(ctx) {
+	const req = replicant.http.NewRequest();
+	Object.assign(req, {Method: "GET", URL: "{{ index . "url" }}"});
+	req.Params.q = "{{ index . "text" }}";
+	req.Header["X-Auth"] = "{{ index . "xauth" }}";
+	const resp = await replicant.http.Do(req);
+	const {reason} = JSON.parse(resp.Body);
+	let rr = replicant.NewResult();
+	rr.Message = ` + "`${resp.StatusCode}: ${reason}`" + `;
+	rr.Failed = resp.StatusCode !== 200;
+	return rr.JSON();
+}`},
+		{name: "object result", message: "2 items", script: `const Run = async (ctx) => {
+	const items = await Promise.all([1, 2].map(async (x) => x * 2));
+	return {Message: ` + "`${items.length} items`" + `, Failed: false, Data: ""};
+}`},
+		{name: "modules", message: "200 OK", script: `async function Run(ctx) {
+	const {search} = require("search");
+	const resp = await search("{{ index . "url" }}", "{{ index . "text" }}");
+	return {Message: resp.Status, Failed: resp.StatusCode !== 200};
+}`},
+		{name: "rejected", error: "Run rejected: Error: replicant down", script: `async function Run(ctx) {
+	throw new Error("replicant down");
+}`},
+		{name: "never settled", error: "never settled", script: `function Run(ctx) {
+	return new Promise(() => {});
+}`},
+		{name: "missing module", error: "error loading module nexus", script: `function Run(ctx) {
+	require("nexus");
+}`},
+		{name: "invalid module", error: "invalid module name", script: `function Run(ctx) {
+	require("../../etc/passwd");
+}`},
+	}
+
+	d, err := New(Config{Modules: DirLoader(dir)})
+	if err != nil {
+		t.Fatalf("error creating driver: %s", err)
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := tmpl.Parse(transaction.Config{
+				Name:    tt.name,
+				Driver:  "js2",
+				Timeout: "5s",
+				Inputs:  map[string]interface{}{"url": server.URL, "text": "blade runner", "xauth": "Joi"},
+				Script:  tt.script,
+			})
+			if err != nil {
+				t.Fatalf("error parsing template: %s", err)
+			}
+
+			txn, err := d.New(cfg)
+			if err != nil {
+				t.Fatalf("error creating transaction: %s", err)
+			}
+
+			ctx := context.WithValue(context.Background(), "transaction_uuid", "test-test-test")
+			result := txn.Run(ctx)
+
+			if tt.error != "" {
+				if result.Error == nil || !strings.Contains(result.Error.Error(), tt.error) {
+					t.Fatalf("Run() error = %v, want %s", result.Error, tt.error)
+				}
+				return
+			}
+
+			if result.Error != nil {
+				t.Fatalf("Run() error = %s", result.Error)
+			}
+
+			if result.Failed != tt.failed {
+				t.Fatalf("Run() failed = %t, want %t: %s", result.Failed, tt.failed, result.Message)
+			}
+
+			if !strings.Contains(result.Message, tt.message) {
+				t.Fatalf("Run() message = %s, want %s", result.Message, tt.message)
+			}
+		})
+	}
+}
+
+func TestDriverTransactionCancel(t *testing.T) {
+	// server that only responds when the client goes away
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+
+	cases := []struct {
+		name   string
+		script string
+	}{
+		{name: "async http", script: `async function Run(ctx) {
+	const req = replicant.http.NewRequest()
+	req.Method = "GET"
+	req.URL = "` + server.URL + `"
+	await replicant.http.Do(req)
+}`},
+		{name: "top level loop", script: `for (;;) {}`},
+	}
+
+	d, err := New(Config{})
+	if err != nil {
+		t.Fatalf("error creating driver: %s", err)
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			tests.Cancel(t, d, tt.script)
+		})
+	}
+}
+
+func TestDriverArtifacts(t *testing.T) {
+	d, err := New(Config{})
+	if err != nil {
+		t.Fatalf("error creating driver: %s", err)
+	}
+
+	txn, err := d.New(transaction.Config{Name: "artifacts", Driver: "js2", Timeout: "5s",
+		Script: `async function Run(ctx) {
+	replicant.AddArtifact("response.json", "application/json", JSON.stringify({reason: "test successful"}));
+	replicant.AddArtifact("pixel.gif", "image/gif", "R0lGODlh", "base64");
+	throw new Error("replicant down");
+}`})
+	if err != nil {
+		t.Fatalf("error creating transaction: %s", err)
+	}
+
+	ctx := context.WithValue(context.Background(), "transaction_uuid", "test-test-test")
+	result := txn.Run(ctx)
+	if !result.Failed {
+		t.Fatalf("Run() expected failure: %#v", result)
+	}
+
+	// artifacts are kept for failed runs
+	if len(result.Artifacts) != 2 {
+		t.Fatalf("expected 2 artifacts, got: %#v", result.Artifacts)
+	}
+
+	if a := result.Artifacts[1]; a.Name != "pixel.gif" || a.ContentType != "image/gif" || string(a.Data) != "GIF89a" {
+		t.Fatalf("unexpected artifact: %#v", a)
+	}
+}
+
+func TestDriverNewInvalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		config transaction.Config
+	}{
+		{name: "syntax error", config: transaction.Config{Script: "function Run(ctx) {"}},
+		{name: "invalid timeout", config: transaction.Config{Script: "function Run(ctx) {}", Timeout: "soon"}},
+		{name: "invalid callback", config: transaction.Config{Script: "function Run(ctx) {}",
+			CallBack: &callback.Config{Type: "webhook", Script: "function Handle("}}},
+	}
+
+	d, _ := New(Config{})
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := d.New(tt.config); err == nil {
+				t.Fatalf("New() expected error")
+			}
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package js2
+
+/*
+   Copyright 2019 Bruno Moura <brunotm@gmail.com>
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/dop251/goja"
+)
+
+// ErrInvalidModuleName module names must only have letters, digits, '_', '-' and '.'
+// and must not start with a '.'
+var ErrInvalidModuleName = errors.New("invalid module name")
+
+var moduleNameRx = regexp.MustCompile(`^[a-zA-Z0-9_\-][a-zA-Z0-9_\-.]*$`)
+
+// ModuleLoader returns the source of the named javascript module
+type ModuleLoader func(ctx context.Context, name string) (source string, err error)
+
+// ModuleName validates and returns the module name without the .js extension
+func ModuleName(name string) (n string, err error) {
+	n = strings.TrimSuffix(name, ".js")
+	if !moduleNameRx.MatchString(n) {
+		return "", fmt.Errorf("%w: %q", ErrInvalidModuleName, name)
+	}
+	return n, nil
+}
+
+// DirLoader loads modules from <dir>/<name>.js
+func DirLoader(dir string) (loader ModuleLoader) {
+	return func(_ context.Context, name string) (source string, err error) {
+		if name, err = ModuleName(name); err != nil {
+			return "", err
+		}
+
+		buf, err := ioutil.ReadFile(filepath.Join(dir, name+".js"))
+		if err != nil {
+			return "", fmt.Errorf("error loading module %s: %w", name, err)
+		}
+
+		return string(buf), nil
+	}
+}
+
+// modules are the CommonJS style modules required during a transaction run.
+// Each module is loaded and evaluated once per run.
+type modules struct {
+	ctx    context.Context
+	vm     *goja.Runtime
+	loader ModuleLoader
+	cache  map[string]goja.Value
+}
+
+// require evaluates the named module and returns its exports,
+// throwing a javascript exception on errors
+func (m *modules) require(name string) (exports goja.Value) {
+	name, err := ModuleName(name)
+	if err != nil {
+		panic(m.vm.NewGoError(err))
+	}
+
+	if exports, ok := m.cache[name]; ok {
+		return exports
+	}
+
+	if m.loader == nil {
+		panic(m.vm.NewGoError(fmt.Errorf("error loading module %s: no module loader configured", name)))
+	}
+
+	source, err := m.loader(m.ctx, name)
+	if err != nil {
+		panic(m.vm.NewGoError(err))
+	}
+
+	wrapped := "(function (exports, module, require) {\n" + source + "\n})"
+	program, err := goja.Compile(name+".js", wrapped, false)
+	if err != nil {
+		panic(m.vm.NewGoError(fmt.Errorf("error compiling module %s: %w", name, err)))
+	}
+
+	value, err := m.vm.RunProgram(program)
+	if err != nil {
+		panic(err)
+	}
+
+	fn, _ := goja.AssertFunction(value)
+	module := m.vm.NewObject()
+	module.Set("exports", m.vm.NewObject())
+
+	// cache before evaluating to allow circular requires
+	m.cache[name] = module.Get("exports")
+	if _, err = fn(goja.Undefined(), module.Get("exports"), module, m.vm.Get("require")); err != nil {
+		delete(m.cache, name)
+		panic(err)
+	}
+
+	m.cache[name] = module.Get("exports")
+	return m.cache[name]
+}
@@ -0,0 +1,272 @@
+package js2
+
+/*
+   Copyright 2019 Bruno Moura <brunotm@gmail.com>
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Unbabel/replicant/driver/javascript"
+	"github.com/Unbabel/replicant/transaction"
+	"github.com/Unbabel/replicant/transaction/callback"
+	"github.com/dop251/goja"
+)
+
+// Transaction is a pre-compiled replicant transaction for js2 based transactions
+type Transaction struct {
+	driver   *Driver
+	config   transaction.Config
+	timeout  time.Duration
+	program  *goja.Program
+	callback *goja.Program
+}
+
+// Result is the result returned by transaction scripts
+type Result struct {
+	Data    string
+	Failed  bool
+	Message string
+}
+
+// Config returns the transaction config
+func (t *Transaction) Config() (config transaction.Config) {
+	return t.config
+}
+
+// Run executes the transaction
+func (t *Transaction) Run(ctx context.Context) (result transaction.Result) {
+	result.Name = t.config.Name
+	result.Driver = "js2"
+	result.Metadata = t.config.Metadata
+
+	uuid, ok := ctx.Value("transaction_uuid").(string)
+	if !ok {
+		result.Failed = true
+		result.Error = fmt.Errorf("driver/js2: transaction_uuid not found in context")
+		return result
+	}
+
+	// If dealing with async responses for this transaction, we must first get a Listener and Handle
+	var err error
+	var handle *callback.Handle
+	if t.config.CallBack != nil {
+		listener, ok := ctx.Value(t.config.CallBack.Type).(callback.Listener)
+		if !ok {
+			result.Failed = true
+			result.Error = fmt.Errorf("driver/js2: callback not found or does not implement callback.Listener interface")
+			return result
+		}
+
+		if handle, err = listener.Listen(ctx); err != nil {
+			result.Failed = true
+			result.Error = fmt.Errorf("driver/js2: error handling callback: %w", err)
+			return result
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, t.timeout)
+	defer cancel()
+
+	vm := goja.New()
+	defer Interrupt(ctx, vm)()
+
+	// native functions are bound to this run and interrupted on cancellation
+	bindings := javascript.NewBindings(ctx)
+	defer bindings.Close()
+	defer func() { result.Artifacts = bindings.Artifacts() }()
+	if err = Register(vm, bindings); err != nil {
+		result.Failed = true
+		result.Error = fmt.Errorf("driver/js2: %w", err)
+		return result
+	}
+
+	if err = t.load(ctx, vm); err != nil {
+		result.Failed = true
+		result.Error = RunError(ctx, "js2", err)
+		return result
+	}
+
+	var address string
+	if handle != nil {
+		address = handle.Address
+	}
+
+	// bindings return early on cancellation, so scripts can complete after the timeout
+	res, err := Call(vm, "Run", map[string]interface{}{"UUID": uuid, "CallbackAddress": address})
+	if err == nil {
+		err = ctx.Err()
+	}
+	if err != nil {
+		result.Failed = true
+		result.Error = RunError(ctx, "js2", fmt.Errorf("driver/js2: error running transaction: %w", err))
+		return result
+	}
+
+	result.Data = res.Data
+	result.Message = res.Message
+	result.Failed = res.Failed
+
+	if handle == nil || result.Failed {
+		return result
+	}
+
+	// wait for callback response or timeout
+	var hr callback.Response
+	select {
+	case hr = <-handle.Response:
+		if hr.Error != nil {
+			result.Failed = true
+			result.Error = fmt.Errorf("driver/js2: error from callback listener: %w", hr.Error)
+			return result
+		}
+	case <-ctx.Done():
+		result.Failed = true
+		result.Error = fmt.Errorf("driver/js2: timed out waiting for callback")
+		return result
+	}
+
+	res, err = Call(vm, "Handle", map[string]interface{}{"Data": string(hr.Data)})
+	if err == nil {
+		err = ctx.Err()
+	}
+	if err != nil {
+		result.Failed = true
+		result.Error = RunError(ctx, "js2", fmt.Errorf("driver/js2: error running callback handler: %w", err))
+		return result
+	}
+
+	result.Data = res.Data
+	result.Message = res.Message
+	result.Failed = res.Failed
+	return result
+}
+
+// load the replicant object, modules support and the transaction scripts in the runtime
+func (t *Transaction) load(ctx context.Context, vm *goja.Runtime) (err error) {
+	mods := &modules{ctx: ctx, vm: vm, loader: t.driver.config.Modules, cache: map[string]goja.Value{}}
+	if err = vm.Set("require", mods.require); err != nil {
+		return fmt.Errorf("driver/js2: error setting require: %w", err)
+	}
+
+	if _, err = vm.RunProgram(t.driver.replicant); err != nil {
+		return fmt.Errorf("driver/js2: error initializing replicant core objects: %w", err)
+	}
+
+	if _, err = vm.RunProgram(t.program); err != nil {
+		return fmt.Errorf("driver/js2: error initializing transaction script: %w", err)
+	}
+
+	if t.callback != nil {
+		if _, err = vm.RunProgram(t.callback); err != nil {
+			return fmt.Errorf("driver/js2: error initializing callback handling script: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Interrupt the runtime when the context is done, until the returned stop function is called.
+// Goja runtimes can be safely interrupted from other goroutines.
+func Interrupt(ctx context.Context, vm *goja.Runtime) (stop func()) {
+	done := make(chan struct{})
+	go func() {
+		select {
+		case <-ctx.Done():
+			vm.Interrupt(ctx.Err())
+		case <-done:
+		}
+	}()
+
+	return func() { close(done) }
+}
+
+// Register the native bindings backing the replicant object
+func Register(vm *goja.Runtime, b *javascript.Bindings) (err error) {
+	functions := map[string]interface{}{
+		"replicant_log":          b.Log,
+		"replicant_sleep":        b.Sleep,
+		"replicant_http_do":      b.HTTPDo,
+		"replicant_ws_connect":   b.WSConnect,
+		"replicant_ws_send":      b.WSSend,
+		"replicant_ws_receive":   b.WSReceive,
+		"replicant_ws_close":     b.WSClose,
+		"replicant_add_artifact": b.AddArtifact,
+	}
+
+	for name, fn := range functions {
+		if err = vm.Set(name, fn); err != nil {
+			return fmt.Errorf("error setting %s: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+// Call the named function, waiting for the returned promise if async, and decode its result.
+// Results can be returned as json strings, eg: by Result.JSON(), or as objects.
+func Call(vm *goja.Runtime, name string, arg interface{}) (res Result, err error) {
+	fn, ok := goja.AssertFunction(vm.Get(name))
+	if !ok {
+		return res, fmt.Errorf("%s is not a function", name)
+	}
+
+	value, err := fn(goja.Undefined(), vm.ToValue(arg))
+	if err != nil {
+		return res, err
+	}
+
+	// promise jobs run before the call returns, so a promise still pending
+	// is waiting on something that can never be resolved
+	if p, ok := value.Export().(*goja.Promise); ok {
+		switch p.State() {
+		case goja.PromiseStateFulfilled:
+			value = p.Result()
+		case goja.PromiseStateRejected:
+			return res, fmt.Errorf("%s rejected: %s", name, p.Result())
+		default:
+			return res, fmt.Errorf("%s returned a promise that was never settled", name)
+		}
+	}
+
+	data, ok := value.Export().(string)
+	if !ok {
+		stringify, _ := goja.AssertFunction(vm.Get("JSON").ToObject(vm).Get("stringify"))
+		s, err := stringify(goja.Undefined(), value)
+		if err != nil {
+			return res, err
+		}
+		data = s.String()
+	}
+
+	if err = json.Unmarshal([]byte(data), &res); err != nil {
+		return res, fmt.Errorf("error deserializing %s response %q: %w", name, data, err)
+	}
+
+	return res, nil
+}
+
+// RunError reports errors from interrupted scripts as timeouts of the given driver
+func RunError(ctx context.Context, driver string, err error) (e error) {
+	var interrupted *goja.InterruptedError
+	if errors.As(err, &interrupted) || ctx.Err() != nil {
+		return fmt.Errorf("driver/%s: timed out running transaction", driver)
+	}
+	return err
+}
@@ -0,0 +1,93 @@
+// Package tcpd implements a raw TCP and UDP connectivity driver.
+package tcpd
+
+/*
+   Copyright 2019 Bruno Moura <brunotm@gmail.com>
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+	"time"
+
+	"github.com/Unbabel/replicant/driver"
+	"github.com/Unbabel/replicant/driver/declarative"
+	"github.com/Unbabel/replicant/transaction"
+)
+
+// Config for TCP and UDP transactions, specified as yaml or json in the transaction script
+type Config struct {
+	// Address to connect to as host:port
+	Address string `json:"address" yaml:"address"`
+
+	// Network to use, tcp or udp. Defaults to tcp.
+	Network string `json:"network" yaml:"network"`
+
+	// Send is an optional payload sent after connecting
+	Send string `json:"send" yaml:"send"`
+
+	// Expect is a regular expression the response must match, eg: ^220 for SMTP greetings
+	Expect string `json:"expect" yaml:"expect"`
+
+	// ReadTimeout for reading the response, eg: 2s. When set without an expected response,
+	// anything received within it, like a greeting banner, is reported without failing
+	// the transaction. Responses are only read if Expect or ReadTimeout are set.
+	ReadTimeout string `json:"read_timeout" yaml:"read_timeout"`
+}
+
+// New creates a new TCP driver
+func New() (d driver.Driver, err error) {
+	return declarative.NewDriver("tcp", newTransaction), nil
+}
+
+// newTransaction creates a TCP or UDP transaction
+func newTransaction(config transaction.Config) (tx transaction.Transaction, err error) {
+	txn := &Transaction{}
+
+	if txn.Transaction, err = declarative.Parse("tcp", config, &txn.check); err != nil {
+		return nil, err
+	}
+
+	if _, _, err = net.SplitHostPort(txn.check.Address); err != nil {
+		return nil, fmt.Errorf("driver/tcp: invalid address %q: %w", txn.check.Address, err)
+	}
+
+	switch txn.check.Network {
+	case "":
+		txn.check.Network = "tcp"
+	case "tcp", "tcp4", "tcp6":
+	case "udp", "udp4", "udp6":
+		if txn.check.Send == "" {
+			return nil, fmt.Errorf("driver/tcp: udp transactions must send a payload")
+		}
+	default:
+		return nil, fmt.Errorf("driver/tcp: unknown network %s", txn.check.Network)
+	}
+
+	if txn.check.Expect != "" {
+		if txn.expect, err = regexp.Compile(txn.check.Expect); err != nil {
+			return nil, fmt.Errorf("driver/tcp: invalid expect expression: %w", err)
+		}
+	}
+
+	if txn.check.ReadTimeout != "" {
+		if txn.readTimeout, err = time.ParseDuration(txn.check.ReadTimeout); err != nil {
+			return nil, fmt.Errorf("driver/tcp: invalid read_timeout: %w", err)
+		}
+	}
+
+	return txn, nil
+}
@@ -0,0 +1,139 @@
+package tcpd
+
+import (
+	"bufio"
+	"encoding/json"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/Unbabel/replicant/driver/declarative/tests"
+)
+
+// newTCPServer starts a server that greets clients and answers PING with PONG
+func newTCPServer(t *testing.T) (address string, stop func()) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("error listening on tcp: %s", err)
+	}
+
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+
+			go func() {
+				defer conn.Close()
+				conn.Write([]byte("220 replicant.test ESMTP ready\r\n"))
+
+				scanner := bufio.NewScanner(conn)
+				for scanner.Scan() {
+					if scanner.Text() == "PING" {
+						conn.Write([]byte("PONG\r\n"))
+					}
+				}
+			}()
+		}
+	}()
+
+	return l.Addr().String(), func() { l.Close() }
+}
+
+// newUDPServer starts an echo server
+func newUDPServer(t *testing.T) (address string, stop func()) {
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("error listening on udp: %s", err)
+	}
+
+	go func() {
+		buf := make([]byte, 512)
+		for {
+			n, addr, err := pc.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+			pc.WriteTo(buf[:n], addr)
+		}
+	}()
+
+	return pc.LocalAddr().String(), func() { pc.Close() }
+}
+
+func TestDriverTransaction(t *testing.T) {
+	tcpAddress, stopTCP := newTCPServer(t)
+	defer stopTCP()
+
+	udpAddress, stopUDP := newUDPServer(t)
+	defer stopUDP()
+
+	// a closed port to check connection errors
+	l, _ := net.Listen("tcp", "127.0.0.1:0")
+	closedAddress := l.Addr().String()
+	l.Close()
+
+	cases := []struct {
+		tests.Case
+		address  string
+		response string
+	}{
+		{Case: tests.Case{Name: "connect", Message: "connected to"}, address: tcpAddress},
+		{Case: tests.Case{Name: "banner", Script: `
+read_timeout: 200ms`}, address: tcpAddress, response: "220 replicant.test"},
+		{Case: tests.Case{Name: "banner match", Message: "response matched", Script: `
+expect: ^220 `}, address: tcpAddress},
+		{Case: tests.Case{Name: "send expect", Script: `
+send: "PING\r\n"
+expect: PONG`}, address: tcpAddress, response: "PONG"},
+		{Case: tests.Case{Name: "no match", Failed: true, Message: "does not match", Script: `
+expect: ^421
+read_timeout: 200ms`}, address: tcpAddress},
+		{Case: tests.Case{Name: "refused", Failed: true, Error: true, Message: "error connecting"}, address: closedAddress},
+		{Case: tests.Case{Name: "udp echo", Script: `
+network: udp
+send: hello
+expect: ^hello$`}, address: udpAddress, response: "hello"},
+	}
+
+	d, err := New()
+	if err != nil {
+		t.Fatalf("error creating driver: %s", err)
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			tt.Script += "\naddress: " + tt.address
+			result := tests.Run(t, d, tt.Case)
+			if tt.Error {
+				return
+			}
+
+			if _, ok := result.Metrics[MetricConnectSeconds]; !ok {
+				t.Fatalf("Run() metrics = %v, want %s", result.Metrics, MetricConnectSeconds)
+			}
+
+			var r report
+			if err := json.Unmarshal([]byte(result.Data), &r); err != nil {
+				t.Fatalf("Run() data = %s, want report", result.Data)
+			}
+
+			if !strings.Contains(r.Response, tt.response) {
+				t.Fatalf("Run() response = %q, want %q", r.Response, tt.response)
+			}
+		})
+	}
+}
+
+func TestDriverNewInvalid(t *testing.T) {
+	d, _ := New()
+	tests.NewInvalid(t, d, map[string]string{
+		"no address":           `send: PING`,
+		"no port":              `address: localhost`,
+		"unknown network":      "address: localhost:25\nnetwork: sctp",
+		"udp without payload":  "address: localhost:53\nnetwork: udp",
+		"invalid expect":       "address: localhost:25\nexpect: '[220'",
+		"invalid read timeout": "address: localhost:25\nread_timeout: soon",
+	})
+}
@@ -0,0 +1,166 @@
+package tcpd
+
+/*
+   Copyright 2019 Bruno Moura <brunotm@gmail.com>
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"regexp"
+	"time"
+
+	"github.com/Unbabel/replicant/driver/declarative"
+	"github.com/Unbabel/replicant/transaction"
+)
+
+const (
+	// defaultTimeout for connecting and reading when the transaction has no timeout
+	defaultTimeout = time.Second * 30
+
+	// maxResponseSize read from connections
+	maxResponseSize = 64 << 10
+
+	// MetricConnectSeconds is the time taken to establish the connection
+	MetricConnectSeconds = "tcp_connect_seconds"
+
+	// MetricResponseSeconds is the time taken to receive the response after connecting
+	MetricResponseSeconds = "tcp_response_seconds"
+)
+
+// Transaction is a pre-configured replicant transaction for TCP and UDP checks
+type Transaction struct {
+	declarative.Transaction
+	check       Config
+	readTimeout time.Duration
+	expect      *regexp.Regexp
+}
+
+// report of the check, included in the result data
+type report struct {
+	Address    string `json:"address"`
+	Network    string `json:"network"`
+	ConnectMs  int64  `json:"connect_ms"`
+	ResponseMs int64  `json:"response_ms,omitempty"`
+	Response   string `json:"response,omitempty"`
+}
+
+// Run executes the connectivity check
+func (t *Transaction) Run(ctx context.Context) (result transaction.Result) {
+	result = t.Result()
+
+	ctx, cancel := t.Context(ctx, defaultTimeout)
+	defer cancel()
+
+	var dialer net.Dialer
+	start := time.Now()
+	conn, err := dialer.DialContext(ctx, t.check.Network, t.check.Address)
+	if err != nil {
+		result.Failed = true
+		result.Error = fmt.Errorf("driver/tcp: error connecting: %w", err)
+		result.Message = fmt.Sprintf("error connecting to %s", t.check.Address)
+		return result
+	}
+	defer conn.Close()
+
+	connected := time.Now()
+	r := report{Address: t.check.Address, Network: t.check.Network, ConnectMs: connected.Sub(start).Milliseconds()}
+	result.Metrics = map[string]float64{MetricConnectSeconds: connected.Sub(start).Seconds()}
+
+	deadline, _ := ctx.Deadline()
+	conn.SetDeadline(deadline)
+
+	if t.check.Send != "" {
+		if _, err = io.WriteString(conn, t.check.Send); err != nil {
+			result.Failed = true
+			result.Error = fmt.Errorf("driver/tcp: error sending payload: %w", err)
+			result.Message = fmt.Sprintf("error sending payload to %s", t.check.Address)
+			return result
+		}
+	}
+
+	if t.expect == nil && t.readTimeout == 0 {
+		buf, _ := json.Marshal(&r)
+		result.Data = string(buf)
+		result.Message = fmt.Sprintf("connected to %s in %s", t.check.Address, connected.Sub(start))
+		return result
+	}
+
+	if t.readTimeout > 0 && connected.Add(t.readTimeout).Before(deadline) {
+		conn.SetReadDeadline(connected.Add(t.readTimeout))
+	}
+
+	response, matched, err := t.read(conn)
+	duration := time.Since(connected)
+	r.ResponseMs = duration.Milliseconds()
+	r.Response = string(response)
+
+	buf, _ := json.Marshal(&r)
+	result.Data = string(buf)
+	result.Metrics[MetricResponseSeconds] = duration.Seconds()
+
+	switch {
+	case t.expect == nil:
+		// banners are optional without expectations
+		result.Message = fmt.Sprintf("connected to %s in %s, received %d bytes",
+			t.check.Address, connected.Sub(start), len(response))
+
+	case matched:
+		result.Message = fmt.Sprintf("connected to %s in %s, response matched %s",
+			t.check.Address, connected.Sub(start), t.expect)
+
+	case err != nil && !isTimeout(err) && !errors.Is(err, io.EOF):
+		result.Failed = true
+		result.Error = fmt.Errorf("driver/tcp: error reading response: %w", err)
+		result.Message = fmt.Sprintf("error reading response from %s", t.check.Address)
+
+	default:
+		result.Failed = true
+		result.Message = fmt.Sprintf("response from %s does not match %s", t.check.Address, t.expect)
+	}
+
+	return result
+}
+
+// read from the connection until the response matches the expected expression,
+// the connection is closed, the deadline is reached or the maximum size is read
+func (t *Transaction) read(conn net.Conn) (response []byte, matched bool, err error) {
+	buf := make([]byte, 4096)
+
+	for len(response) < maxResponseSize {
+		var n int
+		n, err = conn.Read(buf)
+		response = append(response, buf[:n]...)
+
+		if t.expect != nil && t.expect.Match(response) {
+			return response, true, nil
+		}
+
+		if err != nil {
+			return response, false, err
+		}
+	}
+
+	return response, false, nil
+}
+
+func isTimeout(err error) (ok bool) {
+	var ne net.Error
+	return errors.As(err, &ne) && ne.Timeout()
+}
@@ -0,0 +1,157 @@
+// Package tlsd implements a TLS certificate and configuration checking driver.
+package tlsd
+
+/*
+   Copyright 2019 Bruno Moura <brunotm@gmail.com>
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net"
+
+	"github.com/Unbabel/replicant/driver"
+	"github.com/Unbabel/replicant/driver/declarative"
+	"github.com/Unbabel/replicant/transaction"
+)
+
+// DefaultExpiryWindowDays before certificate expiration for failing transactions
+const DefaultExpiryWindowDays = 14
+
+// Config for TLS transactions, specified as yaml or json in the transaction script
+type Config struct {
+	// Address to connect to as host:port
+	Address string `json:"address" yaml:"address"`
+
+	// ServerName sent with SNI and used for hostname verification, defaults to the address host
+	ServerName string `json:"server_name" yaml:"server_name"`
+
+	// ExpiryWindowDays fails the transaction when the certificate expires within
+	// the given number of days. Defaults to 14.
+	ExpiryWindowDays float64 `json:"expiry_window_days" yaml:"expiry_window_days"`
+
+	// CAFile with additional CAs for verifying the certificate chain
+	CAFile string `json:"ca_file" yaml:"ca_file"`
+
+	// MinVersion is the minimum acceptable negotiated protocol version, eg: 1.2
+	MinVersion string `json:"min_version" yaml:"min_version"`
+
+	// IgnoreChain does not fail the transaction on certificate chain errors
+	IgnoreChain bool `json:"ignore_chain" yaml:"ignore_chain"`
+
+	// IgnoreHostname does not fail the transaction on hostname mismatches
+	IgnoreHostname bool `json:"ignore_hostname" yaml:"ignore_hostname"`
+}
+
+// New creates a new TLS driver
+func New() (d driver.Driver, err error) {
+	return declarative.NewDriver("tls", newTransaction), nil
+}
+
+// newTransaction creates a TLS transaction
+func newTransaction(config transaction.Config) (tx transaction.Transaction, err error) {
+	txn := &Transaction{}
+
+	if txn.Transaction, err = declarative.Parse("tls", config, &txn.check); err != nil {
+		return nil, err
+	}
+
+	host, _, err := net.SplitHostPort(txn.check.Address)
+	if err != nil {
+		return nil, fmt.Errorf("driver/tls: invalid address %q: %w", txn.check.Address, err)
+	}
+
+	if txn.check.ServerName == "" {
+		txn.check.ServerName = host
+	}
+
+	if txn.check.ExpiryWindowDays == 0 {
+		txn.check.ExpiryWindowDays = DefaultExpiryWindowDays
+	}
+
+	if txn.check.MinVersion != "" {
+		if txn.minVersion, err = parseVersion(txn.check.MinVersion); err != nil {
+			return nil, err
+		}
+	}
+
+	return txn, nil
+}
+
+// versions names by protocol version
+var versions = map[uint16]string{
+	tls.VersionSSL30: "SSL 3.0",
+	tls.VersionTLS10: "TLS 1.0",
+	tls.VersionTLS11: "TLS 1.1",
+	tls.VersionTLS12: "TLS 1.2",
+	tls.VersionTLS13: "TLS 1.3",
+}
+
+func parseVersion(v string) (version uint16, err error) {
+	for version, name := range versions {
+		if name == "TLS "+v || name == v {
+			return version, nil
+		}
+	}
+	return 0, fmt.Errorf("driver/tls: unknown protocol version %s", v)
+}
+
+func versionName(version uint16) (name string) {
+	if name, ok := versions[version]; ok {
+		return name
+	}
+	return fmt.Sprintf("0x%04x", version)
+}
+
+// cipherSuiteName returns the name of the cipher suite, or its id if unknown
+func cipherSuiteName(id uint16) (name string) {
+	for _, cs := range cipherSuites {
+		if cs.id == id {
+			return cs.name
+		}
+	}
+	return fmt.Sprintf("0x%04x", id)
+}
+
+var cipherSuites = []struct {
+	id   uint16
+	name string
+}{
+	{tls.TLS_RSA_WITH_RC4_128_SHA, "TLS_RSA_WITH_RC4_128_SHA"},
+	{tls.TLS_RSA_WITH_3DES_EDE_CBC_SHA, "TLS_RSA_WITH_3DES_EDE_CBC_SHA"},
+	{tls.TLS_RSA_WITH_AES_128_CBC_SHA, "TLS_RSA_WITH_AES_128_CBC_SHA"},
+	{tls.TLS_RSA_WITH_AES_256_CBC_SHA, "TLS_RSA_WITH_AES_256_CBC_SHA"},
+	{tls.TLS_RSA_WITH_AES_128_CBC_SHA256, "TLS_RSA_WITH_AES_128_CBC_SHA256"},
+	{tls.TLS_RSA_WITH_AES_128_GCM_SHA256, "TLS_RSA_WITH_AES_128_GCM_SHA256"},
+	{tls.TLS_RSA_WITH_AES_256_GCM_SHA384, "TLS_RSA_WITH_AES_256_GCM_SHA384"},
+	{tls.TLS_ECDHE_ECDSA_WITH_RC4_128_SHA, "TLS_ECDHE_ECDSA_WITH_RC4_128_SHA"},
+	{tls.TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA, "TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA"},
+	{tls.TLS_ECDHE_ECDSA_WITH_AES_256_CBC_SHA, "TLS_ECDHE_ECDSA_WITH_AES_256_CBC_SHA"},
+	{tls.TLS_ECDHE_RSA_WITH_RC4_128_SHA, "TLS_ECDHE_RSA_WITH_RC4_128_SHA"},
+	{tls.TLS_ECDHE_RSA_WITH_3DES_EDE_CBC_SHA, "TLS_ECDHE_RSA_WITH_3DES_EDE_CBC_SHA"},
+	{tls.TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA, "TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA"},
+	{tls.TLS_ECDHE_RSA_WITH_AES_256_CBC_SHA, "TLS_ECDHE_RSA_WITH_AES_256_CBC_SHA"},
+	{tls.TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA256, "TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA256"},
+	{tls.TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA256, "TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA256"},
+	{tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256, "TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256"},
+	{tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256, "TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256"},
+	{tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384, "TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384"},
+	{tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384, "TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384"},
+	{tls.TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305, "TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305"},
+	{tls.TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305, "TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305"},
+	{tls.TLS_AES_128_GCM_SHA256, "TLS_AES_128_GCM_SHA256"},
+	{tls.TLS_AES_256_GCM_SHA384, "TLS_AES_256_GCM_SHA384"},
+	{tls.TLS_CHACHA20_POLY1305_SHA256, "TLS_CHACHA20_POLY1305_SHA256"},
+}
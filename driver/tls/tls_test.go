@@ -0,0 +1,73 @@
+package tlsd
+
+import (
+	"context"
+	"encoding/pem"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Unbabel/replicant/driver/declarative/tests"
+	"github.com/Unbabel/replicant/transaction"
+)
+
+func TestDriverTransaction(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	dir, err := ioutil.TempDir("", "replicant-tls")
+	if err != nil {
+		t.Fatalf("error creating temp dir: %s", err)
+	}
+	defer os.RemoveAll(dir)
+
+	// the test server certificate is self signed
+	ca := filepath.Join(dir, "ca.pem")
+	buf := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	if err := ioutil.WriteFile(ca, buf, 0600); err != nil {
+		t.Fatalf("error writing ca: %s", err)
+	}
+
+	address := server.Listener.Addr().String()
+
+	cases := []tests.Case{
+		{Name: "valid", Script: "address: " + address + "\nserver_name: example.com\nca_file: " + ca},
+		{Name: "expiry window", Failed: true, Message: "within the 1000000 days window",
+			Script: "address: " + address + "\nserver_name: example.com\nca_file: " + ca + "\nexpiry_window_days: 1000000"},
+		{Name: "hostname mismatch", Failed: true, Message: "hostname mismatch",
+			Script: "address: " + address + "\nserver_name: replicant.test\nca_file: " + ca},
+		{Name: "ignore hostname", Script: "address: " + address + "\nserver_name: replicant.test\nca_file: " + ca + "\nignore_hostname: true"},
+		{Name: "untrusted chain", Failed: true, Message: "invalid certificate chain",
+			Script: "address: " + address + "\nserver_name: example.com"},
+		{Name: "min version", Script: "address: " + address + "\nserver_name: example.com\nca_file: " + ca + "\nmin_version: 1.2"},
+	}
+
+	d, err := New()
+	if err != nil {
+		t.Fatalf("error creating driver: %s", err)
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			result := tests.Run(t, d, tt)
+			if result.Metrics[MetricExpiryDays] <= 0 {
+				t.Fatalf("Run() metrics = %v, want expiry days", result.Metrics)
+			}
+		})
+	}
+}
+
+func TestDriverConnectionError(t *testing.T) {
+	d, _ := New()
+	txn, err := d.New(transaction.Config{Name: "closed", Timeout: "5s", Script: "address: 127.0.0.1:1"})
+	if err != nil {
+		t.Fatalf("error creating transaction: %s", err)
+	}
+
+	if result := txn.Run(context.Background()); !result.Failed || result.Error == nil {
+		t.Fatalf("Run() = %#v, want failed with error", result)
+	}
+}
@@ -0,0 +1,209 @@
+package tlsd
+
+/*
+   Copyright 2019 Bruno Moura <brunotm@gmail.com>
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/Unbabel/replicant/driver/declarative"
+	"github.com/Unbabel/replicant/internal/tlsutil"
+	"github.com/Unbabel/replicant/transaction"
+)
+
+// Metrics reported in results
+const (
+	// MetricExpiryDays is the number of days until the leaf certificate expires
+	MetricExpiryDays = "tls_expiry_days"
+
+	// MetricChainValid is 1 if the certificate chain is valid, 0 otherwise
+	MetricChainValid = "tls_chain_valid"
+
+	// MetricHostnameValid is 1 if the certificate is valid for the server name, 0 otherwise
+	MetricHostnameValid = "tls_hostname_valid"
+)
+
+// Transaction is a pre-configured replicant transaction for TLS checks
+type Transaction struct {
+	declarative.Transaction
+	check      Config
+	minVersion uint16
+}
+
+// report of the TLS connection, included in the result data
+type report struct {
+	Address       string    `json:"address"`
+	ServerName    string    `json:"server_name"`
+	Protocol      string    `json:"protocol"`
+	CipherSuite   string    `json:"cipher_suite"`
+	Subject       string    `json:"subject"`
+	Issuer        string    `json:"issuer"`
+	DNSNames      []string  `json:"dns_names"`
+	NotBefore     time.Time `json:"not_before"`
+	NotAfter      time.Time `json:"not_after"`
+	ExpiryDays    float64   `json:"expiry_days"`
+	Chain         []string  `json:"chain"`
+	ChainValid    bool      `json:"chain_valid"`
+	ChainError    string    `json:"chain_error,omitempty"`
+	HostnameValid bool      `json:"hostname_valid"`
+	HostnameError string    `json:"hostname_error,omitempty"`
+}
+
+// Run executes the TLS check
+func (t *Transaction) Run(ctx context.Context) (result transaction.Result) {
+	result = t.Result()
+
+	ctx, cancel := t.Context(ctx, 0)
+	defer cancel()
+
+	state, err := t.handshake(ctx)
+	if err != nil {
+		result.Failed = true
+		result.Error = err
+		result.Message = fmt.Sprintf("error connecting to %s", t.check.Address)
+		return result
+	}
+
+	if len(state.PeerCertificates) == 0 {
+		result.Failed = true
+		result.Message = fmt.Sprintf("no certificates presented by %s", t.check.Address)
+		return result
+	}
+
+	r, err := t.inspect(state)
+	if err != nil {
+		result.Failed = true
+		result.Error = err
+		return result
+	}
+
+	buf, _ := json.Marshal(&r)
+	result.Data = string(buf)
+	result.Metrics = map[string]float64{
+		MetricExpiryDays:    r.ExpiryDays,
+		MetricChainValid:    boolMetric(r.ChainValid),
+		MetricHostnameValid: boolMetric(r.HostnameValid),
+	}
+
+	var failures []string
+	if r.ExpiryDays < t.check.ExpiryWindowDays {
+		failures = append(failures, fmt.Sprintf("certificate expires in %.1f days, within the %.0f days window",
+			r.ExpiryDays, t.check.ExpiryWindowDays))
+	}
+
+	if !r.ChainValid && !t.check.IgnoreChain {
+		failures = append(failures, "invalid certificate chain: "+r.ChainError)
+	}
+
+	if !r.HostnameValid && !t.check.IgnoreHostname {
+		failures = append(failures, "hostname mismatch: "+r.HostnameError)
+	}
+
+	if t.minVersion != 0 && state.Version < t.minVersion {
+		failures = append(failures, fmt.Sprintf("negotiated %s, expected at least %s",
+			r.Protocol, versionName(t.minVersion)))
+	}
+
+	if len(failures) > 0 {
+		result.Failed = true
+		result.Message = strings.Join(failures, "; ")
+		return result
+	}
+
+	result.Message = fmt.Sprintf("certificate for %s expires in %.1f days, %s with %s",
+		t.check.ServerName, r.ExpiryDays, r.Protocol, r.CipherSuite)
+	return result
+}
+
+// handshake connects to the address and returns the connection state. Certificates are
+// not verified during the handshake, so that they can be inspected and reported.
+func (t *Transaction) handshake(ctx context.Context) (state tls.ConnectionState, err error) {
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", t.check.Address)
+	if err != nil {
+		return state, fmt.Errorf("driver/tls: error connecting: %w", err)
+	}
+	defer conn.Close()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+
+	client := tls.Client(conn, &tls.Config{ServerName: t.check.ServerName, InsecureSkipVerify: true})
+	if err = client.Handshake(); err != nil {
+		return state, fmt.Errorf("driver/tls: error in handshake: %w", err)
+	}
+
+	return client.ConnectionState(), nil
+}
+
+// inspect the connection state certificates
+func (t *Transaction) inspect(state tls.ConnectionState) (r report, err error) {
+	leaf := state.PeerCertificates[0]
+
+	r.Address = t.check.Address
+	r.ServerName = t.check.ServerName
+	r.Protocol = versionName(state.Version)
+	r.CipherSuite = cipherSuiteName(state.CipherSuite)
+	r.Subject = leaf.Subject.String()
+	r.Issuer = leaf.Issuer.String()
+	r.DNSNames = leaf.DNSNames
+	r.NotBefore = leaf.NotBefore
+	r.NotAfter = leaf.NotAfter
+	r.ExpiryDays = time.Until(leaf.NotAfter).Hours() / 24
+
+	for _, cert := range state.PeerCertificates {
+		r.Chain = append(r.Chain, cert.Subject.String())
+	}
+
+	config, err := tlsutil.ClientConfig(t.check.CAFile, "", "")
+	if err != nil {
+		return r, fmt.Errorf("driver/tls: %w", err)
+	}
+
+	opts := x509.VerifyOptions{Roots: config.RootCAs, Intermediates: x509.NewCertPool()}
+	for _, cert := range state.PeerCertificates[1:] {
+		opts.Intermediates.AddCert(cert)
+	}
+
+	r.ChainValid = true
+	if _, err = leaf.Verify(opts); err != nil {
+		r.ChainValid = false
+		r.ChainError = err.Error()
+	}
+
+	r.HostnameValid = true
+	if err = leaf.VerifyHostname(t.check.ServerName); err != nil {
+		r.HostnameValid = false
+		r.HostnameError = err.Error()
+	}
+
+	return r, nil
+}
+
+func boolMetric(b bool) (v float64) {
+	if b {
+		return 1
+	}
+	return 0
+}
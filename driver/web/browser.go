@@ -0,0 +1,225 @@
+package web
+
+/*
+   Copyright 2019 Bruno Moura <brunotm@gmail.com>
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/Unbabel/replicant/log"
+	"github.com/chromedp/cdproto/cdp"
+	"github.com/chromedp/chromedp"
+	"github.com/mailru/easyjson"
+)
+
+// maxBufferedEvents for each event listened by javascript transaction scripts
+const maxBufferedEvents = 1024
+
+// browser is the browser object exposed to javascript transaction scripts
+// for driving the page with chromedp and raw chrome devtools protocol commands.
+// Methods returning an error throw an exception in the script.
+type browser struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	mtx    sync.Mutex
+	events map[string]chan json.RawMessage
+}
+
+func newBrowser(ctx context.Context) (b *browser) {
+	b = &browser{events: map[string]chan json.RawMessage{}}
+	b.ctx, b.cancel = context.WithCancel(ctx)
+	return b
+}
+
+// Navigate to the given url and wait for the page to load
+func (b *browser) Navigate(url string) (err error) {
+	return chromedp.Run(b.ctx, chromedp.Navigate(url))
+}
+
+// WaitVisible waits until the element matching the selector is visible
+func (b *browser) WaitVisible(selector string) (err error) {
+	return chromedp.Run(b.ctx, chromedp.WaitVisible(selector))
+}
+
+// Click the first element matching the selector
+func (b *browser) Click(selector string) (err error) {
+	return chromedp.Run(b.ctx, chromedp.Click(selector))
+}
+
+// SendKeys sends the text to the first element matching the selector
+func (b *browser) SendKeys(selector, text string) (err error) {
+	return chromedp.Run(b.ctx, chromedp.SendKeys(selector, text))
+}
+
+// Text returns the visible text of the first element matching the selector
+func (b *browser) Text(selector string) (text string, err error) {
+	err = chromedp.Run(b.ctx, chromedp.Text(selector, &text))
+	return text, err
+}
+
+// Evaluate the javascript expression in the page, returning its result
+func (b *browser) Evaluate(expression string) (res interface{}, err error) {
+	err = chromedp.Run(b.ctx, chromedp.Evaluate(expression, &res))
+	return res, err
+}
+
+// Screenshot captures a png screenshot of the page viewport, encoded in base64
+func (b *browser) Screenshot() (data string, err error) {
+	var buf []byte
+	if err = chromedp.Run(b.ctx, chromedp.CaptureScreenshot(&buf)); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(buf), nil
+}
+
+// Send a chrome devtools protocol command to the page, eg: browser.Send("Network.setBlockedURLs", {urls: ["*.png"]}).
+// Returns the command result.
+func (b *browser) Send(method string, params map[string]interface{}) (res interface{}, err error) {
+	var p easyjson.Marshaler
+	if params != nil {
+		data, err := json.Marshal(params)
+		if err != nil {
+			return nil, err
+		}
+		p = (*easyjson.RawMessage)(&data)
+	}
+
+	var r easyjson.RawMessage
+	err = chromedp.Run(b.ctx, chromedp.ActionFunc(func(ctx context.Context) error {
+		return cdp.Execute(ctx, method, p, &r)
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(r) != 0 {
+		err = json.Unmarshal(r, &res)
+	}
+	return res, err
+}
+
+// Listen starts buffering the chrome devtools protocol events with the given method,
+// eg: browser.Listen("Network.requestWillBeSent"), for receiving them with WaitEvent
+func (b *browser) Listen(method string) (err error) {
+	method = eventKey(method)
+
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	// listen for the page events once
+	if len(b.events) == 0 {
+		if c := chromedp.FromContext(b.ctx); c == nil || c.Target == nil {
+			return chromedp.ErrInvalidContext
+		}
+		chromedp.ListenTarget(b.ctx, b.event)
+	}
+
+	if _, ok := b.events[method]; !ok {
+		b.events[method] = make(chan json.RawMessage, maxBufferedEvents)
+	}
+
+	return nil
+}
+
+// WaitEvent waits up to the given timeout in milliseconds for the next event with the given method.
+// Returns the event parameters.
+func (b *browser) WaitEvent(method string, ms int64) (ev interface{}, err error) {
+	b.mtx.Lock()
+	events, ok := b.events[eventKey(method)]
+	b.mtx.Unlock()
+
+	if !ok {
+		return nil, fmt.Errorf("not listening for %s events", method)
+	}
+
+	timer := time.NewTimer(time.Duration(ms) * time.Millisecond)
+	defer timer.Stop()
+
+	select {
+	case data := <-events:
+		err = json.Unmarshal(data, &ev)
+		return ev, err
+	case <-timer.C:
+		return nil, fmt.Errorf("timed out waiting for %s event", method)
+	case <-b.ctx.Done():
+		return nil, b.ctx.Err()
+	}
+}
+
+// event buffers target events being listened
+func (b *browser) event(ev interface{}) {
+	method := eventMethod(ev)
+
+	b.mtx.Lock()
+	events, ok := b.events[method]
+	b.mtx.Unlock()
+
+	if !ok {
+		return
+	}
+
+	data, err := json.Marshal(ev)
+	if err != nil {
+		log.Warn("error serializing browser event").String("driver", "web").
+			String("method", method).Error("error", err).Log()
+		return
+	}
+
+	select {
+	case events <- data:
+	default:
+		log.Warn("dropping browser event").String("driver", "web").String("method", method).Log()
+	}
+}
+
+func (b *browser) close() {
+	b.cancel()
+}
+
+// eventKey returns the protocol method with the domain in lower case
+func eventKey(method string) (key string) {
+	if x := strings.Index(method, "."); x > 0 {
+		return strings.ToLower(method[:x]) + method[x:]
+	}
+	return method
+}
+
+// eventMethod returns the protocol method for cdproto events as an event key,
+// eg: *network.EventRequestWillBeSent is network.requestWillBeSent,
+// as cdproto packages are named after the lower case domains.
+func eventMethod(ev interface{}) (method string) {
+	t := reflect.TypeOf(ev)
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	name := strings.TrimPrefix(t.Name(), "Event")
+	if name == "" || name == t.Name() {
+		return ""
+	}
+
+	r := []rune(name)
+	r[0] = unicode.ToLower(r[0])
+	return t.PkgPath()[strings.LastIndex(t.PkgPath(), "/")+1:] + "." + string(r)
+}
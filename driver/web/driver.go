@@ -19,33 +19,53 @@ package web
 
 import (
 	"fmt"
-	"net"
 	"net/url"
-	"os/exec"
-	"strings"
-	"sync"
-	"syscall"
 	"time"
 
 	"github.com/MontFerret/ferret/pkg/compiler"
-	"github.com/Unbabel/replicant/log"
 	"github.com/Unbabel/replicant/transaction"
 )
 
+// Artifact capture modes for web transactions
+const (
+	// ArtifactsNever disables capturing artifacts
+	ArtifactsNever = "never"
+
+	// ArtifactsOnFailure captures artifacts when a transaction fails
+	ArtifactsOnFailure = "failure"
+
+	// ArtifactsAlways captures artifacts for every transaction run
+	ArtifactsAlways = "always"
+)
+
+// Transaction script modes for web transactions
+const (
+	// ModeFQL runs FQL transaction scripts
+	ModeFQL = "fql"
+
+	// ModeGo runs Go transaction scripts against a chromedp context
+	ModeGo = "go"
+
+	// ModeJavaScript runs javascript transaction scripts against a chromedp context
+	ModeJavaScript = "javascript"
+)
+
 // Driver for web based transactions using the chrome developer protocol
 type Driver struct {
-	m      sync.RWMutex
-	cmd    *exec.Cmd
-	close  chan struct{}
+	pool   *pool
 	config Config
 }
 
 // Config for web driver
 type Config struct {
 	// Server URL for the chrome developer protocol to be used for tests
-	// can be overridden by the "cdp_server_url" in the transaction config inputs
+	// can be overridden by the "cdp_server_url" in the transaction config inputs.
+	// Managed chrome instances listen on consecutive ports starting from this URL port.
 	ServerURL string `json:"server_url" yaml:"server_url"`
 
+	// ServerURLs of additional remote chrome instances
+	ServerURLs []string `json:"server_urls" yaml:"server_urls"`
+
 	// Perform DNS discovery with the server hostname
 	// The web driver needs to maintain the same cdp server across multiple http
 	// requests. This needed when using multiple cdp servers that are load balanced
@@ -61,6 +81,21 @@ type Config struct {
 	// Interval for recycling chrome processes
 	RecycleInterval time.Duration
 
+	// Instances of managed chrome processes, defaults to 1
+	Instances int `json:"instances" yaml:"instances"`
+
+	// MaxRuns of transactions before recycling a managed chrome process, 0 for no limit
+	MaxRuns int `json:"max_runs" yaml:"max_runs"`
+
+	// Concurrency of transactions running on each chrome instance, 0 for no limit
+	Concurrency int `json:"concurrency" yaml:"concurrency"`
+
+	// Artifacts capture mode, one of never (default), failure or always.
+	// A full page screenshot, the final DOM, console messages and a HAR of the network
+	// activity are captured as result artifacts. Can be overridden by the "artifacts"
+	// in the transaction config inputs
+	Artifacts string `json:"artifacts" yaml:"artifacts"`
+
 	// for testing only
 	testing bool
 }
@@ -71,31 +106,83 @@ func New(c Config) (d *Driver, err error) {
 		return nil, fmt.Errorf("driver/web: no chrome binary or server URL specified")
 	}
 
-	_, err = url.Parse(c.ServerURL)
-	if err != nil {
-		return nil, fmt.Errorf("driver/web: could not parse chrome server url: %w", err)
+	if c.Artifacts == "" {
+		c.Artifacts = ArtifactsNever
 	}
 
-	d = &Driver{config: c, close: make(chan struct{})}
+	if !validArtifacts(c.Artifacts) {
+		return nil, fmt.Errorf("driver/web: invalid artifacts capture mode: %s", c.Artifacts)
+	}
 
-	if d.config.BinaryPath != "" {
-		if err = d.monitor(); err != nil {
-			return nil, err
+	for _, u := range append([]string{c.ServerURL}, c.ServerURLs...) {
+		if _, err = url.Parse(u); err != nil {
+			return nil, fmt.Errorf("driver/web: could not parse chrome server url: %w", err)
 		}
 	}
 
+	if c.Instances < 1 {
+		c.Instances = 1
+	}
+
+	d = &Driver{config: c}
+	if d.pool, err = newPool(c); err != nil {
+		return nil, err
+	}
+
 	return d, nil
 }
 
+// NewChecker creates a web driver for checking transactions without chrome instances.
+// Its transactions fail when run.
+func NewChecker() (d *Driver) {
+	d = &Driver{config: Config{Artifacts: ArtifactsNever}}
+	d.pool = &pool{closed: true, changed: make(chan struct{}), close: make(chan struct{})}
+	return d
+}
+
+// Health returns the state of the chrome instances used by this driver
+func (d *Driver) Health() (h Health) {
+	return d.pool.health()
+}
+
+// Close the driver, stopping managed chrome processes
+func (d *Driver) Close() (err error) {
+	d.pool.stop()
+	return nil
+}
+
 // Type returns this driver type
 func (d *Driver) Type() (t string) {
 	return "web"
 }
 
-// New creates a web transaction
+// New creates a web transaction.
+// The transaction script mode is set by the "mode" in the transaction config inputs, defaulting to fql.
 func (d *Driver) New(config transaction.Config) (tx transaction.Transaction, err error) {
+	artifacts := d.config.Artifacts
+	if v, ok := config.Inputs["artifacts"]; ok {
+		artifacts = fmt.Sprint(v)
+		if !validArtifacts(artifacts) {
+			return nil, fmt.Errorf("driver/web: invalid artifacts capture mode: %s", artifacts)
+		}
+	}
+
+	mode := ModeFQL
+	if v, ok := config.Inputs["mode"]; ok {
+		mode = fmt.Sprint(v)
+	}
+
+	switch mode {
+	case ModeFQL:
+	case ModeGo, ModeJavaScript:
+		return d.newScript(config, mode, artifacts)
+	default:
+		return nil, fmt.Errorf("driver/web: invalid transaction mode: %s", mode)
+	}
+
 	txn := &Transaction{}
 	txn.driver = d
+	txn.artifacts = artifacts
 
 	txn.program, err = compiler.New().Compile(config.Script)
 	if err != nil {
@@ -106,80 +193,17 @@ func (d *Driver) New(config transaction.Config) (tx transaction.Transaction, err
 	return txn, nil
 }
 
-// monitor the running chrome process to service transactions and recycle at every interval
-func (d *Driver) monitor() (err error) {
-
-	address := strings.Replace(d.config.ServerURL, "http://", "", 1)
-
-	// start chrome process and set process group id to avoid
-	// leaving zombies upon termination
-	d.cmd = exec.Command(d.config.BinaryPath, d.config.BinaryArgs...)
-	d.cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
-	if err := d.cmd.Start(); err != nil {
-		return fmt.Errorf("driver/web: error starting chrome process: %w", err)
+func validArtifacts(mode string) (ok bool) {
+	switch mode {
+	case ArtifactsNever, ArtifactsOnFailure, ArtifactsAlways:
+		return true
 	}
-
-	if err := waitForConn(address, 5, time.Second); err != nil {
-		panic(fmt.Errorf("driver/web: %w", err))
-	}
-
-	log.Info("chrome process created").Int("pid", int64(d.cmd.Process.Pid)).Log()
-
-	// TODO: don't panic and figure a better way to surface the errors below.
-	go func() {
-		for {
-			select {
-			case <-time.After(d.config.RecycleInterval):
-
-				log.Info("recycling chrome process").Int("pid", int64(d.cmd.Process.Pid)).Log()
-				d.m.Lock()
-
-				// stop chrome process and its children
-				err := syscall.Kill(-d.cmd.Process.Pid, syscall.SIGKILL)
-				if err != nil {
-					panic(fmt.Errorf("driver/web: error stopping chrome process: %w", err))
-				}
-
-				// start chrome process and set process group id to avoid
-				// leaving zombies upon termination
-				d.cmd = exec.Command(d.config.BinaryPath, d.config.BinaryArgs...)
-				d.cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
-				if err := d.cmd.Start(); err != nil {
-					panic(fmt.Errorf("driver/web: error starting chrome process: %w", err))
-				}
-
-				if err := waitForConn(address, 5, time.Second); err != nil {
-					panic(fmt.Errorf("driver/web: %w", err))
-				}
-
-				d.m.Unlock()
-				log.Info("chrome process created").Int("pid", int64(d.cmd.Process.Pid)).Log()
-
-			case <-d.close:
-				return
-			}
-		}
-	}()
-
-	return nil
+	return false
 }
 
-// waitForConn waits for a successful TCP connection to the specified address
-// for the given number of retries beetween the given interval.
-func waitForConn(address string, retries int, interval time.Duration) (err error) {
-	for x := 0; x < retries; x++ {
-		log.Debug("driver/web: checking managed chrome process availability").Log()
-
-		time.Sleep(interval)
-
-		var conn net.Conn
-		conn, err = net.Dial("tcp", address)
-		if err == nil {
-			log.Debug("driver/web: successfully connected to chrome process").Log()
-			conn.Close()
-			return nil
-		}
-	}
-
-	return fmt.Errorf("error connecting to managed chrome process: %w", err)
+// Check compiles the transaction script. Creating web transactions only compiles
+// their scripts, the browser is only accessed when running.
+func (d *Driver) Check(config transaction.Config) (err error) {
+	_, err = d.New(config)
+	return err
 }
@@ -0,0 +1,400 @@
+package web
+
+/*
+   Copyright 2019 Bruno Moura <brunotm@gmail.com>
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Unbabel/replicant/secret"
+	"github.com/mafredri/cdp/protocol/log"
+	"github.com/mafredri/cdp/protocol/network"
+	"github.com/mafredri/cdp/protocol/runtime"
+)
+
+// HAR 1.2 document types, see http://www.softwareishard.com/blog/har-12-spec/
+type har struct {
+	Log harLog `json:"log"`
+}
+
+type harLog struct {
+	Version string      `json:"version"`
+	Creator harCreator  `json:"creator"`
+	Entries []*harEntry `json:"entries"`
+}
+
+type harCreator struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+type harEntry struct {
+	StartedDateTime time.Time   `json:"startedDateTime"`
+	Time            float64     `json:"time"`
+	Request         harRequest  `json:"request"`
+	Response        harResponse `json:"response"`
+	Cache           struct{}    `json:"cache"`
+	Timings         harTimings  `json:"timings"`
+	ServerIPAddress string      `json:"serverIPAddress,omitempty"`
+	Error           string      `json:"_error,omitempty"`
+
+	// monotonic request start and response timing for computing durations
+	start  network.MonotonicTime
+	timing *network.ResourceTiming
+}
+
+type harRequest struct {
+	Method      string         `json:"method"`
+	URL         string         `json:"url"`
+	HTTPVersion string         `json:"httpVersion"`
+	Cookies     []harNameValue `json:"cookies"`
+	Headers     []harNameValue `json:"headers"`
+	QueryString []harNameValue `json:"queryString"`
+	PostData    *harPostData   `json:"postData,omitempty"`
+	HeadersSize int            `json:"headersSize"`
+	BodySize    int            `json:"bodySize"`
+}
+
+type harResponse struct {
+	Status      int            `json:"status"`
+	StatusText  string         `json:"statusText"`
+	HTTPVersion string         `json:"httpVersion"`
+	Cookies     []harNameValue `json:"cookies"`
+	Headers     []harNameValue `json:"headers"`
+	Content     harContent     `json:"content"`
+	RedirectURL string         `json:"redirectURL"`
+	HeadersSize int            `json:"headersSize"`
+	BodySize    int            `json:"bodySize"`
+}
+
+type harContent struct {
+	Size     int    `json:"size"`
+	MimeType string `json:"mimeType"`
+}
+
+type harPostData struct {
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text"`
+}
+
+type harNameValue struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type harTimings struct {
+	Blocked float64 `json:"blocked"`
+	DNS     float64 `json:"dns"`
+	Connect float64 `json:"connect"`
+	Send    float64 `json:"send"`
+	Wait    float64 `json:"wait"`
+	Receive float64 `json:"receive"`
+	SSL     float64 `json:"ssl"`
+}
+
+// consoleEntry is a console message, uncaught exception or browser log entry from a page
+type consoleEntry struct {
+	Time   time.Time `json:"time"`
+	Level  string    `json:"level"`
+	Source string    `json:"source"`
+	Text   string    `json:"text"`
+	URL    string    `json:"url,omitempty"`
+}
+
+// pageLog records the network activity and console messages of a page
+type pageLog struct {
+	mtx     sync.Mutex
+	entries []*harEntry
+	pending map[network.RequestID]*harEntry
+	console []consoleEntry
+}
+
+func newPageLog() (l *pageLog) {
+	return &pageLog{pending: make(map[network.RequestID]*harEntry)}
+}
+
+func (l *pageLog) requestWillBeSent(ev *network.RequestWillBeSentReply) {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	// redirects reuse the request id of the redirected request
+	if e, ok := l.pending[ev.RequestID]; ok && ev.RedirectResponse != nil {
+		e.response(*ev.RedirectResponse)
+		e.Response.RedirectURL = ev.Request.URL
+		e.finish(ev.Timestamp, ev.RedirectResponse.EncodedDataLength)
+		delete(l.pending, ev.RequestID)
+	}
+
+	e := &harEntry{start: ev.Timestamp, StartedDateTime: ev.WallTime.Time()}
+	e.Request.Method = ev.Request.Method
+	e.Request.URL = ev.Request.URL
+	e.Request.HTTPVersion = "HTTP/1.1"
+	e.Request.Cookies = []harNameValue{}
+	e.Request.Headers = headers(ev.Request.Headers)
+	e.Request.QueryString = queryString(ev.Request.URL)
+	e.Request.HeadersSize = -1
+	e.Request.BodySize = 0
+	e.Response.Cookies = []harNameValue{}
+	e.Response.Headers = []harNameValue{}
+	e.Response.HeadersSize = -1
+	e.Timings = harTimings{Blocked: -1, DNS: -1, Connect: -1, SSL: -1}
+
+	if ev.Request.PostData != nil {
+		e.Request.BodySize = len(*ev.Request.PostData)
+		e.Request.PostData = &harPostData{
+			MimeType: headerValue(e.Request.Headers, "Content-Type"), Text: *ev.Request.PostData}
+	}
+
+	l.entries = append(l.entries, e)
+	l.pending[ev.RequestID] = e
+}
+
+func (l *pageLog) responseReceived(ev *network.ResponseReceivedReply) {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	if e, ok := l.pending[ev.RequestID]; ok {
+		e.response(ev.Response)
+	}
+}
+
+func (l *pageLog) loadingFinished(ev *network.LoadingFinishedReply) {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	if e, ok := l.pending[ev.RequestID]; ok {
+		e.finish(ev.Timestamp, ev.EncodedDataLength)
+		delete(l.pending, ev.RequestID)
+	}
+}
+
+func (l *pageLog) loadingFailed(ev *network.LoadingFailedReply) {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	if e, ok := l.pending[ev.RequestID]; ok {
+		e.Error = ev.ErrorText
+		e.finish(ev.Timestamp, 0)
+		delete(l.pending, ev.RequestID)
+	}
+}
+
+func (l *pageLog) consoleAPICalled(ev *runtime.ConsoleAPICalledReply) {
+	args := make([]string, 0, len(ev.Args))
+	for x := range ev.Args {
+		args = append(args, remoteObjectText(ev.Args[x]))
+	}
+
+	l.addConsole(consoleEntry{
+		Time: ev.Timestamp.Time(), Level: ev.Type, Source: "console", Text: strings.Join(args, " ")})
+}
+
+func (l *pageLog) exceptionThrown(ev *runtime.ExceptionThrownReply) {
+	d := ev.ExceptionDetails
+	entry := consoleEntry{Time: ev.Timestamp.Time(), Level: "error", Source: "exception", Text: d.Text}
+
+	if d.Exception != nil {
+		entry.Text = fmt.Sprintf("%s %s", d.Text, remoteObjectText(*d.Exception))
+	}
+
+	if d.URL != nil {
+		entry.URL = fmt.Sprintf("%s:%d:%d", *d.URL, d.LineNumber+1, d.ColumnNumber+1)
+	}
+
+	l.addConsole(entry)
+}
+
+func (l *pageLog) entryAdded(ev *log.EntryAddedReply) {
+	entry := consoleEntry{
+		Time: ev.Entry.Timestamp.Time(), Level: ev.Entry.Level, Source: ev.Entry.Source, Text: ev.Entry.Text}
+
+	if ev.Entry.URL != nil {
+		entry.URL = *ev.Entry.URL
+	}
+
+	l.addConsole(entry)
+}
+
+func (l *pageLog) addConsole(entry consoleEntry) {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	l.console = append(l.console, entry)
+}
+
+// har returns the recorded network activity as a HAR document.
+// Requests still in flight are reported without a response.
+func (l *pageLog) har() (data []byte, err error) {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	h := har{Log: harLog{Version: "1.2", Creator: harCreator{Name: "replicant", Version: "1.0"}}}
+	h.Log.Entries = l.entries
+	if h.Log.Entries == nil {
+		h.Log.Entries = []*harEntry{}
+	}
+
+	return json.MarshalIndent(&h, "", "  ")
+}
+
+// consoleLog returns the recorded console messages ordered by time
+func (l *pageLog) consoleLog() (data []byte, err error) {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	entries := append([]consoleEntry{}, l.console...)
+	sort.SliceStable(entries, func(i, j int) bool { return entries[i].Time.Before(entries[j].Time) })
+	return json.MarshalIndent(entries, "", "  ")
+}
+
+func (e *harEntry) response(r network.Response) {
+	e.Response.Status = r.Status
+	e.Response.StatusText = r.StatusText
+	e.Response.HTTPVersion = "HTTP/1.1"
+	e.Response.Headers = headers(r.Headers)
+	e.Response.Content.MimeType = r.MimeType
+	e.Response.RedirectURL = headerValue(e.Response.Headers, "Location")
+
+	if r.Protocol != nil {
+		e.Request.HTTPVersion = strings.ToUpper(*r.Protocol)
+		e.Response.HTTPVersion = e.Request.HTTPVersion
+	}
+
+	if r.RequestHeaders != nil {
+		e.Request.Headers = headers(r.RequestHeaders)
+	}
+
+	if r.RemoteIPAddress != nil {
+		e.ServerIPAddress = *r.RemoteIPAddress
+	}
+
+	e.timing = r.Timing
+}
+
+// finish the entry at the given monotonic time with the given transferred bytes
+func (e *harEntry) finish(end network.MonotonicTime, size float64) {
+	e.Time = ms((float64(end) - float64(e.start)) * 1000)
+	e.Response.BodySize = int(size)
+	e.Response.Content.Size = int(size)
+
+	t := e.timing
+	if t == nil {
+		e.Timings.Send = 0
+		e.Timings.Wait = 0
+		e.Timings.Receive = e.Time
+		return
+	}
+
+	e.Timings.Blocked = ms((t.RequestTime - float64(e.start)) * 1000)
+	if t.DNSStart >= 0 {
+		e.Timings.DNS = ms(t.DNSEnd - t.DNSStart)
+	}
+	if t.ConnectStart >= 0 {
+		e.Timings.Connect = ms(t.ConnectEnd - t.ConnectStart)
+	}
+	if t.SSLStart >= 0 {
+		e.Timings.SSL = ms(t.SSLEnd - t.SSLStart)
+	}
+	e.Timings.Send = ms(t.SendEnd - t.SendStart)
+	e.Timings.Wait = ms(t.ReceiveHeadersEnd - t.SendEnd)
+	e.Timings.Receive = ms((float64(end)-t.RequestTime)*1000 - t.ReceiveHeadersEnd)
+
+	if e.Timings.Blocked < 0 {
+		e.Timings.Blocked = 0
+	}
+	if e.Timings.Receive < 0 {
+		e.Timings.Receive = 0
+	}
+}
+
+// ms rounds the given milliseconds to microseconds
+func ms(v float64) (r float64) {
+	return float64(int64(v*1000)) / 1000
+}
+
+// headers decodes the raw protocol headers as HAR name value pairs,
+// redacting the values of credential headers
+func headers(raw network.Headers) (hs []harNameValue) {
+	hs = []harNameValue{}
+	h := map[string]interface{}{}
+	if err := json.Unmarshal(raw, &h); err != nil {
+		return hs
+	}
+
+	for name, value := range h {
+		if secret.SensitiveHeader(name) {
+			hs = append(hs, harNameValue{Name: name, Value: secret.Redacted})
+			continue
+		}
+
+		// multiple values for the same header are separated by new lines
+		for _, v := range strings.Split(fmt.Sprint(value), "\n") {
+			hs = append(hs, harNameValue{Name: name, Value: v})
+		}
+	}
+
+	sort.SliceStable(hs, func(i, j int) bool { return hs[i].Name < hs[j].Name })
+	return hs
+}
+
+func headerValue(hs []harNameValue, name string) (value string) {
+	for x := range hs {
+		if strings.EqualFold(hs[x].Name, name) {
+			return hs[x].Value
+		}
+	}
+	return ""
+}
+
+func queryString(u string) (qs []harNameValue) {
+	qs = []harNameValue{}
+	parsed, err := url.Parse(u)
+	if err != nil {
+		return qs
+	}
+
+	for name, values := range parsed.Query() {
+		for _, v := range values {
+			qs = append(qs, harNameValue{Name: name, Value: v})
+		}
+	}
+
+	sort.SliceStable(qs, func(i, j int) bool { return qs[i].Name < qs[j].Name })
+	return qs
+}
+
+// remoteObjectText formats a console argument like the browser console
+func remoteObjectText(o runtime.RemoteObject) (text string) {
+	switch {
+	case len(o.Value) > 0:
+		var s string
+		if err := json.Unmarshal(o.Value, &s); err == nil {
+			return s
+		}
+		return string(o.Value)
+	case o.UnserializableValue != nil:
+		return string(*o.UnserializableValue)
+	case o.Description != nil:
+		return *o.Description
+	default:
+		return o.Type
+	}
+}
@@ -0,0 +1,121 @@
+package web
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/Unbabel/replicant/secret"
+	"github.com/mafredri/cdp/protocol/log"
+	"github.com/mafredri/cdp/protocol/network"
+	"github.com/mafredri/cdp/protocol/runtime"
+)
+
+func TestPageLogHAR(t *testing.T) {
+	l := newPageLog()
+
+	l.requestWillBeSent(&network.RequestWillBeSentReply{
+		RequestID: "1", Timestamp: 100, WallTime: 1574000000,
+		Request: network.Request{Method: "GET", URL: "http://localhost/login?next=home",
+			Headers: network.Headers(`{"Accept":"text/html","Authorization":"Bearer nexus6"}`)}})
+
+	l.requestWillBeSent(&network.RequestWillBeSentReply{
+		RequestID: "1", Timestamp: 100.1, WallTime: 1574000000.1,
+		Request:          network.Request{Method: "GET", URL: "http://localhost/home", Headers: network.Headers(`{"Cookie":"session=deckard"}`)},
+		RedirectResponse: &network.Response{Status: 302, StatusText: "Found", Headers: network.Headers(`{"Location":"/home"}`)}})
+
+	l.responseReceived(&network.ResponseReceivedReply{RequestID: "1",
+		Response: network.Response{Status: 200, StatusText: "OK", MimeType: "text/html",
+			Headers: network.Headers(`{"Set-Cookie":"a=1\nb=2"}`),
+			Timing: &network.ResourceTiming{RequestTime: 100.1, DNSStart: -1, DNSEnd: -1, ConnectStart: -1,
+				ConnectEnd: -1, SSLStart: -1, SSLEnd: -1, SendStart: 1, SendEnd: 2, ReceiveHeadersEnd: 52}}})
+
+	l.loadingFinished(&network.LoadingFinishedReply{RequestID: "1", Timestamp: 100.2, EncodedDataLength: 1024})
+
+	l.requestWillBeSent(&network.RequestWillBeSentReply{
+		RequestID: "2", Timestamp: 100.3, Request: network.Request{Method: "GET", URL: "http://localhost/app.js"}})
+	l.loadingFailed(&network.LoadingFailedReply{RequestID: "2", Timestamp: 100.4, ErrorText: "net::ERR_CONNECTION_REFUSED"})
+
+	buf, err := l.har()
+	if err != nil {
+		t.Fatalf("error serializing har: %s", err)
+	}
+
+	var h har
+	if err = json.Unmarshal(buf, &h); err != nil {
+		t.Fatalf("error deserializing har: %s", err)
+	}
+
+	if len(h.Log.Entries) != 3 {
+		t.Fatalf("expected 3 entries, got %d", len(h.Log.Entries))
+	}
+
+	redirect, home, failed := h.Log.Entries[0], h.Log.Entries[1], h.Log.Entries[2]
+
+	if redirect.Response.Status != 302 || redirect.Response.RedirectURL != "http://localhost/home" ||
+		len(redirect.Request.QueryString) != 1 || redirect.Request.QueryString[0].Value != "home" {
+		t.Fatalf("unexpected redirect entry: %#v", redirect)
+	}
+
+	if h := redirect.Request.Headers; len(h) != 2 || h[1].Name != "Authorization" || h[1].Value != secret.Redacted {
+		t.Fatalf("expected redacted authorization header: %#v", h)
+	}
+
+	if h := home.Request.Headers; len(h) != 1 || h[0].Value != secret.Redacted {
+		t.Fatalf("expected redacted cookie header: %#v", h)
+	}
+
+	if h := home.Response.Headers; len(h) != 1 || h[0].Name != "Set-Cookie" || h[0].Value != secret.Redacted {
+		t.Fatalf("expected redacted set-cookie header: %#v", h)
+	}
+
+	if home.Response.Status != 200 || home.Response.BodySize != 1024 ||
+		home.Time != 100 || home.Timings.Send != 1 || home.Timings.Wait != 50 || home.Timings.Receive != 48 {
+		t.Fatalf("unexpected entry: %#v", home)
+	}
+
+	if failed.Error != "net::ERR_CONNECTION_REFUSED" || failed.Response.Status != 0 {
+		t.Fatalf("unexpected failed entry: %#v", failed)
+	}
+}
+
+func TestPageLogConsole(t *testing.T) {
+	l := newPageLog()
+	url := "http://localhost/app.js"
+	description := "TypeError: x is undefined"
+
+	l.entryAdded(&log.EntryAddedReply{Entry: log.Entry{
+		Source: "network", Level: "error", Text: "Failed to load resource", Timestamp: 3000, URL: &url}})
+
+	l.consoleAPICalled(&runtime.ConsoleAPICalledReply{Type: "log", Timestamp: 1000, Args: []runtime.RemoteObject{
+		{Type: "string", Value: json.RawMessage(`"logged in"`)}, {Type: "number", Value: json.RawMessage(`42`)}}})
+
+	l.exceptionThrown(&runtime.ExceptionThrownReply{Timestamp: 2000, ExceptionDetails: runtime.ExceptionDetails{
+		Text: "Uncaught", LineNumber: 9, ColumnNumber: 4, URL: &url,
+		Exception: &runtime.RemoteObject{Type: "object", Description: &description}}})
+
+	buf, err := l.consoleLog()
+	if err != nil {
+		t.Fatalf("error serializing console log: %s", err)
+	}
+
+	var entries []consoleEntry
+	if err = json.Unmarshal(buf, &entries); err != nil {
+		t.Fatalf("error deserializing console log: %s", err)
+	}
+
+	if len(entries) != 3 {
+		t.Fatalf("expected 3 entries, got %d", len(entries))
+	}
+
+	if entries[0].Text != "logged in 42" || entries[0].Source != "console" {
+		t.Fatalf("unexpected console entry: %#v", entries[0])
+	}
+
+	if entries[1].Text != "Uncaught TypeError: x is undefined" || entries[1].URL != "http://localhost/app.js:10:5" {
+		t.Fatalf("unexpected exception entry: %#v", entries[1])
+	}
+
+	if entries[2].Level != "error" || entries[2].Source != "network" {
+		t.Fatalf("unexpected log entry: %#v", entries[2])
+	}
+}
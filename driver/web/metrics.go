@@ -0,0 +1,224 @@
+package web
+
+/*
+   Copyright 2019 Bruno Moura <brunotm@gmail.com>
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/Unbabel/replicant/log"
+	"github.com/mafredri/cdp/protocol/runtime"
+)
+
+// Metrics reported in results for the first document opened by a transaction.
+// Metrics for further documents are prefixed by their number, eg: web_page2_ttfb_seconds.
+const (
+	// MetricTTFBSeconds is the time to the first byte of the document response
+	MetricTTFBSeconds = "web_ttfb_seconds"
+
+	// MetricFCPSeconds is the time to the first contentful paint
+	MetricFCPSeconds = "web_fcp_seconds"
+
+	// MetricLCPSeconds is the time to the largest contentful paint
+	MetricLCPSeconds = "web_lcp_seconds"
+
+	// MetricCLS is the cumulative layout shift score
+	MetricCLS = "web_cls"
+
+	// MetricDOMContentLoadedSeconds is the time to the end of the DOMContentLoaded event
+	MetricDOMContentLoadedSeconds = "web_dom_content_loaded_seconds"
+
+	// MetricLoadSeconds is the time to the end of the load event
+	MetricLoadSeconds = "web_load_seconds"
+
+	// MetricTransferBytes is the number of bytes transferred by all requests of the document
+	MetricTransferBytes = "web_transfer_bytes"
+
+	// MetricRequests is the number of requests made by the document
+	MetricRequests = "web_requests"
+
+	// MetricFailedRequests is the number of requests which failed or had an error status
+	MetricFailedRequests = "web_failed_requests"
+)
+
+// timingsJS collects the navigation timing, paint and layout shift entries of the document.
+// Largest contentful paint and layout shift entries are only available to buffered performance observers.
+const timingsJS = `new Promise(function (resolve) {
+	var t = {};
+	var nav = performance.getEntriesByType("navigation")[0] || {};
+	t.ttfb = nav.responseStart;
+	t.dcl = nav.domContentLoadedEventEnd;
+	t.load = nav.loadEventEnd;
+
+	performance.getEntriesByType("paint").forEach(function (e) {
+		if (e.name === "first-contentful-paint") {
+			t.fcp = e.startTime;
+		}
+	});
+
+	function observe(type, fn) {
+		try {
+			new PerformanceObserver(function (list) {
+				list.getEntries().forEach(fn);
+			}).observe({type: type, buffered: true});
+			return true;
+		} catch (e) {
+			return false;
+		}
+	}
+
+	observe("largest-contentful-paint", function (e) {
+		t.lcp = e.renderTime || e.loadTime || e.startTime;
+	});
+
+	if (observe("layout-shift", function (e) {
+		if (!e.hadRecentInput) {
+			t.cls += e.value;
+		}
+	})) {
+		t.cls = 0;
+	}
+
+	setTimeout(function () {
+		resolve(JSON.stringify(t));
+	}, 100);
+})`
+
+// pageTimings of a document, in milliseconds since the navigation start
+type pageTimings struct {
+	TTFB *float64 `json:"ttfb"`
+	FCP  *float64 `json:"fcp"`
+	LCP  *float64 `json:"lcp"`
+	CLS  *float64 `json:"cls"`
+	DCL  *float64 `json:"dcl"`
+	Load *float64 `json:"load"`
+}
+
+// timings collects the performance timings of the current page document
+func (p *recordedPage) timings(ctx context.Context) (t pageTimings, err error) {
+	reply, err := p.client.Runtime.Evaluate(ctx,
+		runtime.NewEvaluateArgs(timingsJS).SetAwaitPromise(true).SetReturnByValue(true))
+	if err != nil {
+		return t, err
+	}
+
+	if reply.ExceptionDetails != nil {
+		return t, fmt.Errorf("error evaluating page timings: %s", reply.ExceptionDetails.Text)
+	}
+
+	var data string
+	if err = json.Unmarshal(reply.Result.Value, &data); err != nil {
+		return t, err
+	}
+
+	err = json.Unmarshal([]byte(data), &t)
+	return t, err
+}
+
+// add the page timings to the given metrics, with timings converted to seconds.
+// Timings of events that didn't happen are not reported.
+func (t pageTimings) add(metrics map[string]float64, prefix func(string) string) {
+	for name, v := range map[string]*float64{
+		MetricTTFBSeconds:             t.TTFB,
+		MetricFCPSeconds:              t.FCP,
+		MetricLCPSeconds:              t.LCP,
+		MetricDOMContentLoadedSeconds: t.DCL,
+		MetricLoadSeconds:             t.Load,
+	} {
+		if v != nil && *v > 0 {
+			metrics[prefix(name)] = *v / 1000
+		}
+	}
+
+	if t.CLS != nil {
+		metrics[prefix(MetricCLS)] = *t.CLS
+	}
+}
+
+// requests returns the number of recorded requests, failed requests and transferred bytes.
+// Redirects are counted as requests.
+func (l *pageLog) requests() (requests, failed int, bytes float64) {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	for _, e := range l.entries {
+		requests++
+		bytes += float64(e.Response.BodySize)
+		if e.Error != "" || e.Response.Status >= 400 {
+			failed++
+		}
+	}
+
+	return requests, failed, bytes
+}
+
+// pagePrefix returns the function for naming the metrics of the page with the given index
+func pagePrefix(x int) (prefix func(string) string) {
+	return func(name string) string {
+		if x == 0 {
+			return name
+		}
+		return strings.Replace(name, "web_", fmt.Sprintf("web_page%d_", x+1), 1)
+	}
+}
+
+// metrics collects the performance metrics of the recorded pages
+func (r *recorder) metrics() (metrics map[string]float64) {
+	r.mtx.Lock()
+	pages := append([]*recordedPage{}, r.pages...)
+	r.mtx.Unlock()
+
+	if len(pages) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), captureTimeout)
+	defer cancel()
+
+	metrics = map[string]float64{}
+	for x, p := range pages {
+		prefix := pagePrefix(x)
+
+		requests, failed, bytes := p.log.requests()
+		metrics[prefix(MetricRequests)] = float64(requests)
+		metrics[prefix(MetricFailedRequests)] = float64(failed)
+		metrics[prefix(MetricTransferBytes)] = bytes
+
+		t, err := p.timings(ctx)
+		if err != nil {
+			log.Warn("error collecting page timings").String("driver", "web").Error("error", err).Log()
+			continue
+		}
+		t.add(metrics, prefix)
+	}
+
+	return metrics
+}
+
+// addMetrics adds the recorded metrics to the metrics returned by the transaction script
+func addMetrics(metrics, recorded map[string]float64) (m map[string]float64) {
+	if metrics == nil {
+		return recorded
+	}
+
+	for name, v := range recorded {
+		metrics[name] = v
+	}
+	return metrics
+}
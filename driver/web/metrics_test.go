@@ -0,0 +1,71 @@
+package web
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mafredri/cdp/protocol/network"
+)
+
+func TestPageLogRequests(t *testing.T) {
+	l := newPageLog()
+
+	l.requestWillBeSent(&network.RequestWillBeSentReply{
+		RequestID: "1", Timestamp: 100, Request: network.Request{Method: "GET", URL: "http://localhost/"}})
+	l.responseReceived(&network.ResponseReceivedReply{RequestID: "1", Response: network.Response{Status: 200}})
+	l.loadingFinished(&network.LoadingFinishedReply{RequestID: "1", Timestamp: 100.2, EncodedDataLength: 1024})
+
+	l.requestWillBeSent(&network.RequestWillBeSentReply{
+		RequestID: "2", Timestamp: 100.3, Request: network.Request{Method: "GET", URL: "http://localhost/missing.css"}})
+	l.responseReceived(&network.ResponseReceivedReply{RequestID: "2", Response: network.Response{Status: 404}})
+	l.loadingFinished(&network.LoadingFinishedReply{RequestID: "2", Timestamp: 100.4, EncodedDataLength: 256})
+
+	l.requestWillBeSent(&network.RequestWillBeSentReply{
+		RequestID: "3", Timestamp: 100.3, Request: network.Request{Method: "GET", URL: "http://localhost/app.js"}})
+	l.loadingFailed(&network.LoadingFailedReply{RequestID: "3", Timestamp: 100.4, ErrorText: "net::ERR_CONNECTION_REFUSED"})
+
+	requests, failed, bytes := l.requests()
+	if requests != 3 || failed != 2 || bytes != 1280 {
+		t.Fatalf("unexpected requests: %d, failed: %d, bytes: %f", requests, failed, bytes)
+	}
+}
+
+func TestPageTimingsMetrics(t *testing.T) {
+	var timings pageTimings
+	err := json.Unmarshal([]byte(`{"ttfb":120.5,"fcp":800,"lcp":1500,"cls":0,"dcl":900,"load":0}`), &timings)
+	if err != nil {
+		t.Fatalf("error deserializing timings: %s", err)
+	}
+
+	metrics := map[string]float64{}
+	timings.add(metrics, pagePrefix(0))
+	timings.add(metrics, pagePrefix(1))
+
+	expected := map[string]float64{
+		"web_ttfb_seconds":                     0.1205,
+		"web_fcp_seconds":                      0.8,
+		"web_lcp_seconds":                      1.5,
+		"web_cls":                              0,
+		"web_dom_content_loaded_seconds":       0.9,
+		"web_page2_ttfb_seconds":               0.1205,
+		"web_page2_fcp_seconds":                0.8,
+		"web_page2_lcp_seconds":                1.5,
+		"web_page2_cls":                        0,
+		"web_page2_dom_content_loaded_seconds": 0.9,
+	}
+
+	if len(metrics) != len(expected) {
+		t.Fatalf("unexpected metrics: %#v", metrics)
+	}
+
+	for name, value := range expected {
+		if v, ok := metrics[name]; !ok || v != value {
+			t.Fatalf("unexpected metric %s: %f, expected %f", name, v, value)
+		}
+	}
+
+	merged := addMetrics(map[string]float64{"custom": 1}, metrics)
+	if len(merged) != len(expected)+1 || merged["custom"] != 1 {
+		t.Fatalf("unexpected merged metrics: %#v", merged)
+	}
+}
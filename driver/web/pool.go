@@ -0,0 +1,471 @@
+package web
+
+/*
+   Copyright 2019 Bruno Moura <brunotm@gmail.com>
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"os/exec"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/Unbabel/replicant/log"
+)
+
+// Chrome instance states
+const (
+	// InstanceReady instances are serving transactions
+	InstanceReady = "ready"
+
+	// InstanceDraining instances are waiting for running transactions to finish before recycling
+	InstanceDraining = "draining"
+
+	// InstanceRecycling instances are being restarted, or checked for remote instances
+	InstanceRecycling = "recycling"
+
+	// InstanceFailed instances could not be restarted and are retried with backoff
+	InstanceFailed = "failed"
+)
+
+const (
+	// maxRestartBackoff between attempts to restart a failed chrome instance
+	maxRestartBackoff = time.Minute
+
+	// processExitTimeout for stopped chrome processes
+	processExitTimeout = 10 * time.Second
+)
+
+// errPoolClosed is returned when checking out instances from a closed pool
+var errPoolClosed = errors.New("chrome pool closed")
+
+// InstanceStatus is the state of a chrome instance in the web driver pool
+type InstanceStatus struct {
+	Address  string    `json:"address" yaml:"address"`
+	Managed  bool      `json:"managed" yaml:"managed"`
+	PID      int       `json:"pid,omitempty" yaml:"pid,omitempty"`
+	State    string    `json:"state" yaml:"state"`
+	InFlight int       `json:"in_flight" yaml:"in_flight"`
+	Runs     int       `json:"runs" yaml:"runs"`
+	Restarts int       `json:"restarts" yaml:"restarts"`
+	Started  time.Time `json:"started,omitempty" yaml:"started,omitempty"`
+	Error    string    `json:"error,omitempty" yaml:"error,omitempty"`
+}
+
+// Health is the state of the web driver chrome pool.
+// The pool is healthy while any of its instances is serving transactions.
+type Health struct {
+	Healthy   bool             `json:"healthy" yaml:"healthy"`
+	Instances []InstanceStatus `json:"instances" yaml:"instances"`
+}
+
+// instance is a managed chrome process or a remote chrome endpoint
+type instance struct {
+	address  string
+	args     []string
+	cmd      *exec.Cmd
+	exited   chan struct{}
+	state    string
+	inFlight int
+	runs     int
+	restarts int
+	started  time.Time
+	err      error
+}
+
+// managed checks if this instance is a chrome process started by the pool
+func (i *instance) managed() (ok bool) {
+	return i.args != nil
+}
+
+// pool of chrome instances checked out by web transactions. Instances are drained and
+// recycled in the background on crashes, after a number of runs or at every interval,
+// without blocking transactions running on other instances.
+type pool struct {
+	mtx       sync.Mutex
+	config    Config
+	instances []*instance
+	changed   chan struct{}
+	closed    bool
+	close     chan struct{}
+	wg        sync.WaitGroup
+}
+
+// newPool creates a pool of managed chrome processes if a binary path is configured,
+// or of the remote chrome endpoints otherwise
+func newPool(c Config) (p *pool, err error) {
+	p = &pool{config: c, changed: make(chan struct{}), close: make(chan struct{})}
+
+	if c.BinaryPath == "" {
+		for _, u := range append([]string{c.ServerURL}, c.ServerURLs...) {
+			if u == "" {
+				continue
+			}
+			p.instances = append(p.instances, &instance{address: u, state: InstanceReady, started: time.Now()})
+		}
+		return p, nil
+	}
+
+	u, err := url.Parse(c.ServerURL)
+	if err != nil {
+		return nil, fmt.Errorf("driver/web: could not parse chrome server url: %w", err)
+	}
+
+	port, err := strconv.Atoi(u.Port())
+	if err != nil {
+		return nil, fmt.Errorf("driver/web: invalid chrome server port: %s", c.ServerURL)
+	}
+
+	for x := 0; x < c.Instances; x++ {
+		i := &instance{state: InstanceRecycling}
+		i.address = "http://" + net.JoinHostPort(u.Hostname(), strconv.Itoa(port+x))
+		i.args = instanceArgs(c.BinaryArgs, port+x, x, c.Instances)
+		p.instances = append(p.instances, i)
+	}
+
+	// start all instances, only failing for errors launching chrome
+	// as unreachable instances are retried in the background
+	for _, i := range p.instances {
+		if err = p.start(i); err != nil {
+			p.stop()
+			return nil, err
+		}
+	}
+
+	for _, i := range p.instances {
+		p.wg.Add(1)
+		go p.recycle(i, false)
+	}
+
+	return p, nil
+}
+
+// acquire checks out the least busy ready instance, waiting for
+// an instance to become available until the given context is done
+func (p *pool) acquire(ctx context.Context) (i *instance, err error) {
+	for {
+		p.mtx.Lock()
+		if p.closed {
+			p.mtx.Unlock()
+			return nil, errPoolClosed
+		}
+
+		for _, c := range p.instances {
+			if c.state != InstanceReady {
+				continue
+			}
+
+			if p.config.RecycleInterval > 0 && c.managed() && time.Since(c.started) > p.config.RecycleInterval {
+				log.Info("recycling chrome instance after interval").String("address", c.address).Log()
+				p.drain(c, nil)
+				continue
+			}
+
+			if (p.config.Concurrency < 1 || c.inFlight < p.config.Concurrency) && (i == nil || c.inFlight < i.inFlight) {
+				i = c
+			}
+		}
+
+		if i != nil {
+			i.inFlight++
+			p.mtx.Unlock()
+			return i, nil
+		}
+
+		changed := p.changed
+		p.mtx.Unlock()
+
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return nil, fmt.Errorf("no chrome instance available: %w", ctx.Err())
+		}
+	}
+}
+
+// release returns an instance checked out by a transaction. Instances of failed
+// transactions are checked for availability and recycled if unreachable.
+func (p *pool) release(i *instance, failed bool) {
+	var err error
+	if failed {
+		err = dial(i.address)
+	}
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	i.inFlight--
+	i.runs++
+
+	switch {
+	case err != nil:
+		log.Warn("chrome instance unreachable, recycling").String("address", i.address).Error("error", err).Log()
+		p.drain(i, err)
+	case p.config.MaxRuns > 0 && i.managed() && i.runs >= p.config.MaxRuns:
+		log.Info("recycling chrome instance after max runs").String("address", i.address).Int("runs", int64(i.runs)).Log()
+		p.drain(i, nil)
+	case i.state == InstanceDraining:
+		p.drain(i, i.err)
+	default:
+		p.notify()
+	}
+}
+
+// drain stops checking out the given instance, recycling it once
+// the running transactions are finished. Must be called with the pool lock held.
+func (p *pool) drain(i *instance, err error) {
+	if i.state == InstanceReady {
+		i.state = InstanceDraining
+	}
+
+	if err != nil {
+		i.err = err
+	}
+
+	if i.state == InstanceDraining && i.inFlight == 0 && !p.closed {
+		i.state = InstanceRecycling
+		p.wg.Add(1)
+		go p.recycle(i, true)
+	}
+
+	p.notify()
+}
+
+// recycle restarts managed instances or waits for remote instances to be reachable,
+// retrying with backoff until successful or the pool is closed
+func (p *pool) recycle(i *instance, restart bool) {
+	defer p.wg.Done()
+
+	backoff := time.Second
+	for {
+		var err error
+		if restart && i.managed() {
+			p.kill(i)
+			err = p.start(i)
+		}
+
+		if err == nil {
+			err = waitForConn(i.address, 5, time.Second, p.close)
+		}
+
+		p.mtx.Lock()
+		if err == nil {
+			if restart {
+				i.restarts++
+			}
+			i.state = InstanceReady
+			i.runs = 0
+			i.err = nil
+			i.started = time.Now()
+			p.notify()
+			p.mtx.Unlock()
+
+			log.Info("chrome instance ready").String("address", i.address).Log()
+			return
+		}
+
+		i.state = InstanceFailed
+		i.err = err
+		p.mtx.Unlock()
+
+		log.Error("error recycling chrome instance").String("address", i.address).
+			Error("error", err).Int("retry_seconds", int64(backoff/time.Second)).Log()
+
+		select {
+		case <-time.After(backoff):
+		case <-p.close:
+			return
+		}
+
+		restart = true
+		if backoff *= 2; backoff > maxRestartBackoff {
+			backoff = maxRestartBackoff
+		}
+	}
+}
+
+// start a managed chrome process, setting the process group id to avoid
+// leaving zombies upon termination. Processes exiting on their own are recycled.
+func (p *pool) start(i *instance) (err error) {
+	cmd := exec.Command(p.config.BinaryPath, i.args...)
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	if err = cmd.Start(); err != nil {
+		return fmt.Errorf("driver/web: error starting chrome process: %w", err)
+	}
+
+	exited := make(chan struct{})
+	p.mtx.Lock()
+	i.cmd = cmd
+	i.exited = exited
+	p.mtx.Unlock()
+
+	log.Info("chrome process created").String("address", i.address).Int("pid", int64(cmd.Process.Pid)).Log()
+
+	go func() {
+		err := cmd.Wait()
+		close(exited)
+
+		p.mtx.Lock()
+		defer p.mtx.Unlock()
+
+		// processes are expected to exit when recycled or closed
+		if p.closed || i.cmd != cmd || i.state == InstanceRecycling || i.state == InstanceFailed {
+			return
+		}
+
+		log.Error("chrome process exited unexpectedly").String("address", i.address).
+			Int("pid", int64(cmd.Process.Pid)).Error("error", err).Log()
+		p.drain(i, fmt.Errorf("chrome process exited: %v", err))
+	}()
+
+	return nil
+}
+
+// kill the managed chrome process and its children, waiting for it to exit
+func (p *pool) kill(i *instance) {
+	p.mtx.Lock()
+	cmd, exited := i.cmd, i.exited
+	p.mtx.Unlock()
+
+	if cmd == nil {
+		return
+	}
+
+	if err := syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL); err != nil && err != syscall.ESRCH {
+		log.Error("error stopping chrome process").Int("pid", int64(cmd.Process.Pid)).Error("error", err).Log()
+	}
+
+	select {
+	case <-exited:
+	case <-time.After(processExitTimeout):
+		log.Error("timed out waiting for chrome process to exit").Int("pid", int64(cmd.Process.Pid)).Log()
+	}
+}
+
+// stop the pool and its managed chrome processes
+func (p *pool) stop() {
+	p.mtx.Lock()
+	if p.closed {
+		p.mtx.Unlock()
+		return
+	}
+	p.closed = true
+	close(p.close)
+	p.notify()
+	p.mtx.Unlock()
+
+	p.wg.Wait()
+
+	for _, i := range p.instances {
+		p.kill(i)
+	}
+}
+
+// health returns the state of the pool instances
+func (p *pool) health() (h Health) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	for _, i := range p.instances {
+		s := InstanceStatus{
+			Address:  i.address,
+			Managed:  i.managed(),
+			State:    i.state,
+			InFlight: i.inFlight,
+			Runs:     i.runs,
+			Restarts: i.restarts,
+			Started:  i.started,
+		}
+
+		if i.cmd != nil {
+			s.PID = i.cmd.Process.Pid
+		}
+
+		if i.err != nil {
+			s.Error = i.err.Error()
+		}
+
+		h.Healthy = h.Healthy || i.state == InstanceReady || i.state == InstanceDraining
+		h.Instances = append(h.Instances, s)
+	}
+
+	return h
+}
+
+// notify goroutines waiting on acquire of instance state changes.
+// Must be called with the pool lock held.
+func (p *pool) notify() {
+	close(p.changed)
+	p.changed = make(chan struct{})
+}
+
+// instanceArgs sets the remote debugging port for the given instance, and a distinct
+// user data directory when running several instances with a configured one
+func instanceArgs(args []string, port, x, instances int) (a []string) {
+	a = []string{}
+	for _, arg := range args {
+		switch {
+		case strings.HasPrefix(arg, "--remote-debugging-port="):
+			continue
+		case strings.HasPrefix(arg, "--user-data-dir=") && instances > 1:
+			arg = arg + "-" + strconv.Itoa(x)
+		}
+		a = append(a, arg)
+	}
+
+	return append(a, "--remote-debugging-port="+strconv.Itoa(port))
+}
+
+// dial checks if the chrome instance at the given address is reachable
+func dial(address string) (err error) {
+	u, err := url.Parse(address)
+	if err != nil {
+		return err
+	}
+
+	conn, err := net.DialTimeout("tcp", u.Host, time.Second)
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
+
+// waitForConn waits for a successful TCP connection to the specified address
+// for the given number of retries beetween the given interval, or until done is closed.
+func waitForConn(address string, retries int, interval time.Duration, done chan struct{}) (err error) {
+	for x := 0; x < retries; x++ {
+		log.Debug("driver/web: checking chrome instance availability").String("address", address).Log()
+
+		select {
+		case <-time.After(interval):
+		case <-done:
+			return errPoolClosed
+		}
+
+		if err = dial(address); err == nil {
+			log.Debug("driver/web: successfully connected to chrome instance").String("address", address).Log()
+			return nil
+		}
+	}
+
+	return fmt.Errorf("error connecting to chrome instance: %w", err)
+}
@@ -0,0 +1,211 @@
+package web
+
+import (
+	"context"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+)
+
+// TestHelperChrome is not a real test, it listens on the remote debugging
+// port as a stand in for chrome processes managed by the pool
+func TestHelperChrome(t *testing.T) {
+	if os.Getenv("REPLICANT_HELPER_CHROME") != "1" {
+		return
+	}
+
+	var port string
+	for _, arg := range os.Args {
+		if strings.HasPrefix(arg, "--remote-debugging-port=") {
+			port = strings.TrimPrefix(arg, "--remote-debugging-port=")
+		}
+	}
+
+	l, err := net.Listen("tcp", "127.0.0.1:"+port)
+	if err != nil {
+		os.Exit(1)
+	}
+
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			os.Exit(1)
+		}
+		conn.Close()
+	}
+}
+
+func freePort(t *testing.T) (port int) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("error finding free port: %s", err)
+	}
+	defer l.Close()
+	return l.Addr().(*net.TCPAddr).Port
+}
+
+// waitInstances waits for all pool instances to be ready
+func waitInstances(t *testing.T, p *pool, check func(h Health) bool) (h Health) {
+	deadline := time.Now().Add(20 * time.Second)
+	for {
+		h = p.health()
+		ready := h.Healthy
+		for _, i := range h.Instances {
+			ready = ready && i.State == InstanceReady
+		}
+
+		if ready && (check == nil || check(h)) {
+			return h
+		}
+
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for instances: %#v", h)
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+}
+
+func TestPoolManaged(t *testing.T) {
+	os.Setenv("REPLICANT_HELPER_CHROME", "1")
+	defer os.Unsetenv("REPLICANT_HELPER_CHROME")
+
+	d, err := New(Config{
+		ServerURL:  "http://127.0.0.1:" + strconv.Itoa(freePort(t)),
+		BinaryPath: os.Args[0],
+		BinaryArgs: []string{"-test.run=TestHelperChrome", "--", "--remote-debugging-port=9222"},
+		Instances:  2,
+		MaxRuns:    2,
+	})
+	if err != nil {
+		t.Fatalf("error creating driver: %s", err)
+	}
+	defer d.Close()
+
+	h := waitInstances(t, d.pool, nil)
+	if len(h.Instances) != 2 || h.Instances[0].Address == h.Instances[1].Address {
+		t.Fatalf("expected 2 instances on distinct addresses: %#v", h)
+	}
+
+	// instances are checked out exclusively
+	ctx := context.Background()
+	first, err := d.pool.acquire(ctx)
+	if err != nil {
+		t.Fatalf("error acquiring instance: %s", err)
+	}
+
+	second, err := d.pool.acquire(ctx)
+	if err != nil {
+		t.Fatalf("error acquiring instance: %s", err)
+	}
+
+	if first == second {
+		t.Fatalf("same instance checked out twice")
+	}
+
+	d.pool.release(second, false)
+
+	// recycled after max runs while the other instance is checked out
+	x := 0
+	if second == d.pool.instances[1] {
+		x = 1
+	}
+
+	if second, err = d.pool.acquire(ctx); err != nil {
+		t.Fatalf("error acquiring instance: %s", err)
+	}
+	d.pool.release(second, false)
+
+	deadline := time.Now().Add(20 * time.Second)
+	for {
+		r := d.Health()
+		if r.Instances[x].State == InstanceReady && r.Instances[x].Restarts == 1 {
+			if r.Instances[x].PID == h.Instances[x].PID || r.Instances[1-x].State != InstanceReady ||
+				r.Instances[1-x].InFlight != 1 {
+				t.Fatalf("unexpected instance states after recycling: %#v", r)
+			}
+			break
+		}
+
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for recycled instance: %#v", r)
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+	d.pool.release(first, false)
+
+	// crashed processes are restarted
+	h = d.Health()
+	if err = syscall.Kill(h.Instances[0].PID, syscall.SIGKILL); err != nil {
+		t.Fatalf("error killing helper process: %s", err)
+	}
+
+	waitInstances(t, d.pool, func(c Health) bool {
+		return c.Instances[0].PID != h.Instances[0].PID && c.Instances[0].Restarts == h.Instances[0].Restarts+1
+	})
+}
+
+func TestPoolRemote(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("error listening: %s", err)
+	}
+	defer l.Close()
+
+	d, err := New(Config{ServerURL: "http://" + l.Addr().String(), Concurrency: 1})
+	if err != nil {
+		t.Fatalf("error creating driver: %s", err)
+	}
+	defer d.Close()
+
+	i, err := d.pool.acquire(context.Background())
+	if err != nil {
+		t.Fatalf("error acquiring instance: %s", err)
+	}
+
+	// no instances available until released
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	if _, err = d.pool.acquire(ctx); err == nil {
+		t.Fatalf("expected error acquiring instance with no available instances")
+	}
+
+	// unreachable instances are taken out of the pool
+	l.Close()
+	d.pool.release(i, true)
+
+	h := d.Health()
+	if h.Healthy || h.Instances[0].State == InstanceReady || h.Instances[0].Error == "" {
+		t.Fatalf("expected unhealthy pool with unreachable instance: %#v", h)
+	}
+}
+
+func TestPoolUnlimitedConcurrency(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("error listening: %s", err)
+	}
+	defer l.Close()
+
+	d, err := New(Config{ServerURL: "http://" + l.Addr().String()})
+	if err != nil {
+		t.Fatalf("error creating driver: %s", err)
+	}
+	defer d.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	for x := 0; x < 3; x++ {
+		if _, err = d.pool.acquire(ctx); err != nil {
+			t.Fatalf("error acquiring instance without concurrency limit: %s", err)
+		}
+	}
+
+	if h := d.Health(); h.Instances[0].InFlight != 3 {
+		t.Fatalf("expected 3 transactions in flight: %#v", h)
+	}
+}
@@ -0,0 +1,443 @@
+package web
+
+/*
+   Copyright 2019 Bruno Moura <brunotm@gmail.com>
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/MontFerret/ferret/pkg/drivers"
+	fcdp "github.com/MontFerret/ferret/pkg/drivers/cdp"
+	"github.com/Unbabel/replicant/log"
+	"github.com/Unbabel/replicant/transaction"
+	"github.com/mafredri/cdp"
+	"github.com/mafredri/cdp/devtool"
+	"github.com/mafredri/cdp/protocol/dom"
+	"github.com/mafredri/cdp/protocol/emulation"
+	"github.com/mafredri/cdp/protocol/network"
+	"github.com/mafredri/cdp/protocol/page"
+	"github.com/mafredri/cdp/protocol/target"
+	"github.com/mafredri/cdp/rpcc"
+	"github.com/mafredri/cdp/session"
+)
+
+const (
+	// captureTimeout for capturing artifacts, as transactions often fail by timing out
+	captureTimeout = 10 * time.Second
+
+	// maxScreenshotHeight for full page screenshots
+	maxScreenshotHeight = 16384
+)
+
+// recorder is a ferret cdp driver that records the network activity and console
+// messages of the pages opened by a transaction. Pages are kept open after the
+// transaction script finishes, so that artifacts can be captured from them.
+type recorder struct {
+	mtx        sync.Mutex
+	dev        *devtool.DevTools
+	conn       *rpcc.Conn
+	browserURL string
+	client     *cdp.Client
+	session    *session.Manager
+	contextID  target.BrowserContextID
+	pages      []*recordedPage
+}
+
+// recordedPage is a ferret page with its recorded activity.
+// Pages driven by other cdp clients have no ferret page and own their connection.
+type recordedPage struct {
+	drivers.HTMLPage
+	id     target.ID
+	conn   *rpcc.Conn
+	client *cdp.Client
+	log    *pageLog
+	wg     sync.WaitGroup
+}
+
+func newRecorder(address string) (r *recorder) {
+	return &recorder{dev: devtool.New(address)}
+}
+
+// Name returns the ferret cdp driver name, so that the recorder is used
+// in place of the ferret cdp driver
+func (r *recorder) Name() (name string) {
+	return fcdp.DriverName
+}
+
+// Open a recorded page
+func (r *recorder) Open(ctx context.Context, params drivers.Params) (p drivers.HTMLPage, err error) {
+	rp, conn, err := r.open(ctx, params.KeepCookies)
+	if err != nil {
+		return nil, err
+	}
+
+	if params.Viewport == nil {
+		params.Viewport = &drivers.Viewport{Width: 1600, Height: 900}
+	}
+
+	if rp.HTMLPage, err = fcdp.LoadHTMLPage(ctx, conn, params); err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	r.mtx.Lock()
+	r.pages = append(r.pages, rp)
+	r.mtx.Unlock()
+
+	return rp, nil
+}
+
+// page opens a recorded blank page for being driven by other cdp clients, eg: chromedp.
+// The page is closed with the recorder.
+func (r *recorder) page(ctx context.Context) (id target.ID, err error) {
+	rp, conn, err := r.open(ctx, false)
+	if err != nil {
+		return "", err
+	}
+
+	if err = rp.client.Page.Enable(ctx); err != nil {
+		conn.Close()
+		return "", fmt.Errorf("driver/web: error enabling page events: %w", err)
+	}
+
+	if err = rp.client.Network.Enable(ctx, network.NewEnableArgs()); err != nil {
+		conn.Close()
+		return "", fmt.Errorf("driver/web: error enabling network events: %w", err)
+	}
+
+	err = rp.client.Emulation.SetDeviceMetricsOverride(ctx,
+		emulation.NewSetDeviceMetricsOverrideArgs(1600, 900, 1, false))
+	if err != nil {
+		conn.Close()
+		return "", fmt.Errorf("driver/web: error setting page viewport: %w", err)
+	}
+
+	rp.conn = conn
+	r.mtx.Lock()
+	r.pages = append(r.pages, rp)
+	r.mtx.Unlock()
+
+	return rp.id, nil
+}
+
+// open a browser target with its activity recorded
+func (r *recorder) open(ctx context.Context, keepCookies bool) (rp *recordedPage, conn *rpcc.Conn, err error) {
+	if err = r.init(ctx); err != nil {
+		return nil, nil, err
+	}
+
+	args := target.NewCreateTargetArgs(fcdp.BlankPageURL)
+	if !keepCookies {
+		args.SetBrowserContextID(r.contextID)
+	}
+
+	created, err := r.client.Target.CreateTarget(ctx, args)
+	if err != nil {
+		return nil, nil, fmt.Errorf("driver/web: error creating browser target: %w", err)
+	}
+
+	conn, err = r.session.Dial(ctx, created.TargetID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("driver/web: error connecting to browser target: %w", err)
+	}
+
+	rp = &recordedPage{id: created.TargetID, client: cdp.NewClient(conn), log: newPageLog()}
+	if err = rp.record(ctx); err != nil {
+		conn.Close()
+		return nil, nil, fmt.Errorf("driver/web: error recording page activity: %w", err)
+	}
+
+	return rp, conn, nil
+}
+
+// artifacts captures a full page screenshot, the DOM, console messages and HAR from the
+// recorded pages, added after the given script artifacts within the artifact size limits.
+// Artifact names are suffixed by the page number when there are multiple pages.
+func (r *recorder) artifacts(attached []transaction.Artifact) (artifacts []transaction.Artifact) {
+	r.mtx.Lock()
+	pages := append([]*recordedPage{}, r.pages...)
+	r.mtx.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), captureTimeout)
+	defer cancel()
+
+	collected := &transaction.Artifacts{}
+	for _, a := range attached {
+		collected.Add(a.Name, a.ContentType, a.Data)
+	}
+
+	add := func(name, contentType string, data []byte) {
+		if err := collected.Add(name, contentType, data); err != nil {
+			log.Warn("discarding page artifact").String("driver", "web").Error("error", err).Log()
+		}
+	}
+
+	for x, p := range pages {
+		var suffix string
+		if len(pages) > 1 {
+			suffix = fmt.Sprintf("-%d", x+1)
+		}
+
+		if data, err := p.screenshot(ctx); err != nil {
+			log.Warn("error capturing page screenshot").String("driver", "web").Error("error", err).Log()
+		} else {
+			add("screenshot"+suffix+".png", "image/png", data)
+		}
+
+		if data, err := p.dom(ctx); err != nil {
+			log.Warn("error capturing page dom").String("driver", "web").Error("error", err).Log()
+		} else {
+			add("dom"+suffix+".html", "text/html; charset=utf-8", data)
+		}
+
+		if data, err := p.log.consoleLog(); err == nil {
+			add("console"+suffix+".json", "application/json", data)
+		}
+
+		if data, err := p.log.har(); err == nil {
+			add("network"+suffix+".har", "application/json", data)
+		}
+	}
+
+	return collected.List()
+}
+
+// Close the recorded pages and the browser connection
+func (r *recorder) Close() (err error) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	for _, p := range r.pages {
+		if p.HTMLPage != nil && !p.IsClosed() {
+			p.HTMLPage.Close()
+		}
+		if p.conn != nil {
+			p.conn.Close()
+		}
+		p.wg.Wait()
+	}
+	r.pages = nil
+
+	if r.session == nil {
+		return nil
+	}
+
+	if r.contextID != "" {
+		ctx, cancel := context.WithTimeout(context.Background(), captureTimeout)
+		r.client.Target.DisposeBrowserContext(ctx, target.NewDisposeBrowserContextArgs(r.contextID))
+		cancel()
+	}
+
+	r.session.Close()
+	return r.conn.Close()
+}
+
+func (r *recorder) init(ctx context.Context) (err error) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	if r.session != nil {
+		return nil
+	}
+
+	version, err := r.dev.Version(ctx)
+	if err != nil {
+		return fmt.Errorf("driver/web: error initializing driver: %w", err)
+	}
+
+	conn, err := rpcc.DialContext(ctx, version.WebSocketDebuggerURL)
+	if err != nil {
+		return fmt.Errorf("driver/web: error initializing driver: %w", err)
+	}
+
+	client := cdp.NewClient(conn)
+	sess, err := session.NewManager(client)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("driver/web: error initializing driver: %w", err)
+	}
+
+	created, err := client.Target.CreateBrowserContext(ctx)
+	if err != nil {
+		sess.Close()
+		conn.Close()
+		return fmt.Errorf("driver/web: error creating browser context: %w", err)
+	}
+
+	r.conn = conn
+	r.browserURL = version.WebSocketDebuggerURL
+	r.client = client
+	r.session = sess
+	r.contextID = created.BrowserContextID
+	return nil
+}
+
+// Close defers closing the page to the recorder
+func (p *recordedPage) Close() (err error) {
+	return nil
+}
+
+// record the page network activity and console messages until the page connection is closed
+func (p *recordedPage) record(ctx context.Context) (err error) {
+	// streams must outlive the transaction context for recording until the page is closed
+	sctx := context.Background()
+
+	requests, err := p.client.Network.RequestWillBeSent(sctx)
+	if err != nil {
+		return err
+	}
+	p.receive(func() error {
+		ev, err := requests.Recv()
+		if err == nil {
+			p.log.requestWillBeSent(ev)
+		}
+		return err
+	})
+
+	responses, err := p.client.Network.ResponseReceived(sctx)
+	if err != nil {
+		return err
+	}
+	p.receive(func() error {
+		ev, err := responses.Recv()
+		if err == nil {
+			p.log.responseReceived(ev)
+		}
+		return err
+	})
+
+	finished, err := p.client.Network.LoadingFinished(sctx)
+	if err != nil {
+		return err
+	}
+	p.receive(func() error {
+		ev, err := finished.Recv()
+		if err == nil {
+			p.log.loadingFinished(ev)
+		}
+		return err
+	})
+
+	failed, err := p.client.Network.LoadingFailed(sctx)
+	if err != nil {
+		return err
+	}
+	p.receive(func() error {
+		ev, err := failed.Recv()
+		if err == nil {
+			p.log.loadingFailed(ev)
+		}
+		return err
+	})
+
+	console, err := p.client.Runtime.ConsoleAPICalled(sctx)
+	if err != nil {
+		return err
+	}
+	p.receive(func() error {
+		ev, err := console.Recv()
+		if err == nil {
+			p.log.consoleAPICalled(ev)
+		}
+		return err
+	})
+
+	exceptions, err := p.client.Runtime.ExceptionThrown(sctx)
+	if err != nil {
+		return err
+	}
+	p.receive(func() error {
+		ev, err := exceptions.Recv()
+		if err == nil {
+			p.log.exceptionThrown(ev)
+		}
+		return err
+	})
+
+	entries, err := p.client.Log.EntryAdded(sctx)
+	if err != nil {
+		return err
+	}
+	p.receive(func() error {
+		ev, err := entries.Recv()
+		if err == nil {
+			p.log.entryAdded(ev)
+		}
+		return err
+	})
+
+	// the page and network domains are enabled when loading the ferret page
+	if err = p.client.Runtime.Enable(ctx); err != nil {
+		return err
+	}
+
+	return p.client.Log.Enable(ctx)
+}
+
+// receive events with the given function until the stream is closed
+func (p *recordedPage) receive(recv func() error) {
+	p.wg.Add(1)
+	go func() {
+		defer p.wg.Done()
+		for recv() == nil {
+		}
+	}()
+}
+
+// screenshot captures a png screenshot of the full page
+func (p *recordedPage) screenshot(ctx context.Context) (data []byte, err error) {
+	metrics, err := p.client.Page.GetLayoutMetrics(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	width := int(math.Ceil(metrics.ContentSize.Width))
+	height := int(math.Min(math.Ceil(metrics.ContentSize.Height), maxScreenshotHeight))
+
+	if width > 0 && height > 0 {
+		err = p.client.Emulation.SetDeviceMetricsOverride(ctx,
+			emulation.NewSetDeviceMetricsOverrideArgs(width, height, 1, false))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	reply, err := p.client.Page.CaptureScreenshot(ctx, page.NewCaptureScreenshotArgs().SetFormat("png"))
+	if err != nil {
+		return nil, err
+	}
+
+	return reply.Data, nil
+}
+
+// dom returns the serialized html of the current document
+func (p *recordedPage) dom(ctx context.Context) (data []byte, err error) {
+	doc, err := p.client.DOM.GetDocument(ctx, dom.NewGetDocumentArgs())
+	if err != nil {
+		return nil, err
+	}
+
+	html, err := p.client.DOM.GetOuterHTML(ctx, dom.NewGetOuterHTMLArgs().SetNodeID(doc.Root.NodeID))
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(html.OuterHTML), nil
+}
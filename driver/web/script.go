@@ -0,0 +1,279 @@
+package web
+
+/*
+   Copyright 2019 Bruno Moura <brunotm@gmail.com>
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+import (
+	"context"
+	"fmt"
+
+	fcdp "github.com/MontFerret/ferret/pkg/drivers/cdp"
+	gd "github.com/Unbabel/replicant/driver/go"
+	"github.com/Unbabel/replicant/driver/go/helpers"
+	"github.com/Unbabel/replicant/driver/javascript"
+	"github.com/Unbabel/replicant/driver/js2"
+	"github.com/Unbabel/replicant/driver/web/symbols"
+	"github.com/Unbabel/replicant/log"
+	"github.com/Unbabel/replicant/transaction"
+	"github.com/chromedp/cdproto/target"
+	"github.com/chromedp/chromedp"
+	"github.com/dop251/goja"
+)
+
+// TxFunc is the Run function signature called from Go transaction scripts.
+// Package name must be transaction, "transaction.Run".
+type TxFunc func(context.Context) (message, data string, err error)
+
+// ScriptTransaction is a pre-compiled replicant transaction for Go or javascript
+// scripts driving a chrome page through chromedp
+type ScriptTransaction struct {
+	driver    *Driver
+	config    transaction.Config
+	mode      string
+	artifacts string
+	program   *goja.Program
+}
+
+// scriptContext is the chromedp context for running transaction scripts.
+// Values are looked up in the transaction context when not found in the chromedp context.
+type scriptContext struct {
+	context.Context
+	values context.Context
+}
+
+// Value returns the value associated with this context for key
+func (c scriptContext) Value(key interface{}) (v interface{}) {
+	if v = c.Context.Value(key); v != nil {
+		return v
+	}
+	return c.values.Value(key)
+}
+
+// newScript creates a Go or javascript web transaction
+func (d *Driver) newScript(config transaction.Config, mode, artifacts string) (tx transaction.Transaction, err error) {
+	txn := &ScriptTransaction{}
+	txn.driver = d
+	txn.config = config
+	txn.mode = mode
+	txn.artifacts = artifacts
+
+	if mode == ModeJavaScript {
+		if txn.program, err = goja.Compile(config.Name, config.Script, false); err != nil {
+			return nil, fmt.Errorf("driver/web: error compiling transaction script: %w", err)
+		}
+		return txn, nil
+	}
+
+	// Go scripts are only evaluated when running, so that no script code
+	// runs outside of executors
+	if err = gd.ParseScript(config.Script, "transaction", "Run", 1); err != nil {
+		return nil, fmt.Errorf("driver/web: error parsing transaction script: %w", err)
+	}
+
+	return txn, nil
+}
+
+// Config returns the transaction config
+func (t *ScriptTransaction) Config() (config transaction.Config) {
+	return t.config
+}
+
+// Run executes the web transaction script against a recorded page on the chrome instance
+func (t *ScriptTransaction) Run(ctx context.Context) (result transaction.Result) {
+	result.Name = t.config.Name
+	result.Driver = "web"
+	result.Metadata = t.config.Metadata
+
+	instance, err := t.driver.pool.acquire(ctx)
+	if err != nil {
+		result.Error = fmt.Errorf("driver/web: %w", err)
+		result.Failed = true
+		return result
+	}
+	defer func() { t.driver.pool.release(instance, result.Failed) }()
+
+	// handle browserless mode for testing
+	address := instance.address
+	if t.driver.config.testing {
+		address = fcdp.DefaultAddress
+	}
+
+	rec := newRecorder(address)
+	defer rec.Close()
+
+	id, err := rec.page(ctx)
+	if err != nil {
+		result.Error = err
+		result.Failed = true
+		return result
+	}
+
+	// the chromedp context is not bound to the transaction context,
+	// so that artifacts can still be captured after timeouts
+	allocCtx, cancel := chromedp.NewRemoteAllocator(context.Background(), rec.browserURL)
+	defer cancel()
+
+	browserCtx, cancel := chromedp.NewContext(allocCtx, chromedp.WithTargetID(target.ID(id)),
+		chromedp.WithErrorf(func(format string, args ...interface{}) {
+			log.Debug("chromedp error").String("driver", "web").String("error", fmt.Sprintf(format, args...)).Log()
+		}))
+	defer cancel()
+
+	if err = chromedp.Run(browserCtx); err != nil {
+		result.Error = fmt.Errorf("driver/web: error connecting to browser page: %w", err)
+		result.Failed = true
+		return result
+	}
+
+	// scripts are bound to the transaction context
+	runCtx, cancel := context.WithCancel(browserCtx)
+	defer cancel()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		var cancelDeadline context.CancelFunc
+		runCtx, cancelDeadline = context.WithDeadline(runCtx, deadline)
+		defer cancelDeadline()
+	}
+
+	go func() {
+		select {
+		case <-ctx.Done():
+			cancel()
+		case <-runCtx.Done():
+		}
+	}()
+
+	switch t.mode {
+	case ModeGo:
+		t.runGo(scriptContext{Context: runCtx, values: ctx}, &result)
+	case ModeJavaScript:
+		t.runJS(scriptContext{Context: runCtx, values: ctx}, &result)
+	}
+
+	result.Metrics = addMetrics(result.Metrics, rec.metrics())
+
+	if t.artifacts == ArtifactsAlways || (t.artifacts == ArtifactsOnFailure && result.Failed) {
+		result.Artifacts = rec.artifacts(result.Artifacts)
+	}
+
+	return result
+}
+
+// runGo evaluates and runs the Go transaction script
+func (t *ScriptTransaction) runGo(ctx context.Context, result *transaction.Result) {
+	run, err := t.eval()
+	if err != nil {
+		result.Error = err
+		result.Failed = true
+		return
+	}
+
+	ctx = context.WithValue(ctx, helpers.InputsKey, t.config.Inputs)
+
+	artifacts := &transaction.Artifacts{}
+	ctx = context.WithValue(ctx, helpers.ArtifactsKey, artifacts)
+	defer func() { result.Artifacts = artifacts.List() }()
+
+	m, d, err := run(ctx)
+	result.Message = m
+	result.Data = d
+
+	if err != nil {
+		result.Error = fmt.Errorf("driver/web: error running transaction: %w", err)
+		result.Failed = true
+	}
+}
+
+// eval the Go transaction script returning its Run function
+func (t *ScriptTransaction) eval() (run TxFunc, err error) {
+	i, err := gd.NewInterpreter(symbols.Symbols)
+	if err != nil {
+		return nil, fmt.Errorf("driver/web: error creating interpreter: %w", err)
+	}
+
+	if _, err = i.Eval(t.config.Script); err != nil {
+		return nil, fmt.Errorf("driver/web: error initializing transaction script: %w", err)
+	}
+
+	v, err := i.Eval("transaction.Run")
+	if err != nil {
+		return nil, fmt.Errorf("driver/web: error loading transaction program code: %w", err)
+	}
+
+	run, ok := v.Interface().(func(context.Context) (message, data string, err error))
+	if !ok || run == nil {
+		return nil, fmt.Errorf(
+			`driver/web: transaction.Run doesn't implement "func(context.Context) (message, data string, err error)" signature`)
+	}
+
+	return run, nil
+}
+
+// runJS runs the javascript transaction script with the replicant and browser objects
+func (t *ScriptTransaction) runJS(ctx context.Context, result *transaction.Result) {
+	vm := goja.New()
+	defer js2.Interrupt(ctx, vm)()
+
+	bindings := javascript.NewBindings(ctx)
+	defer bindings.Close()
+	defer func() { result.Artifacts = bindings.Artifacts() }()
+
+	b := newBrowser(ctx)
+	defer b.close()
+
+	if err := t.load(vm, bindings, b); err != nil {
+		result.Failed = true
+		result.Error = js2.RunError(ctx, "web", err)
+		return
+	}
+
+	uuid, _ := ctx.Value("transaction_uuid").(string)
+	// bindings return early on cancellation, so scripts can complete after the timeout
+	res, err := js2.Call(vm, "Run", map[string]interface{}{"UUID": uuid})
+	if err == nil {
+		err = ctx.Err()
+	}
+	if err != nil {
+		result.Failed = true
+		result.Error = js2.RunError(ctx, "web", fmt.Errorf("driver/web: error running transaction: %w", err))
+		return
+	}
+
+	result.Data = res.Data
+	result.Message = res.Message
+	result.Failed = res.Failed
+}
+
+// load the replicant and browser objects and the transaction script in the runtime
+func (t *ScriptTransaction) load(vm *goja.Runtime, bindings *javascript.Bindings, b *browser) (err error) {
+	if err = js2.Register(vm, bindings); err != nil {
+		return fmt.Errorf("driver/web: %w", err)
+	}
+
+	if err = vm.Set("browser", b); err != nil {
+		return fmt.Errorf("driver/web: error setting browser: %w", err)
+	}
+
+	if _, err = vm.RunString(javascript.ReplicantJS); err != nil {
+		return fmt.Errorf("driver/web: error initializing replicant core objects: %w", err)
+	}
+
+	if _, err = vm.RunProgram(t.program); err != nil {
+		return fmt.Errorf("driver/web: error initializing transaction script: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,173 @@
+package web
+
+import (
+	"context"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/Unbabel/replicant/transaction"
+	"github.com/chromedp/cdproto/network"
+)
+
+var goScript = `package transaction
+
+import (
+	"context"
+
+	"github.com/chromedp/cdproto/network"
+	"github.com/chromedp/chromedp"
+	"replicant"
+)
+
+func Run(ctx context.Context) (message string, data string, err error) {
+	err = chromedp.Run(ctx,
+		network.SetBlockedURLS([]string{"*.png"}),
+		chromedp.Navigate(replicant.Input(ctx, "url")),
+	)
+	if err != nil {
+		return "navigation failed", "", err
+	}
+	return "navigated", "", nil
+}
+`
+
+var jsScript = `
+function Run(ctx) {
+	var result = replicant.NewResult();
+	browser.Listen("Network.responseReceived");
+	browser.Send("Network.setBlockedURLs", {urls: ["*.png"]});
+	browser.Navigate("https://example.com");
+	result.Message = browser.Text("h1");
+	return result.JSON();
+}
+`
+
+func newScriptDriver(t *testing.T) (d *Driver, l net.Listener) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("error listening: %s", err)
+	}
+
+	d, err = New(Config{ServerURL: "http://" + l.Addr().String()})
+	if err != nil {
+		l.Close()
+		t.Fatalf("error creating driver: %s", err)
+	}
+
+	return d, l
+}
+
+func TestDriverScriptModes(t *testing.T) {
+	d, l := newScriptDriver(t)
+	defer l.Close()
+	defer d.Close()
+
+	tests := []struct {
+		mode   string
+		script string
+		err    bool
+	}{
+		{mode: ModeGo, script: goScript},
+		// go scripts are only evaluated when running
+		{mode: ModeGo, script: strings.Replace(goScript, "func Run", "func init() { panic(\"ran\") }\n\nfunc Run", 1)},
+		{mode: ModeJavaScript, script: jsScript},
+		{mode: ModeGo, script: "package transaction\n\nfunc Run() {}\n", err: true},
+		{mode: ModeGo, script: "package transaction\n\nfunc Run(", err: true},
+		{mode: ModeJavaScript, script: "function Run(ctx {", err: true},
+		{mode: "lua", script: jsScript, err: true},
+	}
+
+	for _, test := range tests {
+		tx, err := d.New(transaction.Config{
+			Name:   "script-" + test.mode,
+			Driver: "web",
+			Inputs: map[string]interface{}{"mode": test.mode},
+			Script: test.script,
+		})
+
+		if test.err {
+			if err == nil {
+				t.Fatalf("expected error creating %s transaction", test.mode)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("error creating %s transaction: %s", test.mode, err)
+		}
+
+		if _, ok := tx.(*ScriptTransaction); !ok {
+			t.Fatalf("expected script transaction for %s mode, got %T", test.mode, tx)
+		}
+	}
+}
+
+func TestDriverChecker(t *testing.T) {
+	d := NewChecker()
+	defer d.Close()
+
+	if err := d.Check(transaction.Config{Name: "fql", Driver: "web", Script: "RETURN 1"}); err != nil {
+		t.Fatalf("Check() error = %s", err)
+	}
+
+	if err := d.Check(transaction.Config{Name: "fql", Driver: "web", Script: "RETURN ("}); err == nil {
+		t.Fatal("Check() expected error for invalid script")
+	}
+
+	tx, err := d.New(transaction.Config{Name: "go", Driver: "web",
+		Inputs: map[string]interface{}{"mode": ModeGo}, Script: goScript})
+	if err != nil {
+		t.Fatalf("error creating transaction: %s", err)
+	}
+
+	if result := tx.Run(context.Background()); !result.Failed || result.Error == nil {
+		t.Fatalf("Run() expected error without chrome instances: %#v", result)
+	}
+}
+
+func TestDriverScriptRun(t *testing.T) {
+	d, l := newScriptDriver(t)
+	defer l.Close()
+	defer d.Close()
+
+	// scripts run with a context that is not bound to a browser page
+	// fail on the first chromedp action
+	ctx := context.WithValue(context.Background(), "transaction_uuid", "test")
+
+	for mode, script := range map[string]string{ModeGo: goScript, ModeJavaScript: jsScript} {
+		tx, err := d.New(transaction.Config{
+			Name:   "script-" + mode,
+			Driver: "web",
+			Inputs: map[string]interface{}{"mode": mode, "url": "https://example.com"},
+			Script: script,
+		})
+		if err != nil {
+			t.Fatalf("error creating %s transaction: %s", mode, err)
+		}
+
+		var result transaction.Result
+		txn := tx.(*ScriptTransaction)
+		switch mode {
+		case ModeGo:
+			txn.runGo(ctx, &result)
+		case ModeJavaScript:
+			txn.runJS(ctx, &result)
+		}
+
+		if !result.Failed || result.Error == nil || !strings.Contains(result.Error.Error(), "invalid context") {
+			t.Fatalf("expected %s transaction to fail with invalid context: %#v", mode, result)
+		}
+	}
+}
+
+func TestEventMethod(t *testing.T) {
+	method := eventMethod(&network.EventRequestWillBeSent{})
+	if method != "network.requestWillBeSent" || method != eventKey("Network.requestWillBeSent") {
+		t.Fatalf("unexpected event method: %s", method)
+	}
+
+	if method = eventMethod(&network.Request{}); method != "" {
+		t.Fatalf("expected no event method for non event types: %s", method)
+	}
+}
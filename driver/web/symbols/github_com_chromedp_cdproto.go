@@ -0,0 +1,600 @@
+// Code generated by 'yaegi extract github.com/chromedp/cdproto'. DO NOT EDIT.
+
+package symbols
+
+import (
+	"github.com/chromedp/cdproto"
+	"go/constant"
+	"go/token"
+	"reflect"
+)
+
+func init() {
+	Symbols["github.com/chromedp/cdproto/cdproto"] = map[string]reflect.Value{
+		// function, constant and variable definitions
+		"CommandAccessibilityDisable":                            reflect.ValueOf(constant.MakeFromLiteral("\"Accessibility.disable\"", token.STRING, 0)),
+		"CommandAccessibilityEnable":                             reflect.ValueOf(constant.MakeFromLiteral("\"Accessibility.enable\"", token.STRING, 0)),
+		"CommandAccessibilityGetFullAXTree":                      reflect.ValueOf(constant.MakeFromLiteral("\"Accessibility.getFullAXTree\"", token.STRING, 0)),
+		"CommandAccessibilityGetPartialAXTree":                   reflect.ValueOf(constant.MakeFromLiteral("\"Accessibility.getPartialAXTree\"", token.STRING, 0)),
+		"CommandAnimationDisable":                                reflect.ValueOf(constant.MakeFromLiteral("\"Animation.disable\"", token.STRING, 0)),
+		"CommandAnimationEnable":                                 reflect.ValueOf(constant.MakeFromLiteral("\"Animation.enable\"", token.STRING, 0)),
+		"CommandAnimationGetCurrentTime":                         reflect.ValueOf(constant.MakeFromLiteral("\"Animation.getCurrentTime\"", token.STRING, 0)),
+		"CommandAnimationGetPlaybackRate":                        reflect.ValueOf(constant.MakeFromLiteral("\"Animation.getPlaybackRate\"", token.STRING, 0)),
+		"CommandAnimationReleaseAnimations":                      reflect.ValueOf(constant.MakeFromLiteral("\"Animation.releaseAnimations\"", token.STRING, 0)),
+		"CommandAnimationResolveAnimation":                       reflect.ValueOf(constant.MakeFromLiteral("\"Animation.resolveAnimation\"", token.STRING, 0)),
+		"CommandAnimationSeekAnimations":                         reflect.ValueOf(constant.MakeFromLiteral("\"Animation.seekAnimations\"", token.STRING, 0)),
+		"CommandAnimationSetPaused":                              reflect.ValueOf(constant.MakeFromLiteral("\"Animation.setPaused\"", token.STRING, 0)),
+		"CommandAnimationSetPlaybackRate":                        reflect.ValueOf(constant.MakeFromLiteral("\"Animation.setPlaybackRate\"", token.STRING, 0)),
+		"CommandAnimationSetTiming":                              reflect.ValueOf(constant.MakeFromLiteral("\"Animation.setTiming\"", token.STRING, 0)),
+		"CommandApplicationCacheEnable":                          reflect.ValueOf(constant.MakeFromLiteral("\"ApplicationCache.enable\"", token.STRING, 0)),
+		"CommandApplicationCacheGetApplicationCacheForFrame":     reflect.ValueOf(constant.MakeFromLiteral("\"ApplicationCache.getApplicationCacheForFrame\"", token.STRING, 0)),
+		"CommandApplicationCacheGetFramesWithManifests":          reflect.ValueOf(constant.MakeFromLiteral("\"ApplicationCache.getFramesWithManifests\"", token.STRING, 0)),
+		"CommandApplicationCacheGetManifestForFrame":             reflect.ValueOf(constant.MakeFromLiteral("\"ApplicationCache.getManifestForFrame\"", token.STRING, 0)),
+		"CommandAuditsGetEncodedResponse":                        reflect.ValueOf(constant.MakeFromLiteral("\"Audits.getEncodedResponse\"", token.STRING, 0)),
+		"CommandBackgroundServiceClearEvents":                    reflect.ValueOf(constant.MakeFromLiteral("\"BackgroundService.clearEvents\"", token.STRING, 0)),
+		"CommandBackgroundServiceSetRecording":                   reflect.ValueOf(constant.MakeFromLiteral("\"BackgroundService.setRecording\"", token.STRING, 0)),
+		"CommandBackgroundServiceStartObserving":                 reflect.ValueOf(constant.MakeFromLiteral("\"BackgroundService.startObserving\"", token.STRING, 0)),
+		"CommandBackgroundServiceStopObserving":                  reflect.ValueOf(constant.MakeFromLiteral("\"BackgroundService.stopObserving\"", token.STRING, 0)),
+		"CommandBrowserClose":                                    reflect.ValueOf(constant.MakeFromLiteral("\"Browser.close\"", token.STRING, 0)),
+		"CommandBrowserCrash":                                    reflect.ValueOf(constant.MakeFromLiteral("\"Browser.crash\"", token.STRING, 0)),
+		"CommandBrowserCrashGpuProcess":                          reflect.ValueOf(constant.MakeFromLiteral("\"Browser.crashGpuProcess\"", token.STRING, 0)),
+		"CommandBrowserGetBrowserCommandLine":                    reflect.ValueOf(constant.MakeFromLiteral("\"Browser.getBrowserCommandLine\"", token.STRING, 0)),
+		"CommandBrowserGetHistogram":                             reflect.ValueOf(constant.MakeFromLiteral("\"Browser.getHistogram\"", token.STRING, 0)),
+		"CommandBrowserGetHistograms":                            reflect.ValueOf(constant.MakeFromLiteral("\"Browser.getHistograms\"", token.STRING, 0)),
+		"CommandBrowserGetVersion":                               reflect.ValueOf(constant.MakeFromLiteral("\"Browser.getVersion\"", token.STRING, 0)),
+		"CommandBrowserGetWindowBounds":                          reflect.ValueOf(constant.MakeFromLiteral("\"Browser.getWindowBounds\"", token.STRING, 0)),
+		"CommandBrowserGetWindowForTarget":                       reflect.ValueOf(constant.MakeFromLiteral("\"Browser.getWindowForTarget\"", token.STRING, 0)),
+		"CommandBrowserGrantPermissions":                         reflect.ValueOf(constant.MakeFromLiteral("\"Browser.grantPermissions\"", token.STRING, 0)),
+		"CommandBrowserResetPermissions":                         reflect.ValueOf(constant.MakeFromLiteral("\"Browser.resetPermissions\"", token.STRING, 0)),
+		"CommandBrowserSetDockTile":                              reflect.ValueOf(constant.MakeFromLiteral("\"Browser.setDockTile\"", token.STRING, 0)),
+		"CommandBrowserSetPermission":                            reflect.ValueOf(constant.MakeFromLiteral("\"Browser.setPermission\"", token.STRING, 0)),
+		"CommandBrowserSetWindowBounds":                          reflect.ValueOf(constant.MakeFromLiteral("\"Browser.setWindowBounds\"", token.STRING, 0)),
+		"CommandCSSAddRule":                                      reflect.ValueOf(constant.MakeFromLiteral("\"CSS.addRule\"", token.STRING, 0)),
+		"CommandCSSCollectClassNames":                            reflect.ValueOf(constant.MakeFromLiteral("\"CSS.collectClassNames\"", token.STRING, 0)),
+		"CommandCSSCreateStyleSheet":                             reflect.ValueOf(constant.MakeFromLiteral("\"CSS.createStyleSheet\"", token.STRING, 0)),
+		"CommandCSSDisable":                                      reflect.ValueOf(constant.MakeFromLiteral("\"CSS.disable\"", token.STRING, 0)),
+		"CommandCSSEnable":                                       reflect.ValueOf(constant.MakeFromLiteral("\"CSS.enable\"", token.STRING, 0)),
+		"CommandCSSForcePseudoState":                             reflect.ValueOf(constant.MakeFromLiteral("\"CSS.forcePseudoState\"", token.STRING, 0)),
+		"CommandCSSGetBackgroundColors":                          reflect.ValueOf(constant.MakeFromLiteral("\"CSS.getBackgroundColors\"", token.STRING, 0)),
+		"CommandCSSGetComputedStyleForNode":                      reflect.ValueOf(constant.MakeFromLiteral("\"CSS.getComputedStyleForNode\"", token.STRING, 0)),
+		"CommandCSSGetInlineStylesForNode":                       reflect.ValueOf(constant.MakeFromLiteral("\"CSS.getInlineStylesForNode\"", token.STRING, 0)),
+		"CommandCSSGetMatchedStylesForNode":                      reflect.ValueOf(constant.MakeFromLiteral("\"CSS.getMatchedStylesForNode\"", token.STRING, 0)),
+		"CommandCSSGetMediaQueries":                              reflect.ValueOf(constant.MakeFromLiteral("\"CSS.getMediaQueries\"", token.STRING, 0)),
+		"CommandCSSGetPlatformFontsForNode":                      reflect.ValueOf(constant.MakeFromLiteral("\"CSS.getPlatformFontsForNode\"", token.STRING, 0)),
+		"CommandCSSGetStyleSheetText":                            reflect.ValueOf(constant.MakeFromLiteral("\"CSS.getStyleSheetText\"", token.STRING, 0)),
+		"CommandCSSSetEffectivePropertyValueForNode":             reflect.ValueOf(constant.MakeFromLiteral("\"CSS.setEffectivePropertyValueForNode\"", token.STRING, 0)),
+		"CommandCSSSetKeyframeKey":                               reflect.ValueOf(constant.MakeFromLiteral("\"CSS.setKeyframeKey\"", token.STRING, 0)),
+		"CommandCSSSetMediaText":                                 reflect.ValueOf(constant.MakeFromLiteral("\"CSS.setMediaText\"", token.STRING, 0)),
+		"CommandCSSSetRuleSelector":                              reflect.ValueOf(constant.MakeFromLiteral("\"CSS.setRuleSelector\"", token.STRING, 0)),
+		"CommandCSSSetStyleSheetText":                            reflect.ValueOf(constant.MakeFromLiteral("\"CSS.setStyleSheetText\"", token.STRING, 0)),
+		"CommandCSSSetStyleTexts":                                reflect.ValueOf(constant.MakeFromLiteral("\"CSS.setStyleTexts\"", token.STRING, 0)),
+		"CommandCSSStartRuleUsageTracking":                       reflect.ValueOf(constant.MakeFromLiteral("\"CSS.startRuleUsageTracking\"", token.STRING, 0)),
+		"CommandCSSStopRuleUsageTracking":                        reflect.ValueOf(constant.MakeFromLiteral("\"CSS.stopRuleUsageTracking\"", token.STRING, 0)),
+		"CommandCSSTakeCoverageDelta":                            reflect.ValueOf(constant.MakeFromLiteral("\"CSS.takeCoverageDelta\"", token.STRING, 0)),
+		"CommandCacheStorageDeleteCache":                         reflect.ValueOf(constant.MakeFromLiteral("\"CacheStorage.deleteCache\"", token.STRING, 0)),
+		"CommandCacheStorageDeleteEntry":                         reflect.ValueOf(constant.MakeFromLiteral("\"CacheStorage.deleteEntry\"", token.STRING, 0)),
+		"CommandCacheStorageRequestCacheNames":                   reflect.ValueOf(constant.MakeFromLiteral("\"CacheStorage.requestCacheNames\"", token.STRING, 0)),
+		"CommandCacheStorageRequestCachedResponse":               reflect.ValueOf(constant.MakeFromLiteral("\"CacheStorage.requestCachedResponse\"", token.STRING, 0)),
+		"CommandCacheStorageRequestEntries":                      reflect.ValueOf(constant.MakeFromLiteral("\"CacheStorage.requestEntries\"", token.STRING, 0)),
+		"CommandCastDisable":                                     reflect.ValueOf(constant.MakeFromLiteral("\"Cast.disable\"", token.STRING, 0)),
+		"CommandCastEnable":                                      reflect.ValueOf(constant.MakeFromLiteral("\"Cast.enable\"", token.STRING, 0)),
+		"CommandCastSetSinkToUse":                                reflect.ValueOf(constant.MakeFromLiteral("\"Cast.setSinkToUse\"", token.STRING, 0)),
+		"CommandCastStartTabMirroring":                           reflect.ValueOf(constant.MakeFromLiteral("\"Cast.startTabMirroring\"", token.STRING, 0)),
+		"CommandCastStopCasting":                                 reflect.ValueOf(constant.MakeFromLiteral("\"Cast.stopCasting\"", token.STRING, 0)),
+		"CommandDOMCollectClassNamesFromSubtree":                 reflect.ValueOf(constant.MakeFromLiteral("\"DOM.collectClassNamesFromSubtree\"", token.STRING, 0)),
+		"CommandDOMCopyTo":                                       reflect.ValueOf(constant.MakeFromLiteral("\"DOM.copyTo\"", token.STRING, 0)),
+		"CommandDOMDebuggerGetEventListeners":                    reflect.ValueOf(constant.MakeFromLiteral("\"DOMDebugger.getEventListeners\"", token.STRING, 0)),
+		"CommandDOMDebuggerRemoveDOMBreakpoint":                  reflect.ValueOf(constant.MakeFromLiteral("\"DOMDebugger.removeDOMBreakpoint\"", token.STRING, 0)),
+		"CommandDOMDebuggerRemoveEventListenerBreakpoint":        reflect.ValueOf(constant.MakeFromLiteral("\"DOMDebugger.removeEventListenerBreakpoint\"", token.STRING, 0)),
+		"CommandDOMDebuggerRemoveInstrumentationBreakpoint":      reflect.ValueOf(constant.MakeFromLiteral("\"DOMDebugger.removeInstrumentationBreakpoint\"", token.STRING, 0)),
+		"CommandDOMDebuggerRemoveXHRBreakpoint":                  reflect.ValueOf(constant.MakeFromLiteral("\"DOMDebugger.removeXHRBreakpoint\"", token.STRING, 0)),
+		"CommandDOMDebuggerSetDOMBreakpoint":                     reflect.ValueOf(constant.MakeFromLiteral("\"DOMDebugger.setDOMBreakpoint\"", token.STRING, 0)),
+		"CommandDOMDebuggerSetEventListenerBreakpoint":           reflect.ValueOf(constant.MakeFromLiteral("\"DOMDebugger.setEventListenerBreakpoint\"", token.STRING, 0)),
+		"CommandDOMDebuggerSetInstrumentationBreakpoint":         reflect.ValueOf(constant.MakeFromLiteral("\"DOMDebugger.setInstrumentationBreakpoint\"", token.STRING, 0)),
+		"CommandDOMDebuggerSetXHRBreakpoint":                     reflect.ValueOf(constant.MakeFromLiteral("\"DOMDebugger.setXHRBreakpoint\"", token.STRING, 0)),
+		"CommandDOMDescribeNode":                                 reflect.ValueOf(constant.MakeFromLiteral("\"DOM.describeNode\"", token.STRING, 0)),
+		"CommandDOMDisable":                                      reflect.ValueOf(constant.MakeFromLiteral("\"DOM.disable\"", token.STRING, 0)),
+		"CommandDOMDiscardSearchResults":                         reflect.ValueOf(constant.MakeFromLiteral("\"DOM.discardSearchResults\"", token.STRING, 0)),
+		"CommandDOMEnable":                                       reflect.ValueOf(constant.MakeFromLiteral("\"DOM.enable\"", token.STRING, 0)),
+		"CommandDOMFocus":                                        reflect.ValueOf(constant.MakeFromLiteral("\"DOM.focus\"", token.STRING, 0)),
+		"CommandDOMGetAttributes":                                reflect.ValueOf(constant.MakeFromLiteral("\"DOM.getAttributes\"", token.STRING, 0)),
+		"CommandDOMGetBoxModel":                                  reflect.ValueOf(constant.MakeFromLiteral("\"DOM.getBoxModel\"", token.STRING, 0)),
+		"CommandDOMGetContentQuads":                              reflect.ValueOf(constant.MakeFromLiteral("\"DOM.getContentQuads\"", token.STRING, 0)),
+		"CommandDOMGetDocument":                                  reflect.ValueOf(constant.MakeFromLiteral("\"DOM.getDocument\"", token.STRING, 0)),
+		"CommandDOMGetFileInfo":                                  reflect.ValueOf(constant.MakeFromLiteral("\"DOM.getFileInfo\"", token.STRING, 0)),
+		"CommandDOMGetFlattenedDocument":                         reflect.ValueOf(constant.MakeFromLiteral("\"DOM.getFlattenedDocument\"", token.STRING, 0)),
+		"CommandDOMGetFrameOwner":                                reflect.ValueOf(constant.MakeFromLiteral("\"DOM.getFrameOwner\"", token.STRING, 0)),
+		"CommandDOMGetNodeForLocation":                           reflect.ValueOf(constant.MakeFromLiteral("\"DOM.getNodeForLocation\"", token.STRING, 0)),
+		"CommandDOMGetNodeStackTraces":                           reflect.ValueOf(constant.MakeFromLiteral("\"DOM.getNodeStackTraces\"", token.STRING, 0)),
+		"CommandDOMGetOuterHTML":                                 reflect.ValueOf(constant.MakeFromLiteral("\"DOM.getOuterHTML\"", token.STRING, 0)),
+		"CommandDOMGetRelayoutBoundary":                          reflect.ValueOf(constant.MakeFromLiteral("\"DOM.getRelayoutBoundary\"", token.STRING, 0)),
+		"CommandDOMGetSearchResults":                             reflect.ValueOf(constant.MakeFromLiteral("\"DOM.getSearchResults\"", token.STRING, 0)),
+		"CommandDOMMarkUndoableState":                            reflect.ValueOf(constant.MakeFromLiteral("\"DOM.markUndoableState\"", token.STRING, 0)),
+		"CommandDOMMoveTo":                                       reflect.ValueOf(constant.MakeFromLiteral("\"DOM.moveTo\"", token.STRING, 0)),
+		"CommandDOMPerformSearch":                                reflect.ValueOf(constant.MakeFromLiteral("\"DOM.performSearch\"", token.STRING, 0)),
+		"CommandDOMPushNodeByPathToFrontend":                     reflect.ValueOf(constant.MakeFromLiteral("\"DOM.pushNodeByPathToFrontend\"", token.STRING, 0)),
+		"CommandDOMPushNodesByBackendIdsToFrontend":              reflect.ValueOf(constant.MakeFromLiteral("\"DOM.pushNodesByBackendIdsToFrontend\"", token.STRING, 0)),
+		"CommandDOMQuerySelector":                                reflect.ValueOf(constant.MakeFromLiteral("\"DOM.querySelector\"", token.STRING, 0)),
+		"CommandDOMQuerySelectorAll":                             reflect.ValueOf(constant.MakeFromLiteral("\"DOM.querySelectorAll\"", token.STRING, 0)),
+		"CommandDOMRedo":                                         reflect.ValueOf(constant.MakeFromLiteral("\"DOM.redo\"", token.STRING, 0)),
+		"CommandDOMRemoveAttribute":                              reflect.ValueOf(constant.MakeFromLiteral("\"DOM.removeAttribute\"", token.STRING, 0)),
+		"CommandDOMRemoveNode":                                   reflect.ValueOf(constant.MakeFromLiteral("\"DOM.removeNode\"", token.STRING, 0)),
+		"CommandDOMRequestChildNodes":                            reflect.ValueOf(constant.MakeFromLiteral("\"DOM.requestChildNodes\"", token.STRING, 0)),
+		"CommandDOMRequestNode":                                  reflect.ValueOf(constant.MakeFromLiteral("\"DOM.requestNode\"", token.STRING, 0)),
+		"CommandDOMResolveNode":                                  reflect.ValueOf(constant.MakeFromLiteral("\"DOM.resolveNode\"", token.STRING, 0)),
+		"CommandDOMSetAttributeValue":                            reflect.ValueOf(constant.MakeFromLiteral("\"DOM.setAttributeValue\"", token.STRING, 0)),
+		"CommandDOMSetAttributesAsText":                          reflect.ValueOf(constant.MakeFromLiteral("\"DOM.setAttributesAsText\"", token.STRING, 0)),
+		"CommandDOMSetFileInputFiles":                            reflect.ValueOf(constant.MakeFromLiteral("\"DOM.setFileInputFiles\"", token.STRING, 0)),
+		"CommandDOMSetInspectedNode":                             reflect.ValueOf(constant.MakeFromLiteral("\"DOM.setInspectedNode\"", token.STRING, 0)),
+		"CommandDOMSetNodeName":                                  reflect.ValueOf(constant.MakeFromLiteral("\"DOM.setNodeName\"", token.STRING, 0)),
+		"CommandDOMSetNodeStackTracesEnabled":                    reflect.ValueOf(constant.MakeFromLiteral("\"DOM.setNodeStackTracesEnabled\"", token.STRING, 0)),
+		"CommandDOMSetNodeValue":                                 reflect.ValueOf(constant.MakeFromLiteral("\"DOM.setNodeValue\"", token.STRING, 0)),
+		"CommandDOMSetOuterHTML":                                 reflect.ValueOf(constant.MakeFromLiteral("\"DOM.setOuterHTML\"", token.STRING, 0)),
+		"CommandDOMSnapshotCaptureSnapshot":                      reflect.ValueOf(constant.MakeFromLiteral("\"DOMSnapshot.captureSnapshot\"", token.STRING, 0)),
+		"CommandDOMSnapshotDisable":                              reflect.ValueOf(constant.MakeFromLiteral("\"DOMSnapshot.disable\"", token.STRING, 0)),
+		"CommandDOMSnapshotEnable":                               reflect.ValueOf(constant.MakeFromLiteral("\"DOMSnapshot.enable\"", token.STRING, 0)),
+		"CommandDOMStorageClear":                                 reflect.ValueOf(constant.MakeFromLiteral("\"DOMStorage.clear\"", token.STRING, 0)),
+		"CommandDOMStorageDisable":                               reflect.ValueOf(constant.MakeFromLiteral("\"DOMStorage.disable\"", token.STRING, 0)),
+		"CommandDOMStorageEnable":                                reflect.ValueOf(constant.MakeFromLiteral("\"DOMStorage.enable\"", token.STRING, 0)),
+		"CommandDOMStorageGetDOMStorageItems":                    reflect.ValueOf(constant.MakeFromLiteral("\"DOMStorage.getDOMStorageItems\"", token.STRING, 0)),
+		"CommandDOMStorageRemoveDOMStorageItem":                  reflect.ValueOf(constant.MakeFromLiteral("\"DOMStorage.removeDOMStorageItem\"", token.STRING, 0)),
+		"CommandDOMStorageSetDOMStorageItem":                     reflect.ValueOf(constant.MakeFromLiteral("\"DOMStorage.setDOMStorageItem\"", token.STRING, 0)),
+		"CommandDOMUndo":                                         reflect.ValueOf(constant.MakeFromLiteral("\"DOM.undo\"", token.STRING, 0)),
+		"CommandDatabaseDisable":                                 reflect.ValueOf(constant.MakeFromLiteral("\"Database.disable\"", token.STRING, 0)),
+		"CommandDatabaseEnable":                                  reflect.ValueOf(constant.MakeFromLiteral("\"Database.enable\"", token.STRING, 0)),
+		"CommandDatabaseExecuteSQL":                              reflect.ValueOf(constant.MakeFromLiteral("\"Database.executeSQL\"", token.STRING, 0)),
+		"CommandDatabaseGetDatabaseTableNames":                   reflect.ValueOf(constant.MakeFromLiteral("\"Database.getDatabaseTableNames\"", token.STRING, 0)),
+		"CommandDebuggerContinueToLocation":                      reflect.ValueOf(constant.MakeFromLiteral("\"Debugger.continueToLocation\"", token.STRING, 0)),
+		"CommandDebuggerDisable":                                 reflect.ValueOf(constant.MakeFromLiteral("\"Debugger.disable\"", token.STRING, 0)),
+		"CommandDebuggerEnable":                                  reflect.ValueOf(constant.MakeFromLiteral("\"Debugger.enable\"", token.STRING, 0)),
+		"CommandDebuggerEvaluateOnCallFrame":                     reflect.ValueOf(constant.MakeFromLiteral("\"Debugger.evaluateOnCallFrame\"", token.STRING, 0)),
+		"CommandDebuggerGetPossibleBreakpoints":                  reflect.ValueOf(constant.MakeFromLiteral("\"Debugger.getPossibleBreakpoints\"", token.STRING, 0)),
+		"CommandDebuggerGetScriptSource":                         reflect.ValueOf(constant.MakeFromLiteral("\"Debugger.getScriptSource\"", token.STRING, 0)),
+		"CommandDebuggerGetStackTrace":                           reflect.ValueOf(constant.MakeFromLiteral("\"Debugger.getStackTrace\"", token.STRING, 0)),
+		"CommandDebuggerGetWasmBytecode":                         reflect.ValueOf(constant.MakeFromLiteral("\"Debugger.getWasmBytecode\"", token.STRING, 0)),
+		"CommandDebuggerPause":                                   reflect.ValueOf(constant.MakeFromLiteral("\"Debugger.pause\"", token.STRING, 0)),
+		"CommandDebuggerRemoveBreakpoint":                        reflect.ValueOf(constant.MakeFromLiteral("\"Debugger.removeBreakpoint\"", token.STRING, 0)),
+		"CommandDebuggerRestartFrame":                            reflect.ValueOf(constant.MakeFromLiteral("\"Debugger.restartFrame\"", token.STRING, 0)),
+		"CommandDebuggerResume":                                  reflect.ValueOf(constant.MakeFromLiteral("\"Debugger.resume\"", token.STRING, 0)),
+		"CommandDebuggerSearchInContent":                         reflect.ValueOf(constant.MakeFromLiteral("\"Debugger.searchInContent\"", token.STRING, 0)),
+		"CommandDebuggerSetAsyncCallStackDepth":                  reflect.ValueOf(constant.MakeFromLiteral("\"Debugger.setAsyncCallStackDepth\"", token.STRING, 0)),
+		"CommandDebuggerSetBlackboxPatterns":                     reflect.ValueOf(constant.MakeFromLiteral("\"Debugger.setBlackboxPatterns\"", token.STRING, 0)),
+		"CommandDebuggerSetBlackboxedRanges":                     reflect.ValueOf(constant.MakeFromLiteral("\"Debugger.setBlackboxedRanges\"", token.STRING, 0)),
+		"CommandDebuggerSetBreakpoint":                           reflect.ValueOf(constant.MakeFromLiteral("\"Debugger.setBreakpoint\"", token.STRING, 0)),
+		"CommandDebuggerSetBreakpointByURL":                      reflect.ValueOf(constant.MakeFromLiteral("\"Debugger.setBreakpointByUrl\"", token.STRING, 0)),
+		"CommandDebuggerSetBreakpointOnFunctionCall":             reflect.ValueOf(constant.MakeFromLiteral("\"Debugger.setBreakpointOnFunctionCall\"", token.STRING, 0)),
+		"CommandDebuggerSetBreakpointsActive":                    reflect.ValueOf(constant.MakeFromLiteral("\"Debugger.setBreakpointsActive\"", token.STRING, 0)),
+		"CommandDebuggerSetInstrumentationBreakpoint":            reflect.ValueOf(constant.MakeFromLiteral("\"Debugger.setInstrumentationBreakpoint\"", token.STRING, 0)),
+		"CommandDebuggerSetPauseOnExceptions":                    reflect.ValueOf(constant.MakeFromLiteral("\"Debugger.setPauseOnExceptions\"", token.STRING, 0)),
+		"CommandDebuggerSetReturnValue":                          reflect.ValueOf(constant.MakeFromLiteral("\"Debugger.setReturnValue\"", token.STRING, 0)),
+		"CommandDebuggerSetScriptSource":                         reflect.ValueOf(constant.MakeFromLiteral("\"Debugger.setScriptSource\"", token.STRING, 0)),
+		"CommandDebuggerSetSkipAllPauses":                        reflect.ValueOf(constant.MakeFromLiteral("\"Debugger.setSkipAllPauses\"", token.STRING, 0)),
+		"CommandDebuggerSetVariableValue":                        reflect.ValueOf(constant.MakeFromLiteral("\"Debugger.setVariableValue\"", token.STRING, 0)),
+		"CommandDebuggerStepInto":                                reflect.ValueOf(constant.MakeFromLiteral("\"Debugger.stepInto\"", token.STRING, 0)),
+		"CommandDebuggerStepOut":                                 reflect.ValueOf(constant.MakeFromLiteral("\"Debugger.stepOut\"", token.STRING, 0)),
+		"CommandDebuggerStepOver":                                reflect.ValueOf(constant.MakeFromLiteral("\"Debugger.stepOver\"", token.STRING, 0)),
+		"CommandDeviceOrientationClearDeviceOrientationOverride": reflect.ValueOf(constant.MakeFromLiteral("\"DeviceOrientation.clearDeviceOrientationOverride\"", token.STRING, 0)),
+		"CommandDeviceOrientationSetDeviceOrientationOverride":   reflect.ValueOf(constant.MakeFromLiteral("\"DeviceOrientation.setDeviceOrientationOverride\"", token.STRING, 0)),
+		"CommandEmulationCanEmulate":                             reflect.ValueOf(constant.MakeFromLiteral("\"Emulation.canEmulate\"", token.STRING, 0)),
+		"CommandEmulationClearDeviceMetricsOverride":             reflect.ValueOf(constant.MakeFromLiteral("\"Emulation.clearDeviceMetricsOverride\"", token.STRING, 0)),
+		"CommandEmulationClearGeolocationOverride":               reflect.ValueOf(constant.MakeFromLiteral("\"Emulation.clearGeolocationOverride\"", token.STRING, 0)),
+		"CommandEmulationResetPageScaleFactor":                   reflect.ValueOf(constant.MakeFromLiteral("\"Emulation.resetPageScaleFactor\"", token.STRING, 0)),
+		"CommandEmulationSetCPUThrottlingRate":                   reflect.ValueOf(constant.MakeFromLiteral("\"Emulation.setCPUThrottlingRate\"", token.STRING, 0)),
+		"CommandEmulationSetDefaultBackgroundColorOverride":      reflect.ValueOf(constant.MakeFromLiteral("\"Emulation.setDefaultBackgroundColorOverride\"", token.STRING, 0)),
+		"CommandEmulationSetDeviceMetricsOverride":               reflect.ValueOf(constant.MakeFromLiteral("\"Emulation.setDeviceMetricsOverride\"", token.STRING, 0)),
+		"CommandEmulationSetDocumentCookieDisabled":              reflect.ValueOf(constant.MakeFromLiteral("\"Emulation.setDocumentCookieDisabled\"", token.STRING, 0)),
+		"CommandEmulationSetEmitTouchEventsForMouse":             reflect.ValueOf(constant.MakeFromLiteral("\"Emulation.setEmitTouchEventsForMouse\"", token.STRING, 0)),
+		"CommandEmulationSetEmulatedMedia":                       reflect.ValueOf(constant.MakeFromLiteral("\"Emulation.setEmulatedMedia\"", token.STRING, 0)),
+		"CommandEmulationSetFocusEmulationEnabled":               reflect.ValueOf(constant.MakeFromLiteral("\"Emulation.setFocusEmulationEnabled\"", token.STRING, 0)),
+		"CommandEmulationSetGeolocationOverride":                 reflect.ValueOf(constant.MakeFromLiteral("\"Emulation.setGeolocationOverride\"", token.STRING, 0)),
+		"CommandEmulationSetPageScaleFactor":                     reflect.ValueOf(constant.MakeFromLiteral("\"Emulation.setPageScaleFactor\"", token.STRING, 0)),
+		"CommandEmulationSetScriptExecutionDisabled":             reflect.ValueOf(constant.MakeFromLiteral("\"Emulation.setScriptExecutionDisabled\"", token.STRING, 0)),
+		"CommandEmulationSetScrollbarsHidden":                    reflect.ValueOf(constant.MakeFromLiteral("\"Emulation.setScrollbarsHidden\"", token.STRING, 0)),
+		"CommandEmulationSetTimezoneOverride":                    reflect.ValueOf(constant.MakeFromLiteral("\"Emulation.setTimezoneOverride\"", token.STRING, 0)),
+		"CommandEmulationSetTouchEmulationEnabled":               reflect.ValueOf(constant.MakeFromLiteral("\"Emulation.setTouchEmulationEnabled\"", token.STRING, 0)),
+		"CommandEmulationSetUserAgentOverride":                   reflect.ValueOf(constant.MakeFromLiteral("\"Emulation.setUserAgentOverride\"", token.STRING, 0)),
+		"CommandEmulationSetVirtualTimePolicy":                   reflect.ValueOf(constant.MakeFromLiteral("\"Emulation.setVirtualTimePolicy\"", token.STRING, 0)),
+		"CommandFetchContinueRequest":                            reflect.ValueOf(constant.MakeFromLiteral("\"Fetch.continueRequest\"", token.STRING, 0)),
+		"CommandFetchContinueWithAuth":                           reflect.ValueOf(constant.MakeFromLiteral("\"Fetch.continueWithAuth\"", token.STRING, 0)),
+		"CommandFetchDisable":                                    reflect.ValueOf(constant.MakeFromLiteral("\"Fetch.disable\"", token.STRING, 0)),
+		"CommandFetchEnable":                                     reflect.ValueOf(constant.MakeFromLiteral("\"Fetch.enable\"", token.STRING, 0)),
+		"CommandFetchFailRequest":                                reflect.ValueOf(constant.MakeFromLiteral("\"Fetch.failRequest\"", token.STRING, 0)),
+		"CommandFetchFulfillRequest":                             reflect.ValueOf(constant.MakeFromLiteral("\"Fetch.fulfillRequest\"", token.STRING, 0)),
+		"CommandFetchGetResponseBody":                            reflect.ValueOf(constant.MakeFromLiteral("\"Fetch.getResponseBody\"", token.STRING, 0)),
+		"CommandFetchTakeResponseBodyAsStream":                   reflect.ValueOf(constant.MakeFromLiteral("\"Fetch.takeResponseBodyAsStream\"", token.STRING, 0)),
+		"CommandHeadlessExperimentalBeginFrame":                  reflect.ValueOf(constant.MakeFromLiteral("\"HeadlessExperimental.beginFrame\"", token.STRING, 0)),
+		"CommandHeadlessExperimentalDisable":                     reflect.ValueOf(constant.MakeFromLiteral("\"HeadlessExperimental.disable\"", token.STRING, 0)),
+		"CommandHeadlessExperimentalEnable":                      reflect.ValueOf(constant.MakeFromLiteral("\"HeadlessExperimental.enable\"", token.STRING, 0)),
+		"CommandHeapProfilerAddInspectedHeapObject":              reflect.ValueOf(constant.MakeFromLiteral("\"HeapProfiler.addInspectedHeapObject\"", token.STRING, 0)),
+		"CommandHeapProfilerCollectGarbage":                      reflect.ValueOf(constant.MakeFromLiteral("\"HeapProfiler.collectGarbage\"", token.STRING, 0)),
+		"CommandHeapProfilerDisable":                             reflect.ValueOf(constant.MakeFromLiteral("\"HeapProfiler.disable\"", token.STRING, 0)),
+		"CommandHeapProfilerEnable":                              reflect.ValueOf(constant.MakeFromLiteral("\"HeapProfiler.enable\"", token.STRING, 0)),
+		"CommandHeapProfilerGetHeapObjectID":                     reflect.ValueOf(constant.MakeFromLiteral("\"HeapProfiler.getHeapObjectId\"", token.STRING, 0)),
+		"CommandHeapProfilerGetObjectByHeapObjectID":             reflect.ValueOf(constant.MakeFromLiteral("\"HeapProfiler.getObjectByHeapObjectId\"", token.STRING, 0)),
+		"CommandHeapProfilerGetSamplingProfile":                  reflect.ValueOf(constant.MakeFromLiteral("\"HeapProfiler.getSamplingProfile\"", token.STRING, 0)),
+		"CommandHeapProfilerStartSampling":                       reflect.ValueOf(constant.MakeFromLiteral("\"HeapProfiler.startSampling\"", token.STRING, 0)),
+		"CommandHeapProfilerStartTrackingHeapObjects":            reflect.ValueOf(constant.MakeFromLiteral("\"HeapProfiler.startTrackingHeapObjects\"", token.STRING, 0)),
+		"CommandHeapProfilerStopSampling":                        reflect.ValueOf(constant.MakeFromLiteral("\"HeapProfiler.stopSampling\"", token.STRING, 0)),
+		"CommandHeapProfilerStopTrackingHeapObjects":             reflect.ValueOf(constant.MakeFromLiteral("\"HeapProfiler.stopTrackingHeapObjects\"", token.STRING, 0)),
+		"CommandHeapProfilerTakeHeapSnapshot":                    reflect.ValueOf(constant.MakeFromLiteral("\"HeapProfiler.takeHeapSnapshot\"", token.STRING, 0)),
+		"CommandIOClose":                                         reflect.ValueOf(constant.MakeFromLiteral("\"IO.close\"", token.STRING, 0)),
+		"CommandIORead":                                          reflect.ValueOf(constant.MakeFromLiteral("\"IO.read\"", token.STRING, 0)),
+		"CommandIOResolveBlob":                                   reflect.ValueOf(constant.MakeFromLiteral("\"IO.resolveBlob\"", token.STRING, 0)),
+		"CommandIndexedDBClearObjectStore":                       reflect.ValueOf(constant.MakeFromLiteral("\"IndexedDB.clearObjectStore\"", token.STRING, 0)),
+		"CommandIndexedDBDeleteDatabase":                         reflect.ValueOf(constant.MakeFromLiteral("\"IndexedDB.deleteDatabase\"", token.STRING, 0)),
+		"CommandIndexedDBDeleteObjectStoreEntries":               reflect.ValueOf(constant.MakeFromLiteral("\"IndexedDB.deleteObjectStoreEntries\"", token.STRING, 0)),
+		"CommandIndexedDBDisable":                                reflect.ValueOf(constant.MakeFromLiteral("\"IndexedDB.disable\"", token.STRING, 0)),
+		"CommandIndexedDBEnable":                                 reflect.ValueOf(constant.MakeFromLiteral("\"IndexedDB.enable\"", token.STRING, 0)),
+		"CommandIndexedDBGetMetadata":                            reflect.ValueOf(constant.MakeFromLiteral("\"IndexedDB.getMetadata\"", token.STRING, 0)),
+		"CommandIndexedDBRequestData":                            reflect.ValueOf(constant.MakeFromLiteral("\"IndexedDB.requestData\"", token.STRING, 0)),
+		"CommandIndexedDBRequestDatabase":                        reflect.ValueOf(constant.MakeFromLiteral("\"IndexedDB.requestDatabase\"", token.STRING, 0)),
+		"CommandIndexedDBRequestDatabaseNames":                   reflect.ValueOf(constant.MakeFromLiteral("\"IndexedDB.requestDatabaseNames\"", token.STRING, 0)),
+		"CommandInputDispatchKeyEvent":                           reflect.ValueOf(constant.MakeFromLiteral("\"Input.dispatchKeyEvent\"", token.STRING, 0)),
+		"CommandInputDispatchMouseEvent":                         reflect.ValueOf(constant.MakeFromLiteral("\"Input.dispatchMouseEvent\"", token.STRING, 0)),
+		"CommandInputDispatchTouchEvent":                         reflect.ValueOf(constant.MakeFromLiteral("\"Input.dispatchTouchEvent\"", token.STRING, 0)),
+		"CommandInputEmulateTouchFromMouseEvent":                 reflect.ValueOf(constant.MakeFromLiteral("\"Input.emulateTouchFromMouseEvent\"", token.STRING, 0)),
+		"CommandInputInsertText":                                 reflect.ValueOf(constant.MakeFromLiteral("\"Input.insertText\"", token.STRING, 0)),
+		"CommandInputSetIgnoreInputEvents":                       reflect.ValueOf(constant.MakeFromLiteral("\"Input.setIgnoreInputEvents\"", token.STRING, 0)),
+		"CommandInputSynthesizePinchGesture":                     reflect.ValueOf(constant.MakeFromLiteral("\"Input.synthesizePinchGesture\"", token.STRING, 0)),
+		"CommandInputSynthesizeScrollGesture":                    reflect.ValueOf(constant.MakeFromLiteral("\"Input.synthesizeScrollGesture\"", token.STRING, 0)),
+		"CommandInputSynthesizeTapGesture":                       reflect.ValueOf(constant.MakeFromLiteral("\"Input.synthesizeTapGesture\"", token.STRING, 0)),
+		"CommandInspectorDisable":                                reflect.ValueOf(constant.MakeFromLiteral("\"Inspector.disable\"", token.STRING, 0)),
+		"CommandInspectorEnable":                                 reflect.ValueOf(constant.MakeFromLiteral("\"Inspector.enable\"", token.STRING, 0)),
+		"CommandLayerTreeCompositingReasons":                     reflect.ValueOf(constant.MakeFromLiteral("\"LayerTree.compositingReasons\"", token.STRING, 0)),
+		"CommandLayerTreeDisable":                                reflect.ValueOf(constant.MakeFromLiteral("\"LayerTree.disable\"", token.STRING, 0)),
+		"CommandLayerTreeEnable":                                 reflect.ValueOf(constant.MakeFromLiteral("\"LayerTree.enable\"", token.STRING, 0)),
+		"CommandLayerTreeLoadSnapshot":                           reflect.ValueOf(constant.MakeFromLiteral("\"LayerTree.loadSnapshot\"", token.STRING, 0)),
+		"CommandLayerTreeMakeSnapshot":                           reflect.ValueOf(constant.MakeFromLiteral("\"LayerTree.makeSnapshot\"", token.STRING, 0)),
+		"CommandLayerTreeProfileSnapshot":                        reflect.ValueOf(constant.MakeFromLiteral("\"LayerTree.profileSnapshot\"", token.STRING, 0)),
+		"CommandLayerTreeReleaseSnapshot":                        reflect.ValueOf(constant.MakeFromLiteral("\"LayerTree.releaseSnapshot\"", token.STRING, 0)),
+		"CommandLayerTreeReplaySnapshot":                         reflect.ValueOf(constant.MakeFromLiteral("\"LayerTree.replaySnapshot\"", token.STRING, 0)),
+		"CommandLayerTreeSnapshotCommandLog":                     reflect.ValueOf(constant.MakeFromLiteral("\"LayerTree.snapshotCommandLog\"", token.STRING, 0)),
+		"CommandLogClear":                                        reflect.ValueOf(constant.MakeFromLiteral("\"Log.clear\"", token.STRING, 0)),
+		"CommandLogDisable":                                      reflect.ValueOf(constant.MakeFromLiteral("\"Log.disable\"", token.STRING, 0)),
+		"CommandLogEnable":                                       reflect.ValueOf(constant.MakeFromLiteral("\"Log.enable\"", token.STRING, 0)),
+		"CommandLogStartViolationsReport":                        reflect.ValueOf(constant.MakeFromLiteral("\"Log.startViolationsReport\"", token.STRING, 0)),
+		"CommandLogStopViolationsReport":                         reflect.ValueOf(constant.MakeFromLiteral("\"Log.stopViolationsReport\"", token.STRING, 0)),
+		"CommandMediaDisable":                                    reflect.ValueOf(constant.MakeFromLiteral("\"Media.disable\"", token.STRING, 0)),
+		"CommandMediaEnable":                                     reflect.ValueOf(constant.MakeFromLiteral("\"Media.enable\"", token.STRING, 0)),
+		"CommandMemoryForciblyPurgeJavaScriptMemory":             reflect.ValueOf(constant.MakeFromLiteral("\"Memory.forciblyPurgeJavaScriptMemory\"", token.STRING, 0)),
+		"CommandMemoryGetAllTimeSamplingProfile":                 reflect.ValueOf(constant.MakeFromLiteral("\"Memory.getAllTimeSamplingProfile\"", token.STRING, 0)),
+		"CommandMemoryGetBrowserSamplingProfile":                 reflect.ValueOf(constant.MakeFromLiteral("\"Memory.getBrowserSamplingProfile\"", token.STRING, 0)),
+		"CommandMemoryGetDOMCounters":                            reflect.ValueOf(constant.MakeFromLiteral("\"Memory.getDOMCounters\"", token.STRING, 0)),
+		"CommandMemoryGetSamplingProfile":                        reflect.ValueOf(constant.MakeFromLiteral("\"Memory.getSamplingProfile\"", token.STRING, 0)),
+		"CommandMemoryPrepareForLeakDetection":                   reflect.ValueOf(constant.MakeFromLiteral("\"Memory.prepareForLeakDetection\"", token.STRING, 0)),
+		"CommandMemorySetPressureNotificationsSuppressed":        reflect.ValueOf(constant.MakeFromLiteral("\"Memory.setPressureNotificationsSuppressed\"", token.STRING, 0)),
+		"CommandMemorySimulatePressureNotification":              reflect.ValueOf(constant.MakeFromLiteral("\"Memory.simulatePressureNotification\"", token.STRING, 0)),
+		"CommandMemoryStartSampling":                             reflect.ValueOf(constant.MakeFromLiteral("\"Memory.startSampling\"", token.STRING, 0)),
+		"CommandMemoryStopSampling":                              reflect.ValueOf(constant.MakeFromLiteral("\"Memory.stopSampling\"", token.STRING, 0)),
+		"CommandNetworkClearBrowserCache":                        reflect.ValueOf(constant.MakeFromLiteral("\"Network.clearBrowserCache\"", token.STRING, 0)),
+		"CommandNetworkClearBrowserCookies":                      reflect.ValueOf(constant.MakeFromLiteral("\"Network.clearBrowserCookies\"", token.STRING, 0)),
+		"CommandNetworkDeleteCookies":                            reflect.ValueOf(constant.MakeFromLiteral("\"Network.deleteCookies\"", token.STRING, 0)),
+		"CommandNetworkDisable":                                  reflect.ValueOf(constant.MakeFromLiteral("\"Network.disable\"", token.STRING, 0)),
+		"CommandNetworkEmulateNetworkConditions":                 reflect.ValueOf(constant.MakeFromLiteral("\"Network.emulateNetworkConditions\"", token.STRING, 0)),
+		"CommandNetworkEnable":                                   reflect.ValueOf(constant.MakeFromLiteral("\"Network.enable\"", token.STRING, 0)),
+		"CommandNetworkGetAllCookies":                            reflect.ValueOf(constant.MakeFromLiteral("\"Network.getAllCookies\"", token.STRING, 0)),
+		"CommandNetworkGetCertificate":                           reflect.ValueOf(constant.MakeFromLiteral("\"Network.getCertificate\"", token.STRING, 0)),
+		"CommandNetworkGetCookies":                               reflect.ValueOf(constant.MakeFromLiteral("\"Network.getCookies\"", token.STRING, 0)),
+		"CommandNetworkGetRequestPostData":                       reflect.ValueOf(constant.MakeFromLiteral("\"Network.getRequestPostData\"", token.STRING, 0)),
+		"CommandNetworkGetResponseBody":                          reflect.ValueOf(constant.MakeFromLiteral("\"Network.getResponseBody\"", token.STRING, 0)),
+		"CommandNetworkGetResponseBodyForInterception":           reflect.ValueOf(constant.MakeFromLiteral("\"Network.getResponseBodyForInterception\"", token.STRING, 0)),
+		"CommandNetworkReplayXHR":                                reflect.ValueOf(constant.MakeFromLiteral("\"Network.replayXHR\"", token.STRING, 0)),
+		"CommandNetworkSearchInResponseBody":                     reflect.ValueOf(constant.MakeFromLiteral("\"Network.searchInResponseBody\"", token.STRING, 0)),
+		"CommandNetworkSetBlockedURLS":                           reflect.ValueOf(constant.MakeFromLiteral("\"Network.setBlockedURLs\"", token.STRING, 0)),
+		"CommandNetworkSetBypassServiceWorker":                   reflect.ValueOf(constant.MakeFromLiteral("\"Network.setBypassServiceWorker\"", token.STRING, 0)),
+		"CommandNetworkSetCacheDisabled":                         reflect.ValueOf(constant.MakeFromLiteral("\"Network.setCacheDisabled\"", token.STRING, 0)),
+		"CommandNetworkSetCookie":                                reflect.ValueOf(constant.MakeFromLiteral("\"Network.setCookie\"", token.STRING, 0)),
+		"CommandNetworkSetCookies":                               reflect.ValueOf(constant.MakeFromLiteral("\"Network.setCookies\"", token.STRING, 0)),
+		"CommandNetworkSetDataSizeLimitsForTest":                 reflect.ValueOf(constant.MakeFromLiteral("\"Network.setDataSizeLimitsForTest\"", token.STRING, 0)),
+		"CommandNetworkSetExtraHTTPHeaders":                      reflect.ValueOf(constant.MakeFromLiteral("\"Network.setExtraHTTPHeaders\"", token.STRING, 0)),
+		"CommandNetworkTakeResponseBodyForInterceptionAsStream":  reflect.ValueOf(constant.MakeFromLiteral("\"Network.takeResponseBodyForInterceptionAsStream\"", token.STRING, 0)),
+		"CommandOverlayDisable":                                  reflect.ValueOf(constant.MakeFromLiteral("\"Overlay.disable\"", token.STRING, 0)),
+		"CommandOverlayEnable":                                   reflect.ValueOf(constant.MakeFromLiteral("\"Overlay.enable\"", token.STRING, 0)),
+		"CommandOverlayGetHighlightObjectForTest":                reflect.ValueOf(constant.MakeFromLiteral("\"Overlay.getHighlightObjectForTest\"", token.STRING, 0)),
+		"CommandOverlayHideHighlight":                            reflect.ValueOf(constant.MakeFromLiteral("\"Overlay.hideHighlight\"", token.STRING, 0)),
+		"CommandOverlayHighlightFrame":                           reflect.ValueOf(constant.MakeFromLiteral("\"Overlay.highlightFrame\"", token.STRING, 0)),
+		"CommandOverlayHighlightNode":                            reflect.ValueOf(constant.MakeFromLiteral("\"Overlay.highlightNode\"", token.STRING, 0)),
+		"CommandOverlayHighlightQuad":                            reflect.ValueOf(constant.MakeFromLiteral("\"Overlay.highlightQuad\"", token.STRING, 0)),
+		"CommandOverlayHighlightRect":                            reflect.ValueOf(constant.MakeFromLiteral("\"Overlay.highlightRect\"", token.STRING, 0)),
+		"CommandOverlaySetInspectMode":                           reflect.ValueOf(constant.MakeFromLiteral("\"Overlay.setInspectMode\"", token.STRING, 0)),
+		"CommandOverlaySetPausedInDebuggerMessage":               reflect.ValueOf(constant.MakeFromLiteral("\"Overlay.setPausedInDebuggerMessage\"", token.STRING, 0)),
+		"CommandOverlaySetShowAdHighlights":                      reflect.ValueOf(constant.MakeFromLiteral("\"Overlay.setShowAdHighlights\"", token.STRING, 0)),
+		"CommandOverlaySetShowDebugBorders":                      reflect.ValueOf(constant.MakeFromLiteral("\"Overlay.setShowDebugBorders\"", token.STRING, 0)),
+		"CommandOverlaySetShowFPSCounter":                        reflect.ValueOf(constant.MakeFromLiteral("\"Overlay.setShowFPSCounter\"", token.STRING, 0)),
+		"CommandOverlaySetShowHitTestBorders":                    reflect.ValueOf(constant.MakeFromLiteral("\"Overlay.setShowHitTestBorders\"", token.STRING, 0)),
+		"CommandOverlaySetShowLayoutShiftRegions":                reflect.ValueOf(constant.MakeFromLiteral("\"Overlay.setShowLayoutShiftRegions\"", token.STRING, 0)),
+		"CommandOverlaySetShowPaintRects":                        reflect.ValueOf(constant.MakeFromLiteral("\"Overlay.setShowPaintRects\"", token.STRING, 0)),
+		"CommandOverlaySetShowScrollBottleneckRects":             reflect.ValueOf(constant.MakeFromLiteral("\"Overlay.setShowScrollBottleneckRects\"", token.STRING, 0)),
+		"CommandOverlaySetShowViewportSizeOnResize":              reflect.ValueOf(constant.MakeFromLiteral("\"Overlay.setShowViewportSizeOnResize\"", token.STRING, 0)),
+		"CommandPageAddCompilationCache":                         reflect.ValueOf(constant.MakeFromLiteral("\"Page.addCompilationCache\"", token.STRING, 0)),
+		"CommandPageAddScriptToEvaluateOnNewDocument":            reflect.ValueOf(constant.MakeFromLiteral("\"Page.addScriptToEvaluateOnNewDocument\"", token.STRING, 0)),
+		"CommandPageBringToFront":                                reflect.ValueOf(constant.MakeFromLiteral("\"Page.bringToFront\"", token.STRING, 0)),
+		"CommandPageCaptureScreenshot":                           reflect.ValueOf(constant.MakeFromLiteral("\"Page.captureScreenshot\"", token.STRING, 0)),
+		"CommandPageCaptureSnapshot":                             reflect.ValueOf(constant.MakeFromLiteral("\"Page.captureSnapshot\"", token.STRING, 0)),
+		"CommandPageClearCompilationCache":                       reflect.ValueOf(constant.MakeFromLiteral("\"Page.clearCompilationCache\"", token.STRING, 0)),
+		"CommandPageClose":                                       reflect.ValueOf(constant.MakeFromLiteral("\"Page.close\"", token.STRING, 0)),
+		"CommandPageCrash":                                       reflect.ValueOf(constant.MakeFromLiteral("\"Page.crash\"", token.STRING, 0)),
+		"CommandPageCreateIsolatedWorld":                         reflect.ValueOf(constant.MakeFromLiteral("\"Page.createIsolatedWorld\"", token.STRING, 0)),
+		"CommandPageDisable":                                     reflect.ValueOf(constant.MakeFromLiteral("\"Page.disable\"", token.STRING, 0)),
+		"CommandPageEnable":                                      reflect.ValueOf(constant.MakeFromLiteral("\"Page.enable\"", token.STRING, 0)),
+		"CommandPageGenerateTestReport":                          reflect.ValueOf(constant.MakeFromLiteral("\"Page.generateTestReport\"", token.STRING, 0)),
+		"CommandPageGetAppManifest":                              reflect.ValueOf(constant.MakeFromLiteral("\"Page.getAppManifest\"", token.STRING, 0)),
+		"CommandPageGetFrameTree":                                reflect.ValueOf(constant.MakeFromLiteral("\"Page.getFrameTree\"", token.STRING, 0)),
+		"CommandPageGetInstallabilityErrors":                     reflect.ValueOf(constant.MakeFromLiteral("\"Page.getInstallabilityErrors\"", token.STRING, 0)),
+		"CommandPageGetLayoutMetrics":                            reflect.ValueOf(constant.MakeFromLiteral("\"Page.getLayoutMetrics\"", token.STRING, 0)),
+		"CommandPageGetNavigationHistory":                        reflect.ValueOf(constant.MakeFromLiteral("\"Page.getNavigationHistory\"", token.STRING, 0)),
+		"CommandPageGetResourceContent":                          reflect.ValueOf(constant.MakeFromLiteral("\"Page.getResourceContent\"", token.STRING, 0)),
+		"CommandPageGetResourceTree":                             reflect.ValueOf(constant.MakeFromLiteral("\"Page.getResourceTree\"", token.STRING, 0)),
+		"CommandPageHandleFileChooser":                           reflect.ValueOf(constant.MakeFromLiteral("\"Page.handleFileChooser\"", token.STRING, 0)),
+		"CommandPageHandleJavaScriptDialog":                      reflect.ValueOf(constant.MakeFromLiteral("\"Page.handleJavaScriptDialog\"", token.STRING, 0)),
+		"CommandPageNavigate":                                    reflect.ValueOf(constant.MakeFromLiteral("\"Page.navigate\"", token.STRING, 0)),
+		"CommandPageNavigateToHistoryEntry":                      reflect.ValueOf(constant.MakeFromLiteral("\"Page.navigateToHistoryEntry\"", token.STRING, 0)),
+		"CommandPagePrintToPDF":                                  reflect.ValueOf(constant.MakeFromLiteral("\"Page.printToPDF\"", token.STRING, 0)),
+		"CommandPageReload":                                      reflect.ValueOf(constant.MakeFromLiteral("\"Page.reload\"", token.STRING, 0)),
+		"CommandPageRemoveScriptToEvaluateOnNewDocument":         reflect.ValueOf(constant.MakeFromLiteral("\"Page.removeScriptToEvaluateOnNewDocument\"", token.STRING, 0)),
+		"CommandPageResetNavigationHistory":                      reflect.ValueOf(constant.MakeFromLiteral("\"Page.resetNavigationHistory\"", token.STRING, 0)),
+		"CommandPageScreencastFrameAck":                          reflect.ValueOf(constant.MakeFromLiteral("\"Page.screencastFrameAck\"", token.STRING, 0)),
+		"CommandPageSearchInResource":                            reflect.ValueOf(constant.MakeFromLiteral("\"Page.searchInResource\"", token.STRING, 0)),
+		"CommandPageSetAdBlockingEnabled":                        reflect.ValueOf(constant.MakeFromLiteral("\"Page.setAdBlockingEnabled\"", token.STRING, 0)),
+		"CommandPageSetBypassCSP":                                reflect.ValueOf(constant.MakeFromLiteral("\"Page.setBypassCSP\"", token.STRING, 0)),
+		"CommandPageSetDocumentContent":                          reflect.ValueOf(constant.MakeFromLiteral("\"Page.setDocumentContent\"", token.STRING, 0)),
+		"CommandPageSetDownloadBehavior":                         reflect.ValueOf(constant.MakeFromLiteral("\"Page.setDownloadBehavior\"", token.STRING, 0)),
+		"CommandPageSetFontFamilies":                             reflect.ValueOf(constant.MakeFromLiteral("\"Page.setFontFamilies\"", token.STRING, 0)),
+		"CommandPageSetFontSizes":                                reflect.ValueOf(constant.MakeFromLiteral("\"Page.setFontSizes\"", token.STRING, 0)),
+		"CommandPageSetInterceptFileChooserDialog":               reflect.ValueOf(constant.MakeFromLiteral("\"Page.setInterceptFileChooserDialog\"", token.STRING, 0)),
+		"CommandPageSetLifecycleEventsEnabled":                   reflect.ValueOf(constant.MakeFromLiteral("\"Page.setLifecycleEventsEnabled\"", token.STRING, 0)),
+		"CommandPageSetProduceCompilationCache":                  reflect.ValueOf(constant.MakeFromLiteral("\"Page.setProduceCompilationCache\"", token.STRING, 0)),
+		"CommandPageSetWebLifecycleState":                        reflect.ValueOf(constant.MakeFromLiteral("\"Page.setWebLifecycleState\"", token.STRING, 0)),
+		"CommandPageStartScreencast":                             reflect.ValueOf(constant.MakeFromLiteral("\"Page.startScreencast\"", token.STRING, 0)),
+		"CommandPageStopLoading":                                 reflect.ValueOf(constant.MakeFromLiteral("\"Page.stopLoading\"", token.STRING, 0)),
+		"CommandPageStopScreencast":                              reflect.ValueOf(constant.MakeFromLiteral("\"Page.stopScreencast\"", token.STRING, 0)),
+		"CommandPageWaitForDebugger":                             reflect.ValueOf(constant.MakeFromLiteral("\"Page.waitForDebugger\"", token.STRING, 0)),
+		"CommandPerformanceDisable":                              reflect.ValueOf(constant.MakeFromLiteral("\"Performance.disable\"", token.STRING, 0)),
+		"CommandPerformanceEnable":                               reflect.ValueOf(constant.MakeFromLiteral("\"Performance.enable\"", token.STRING, 0)),
+		"CommandPerformanceGetMetrics":                           reflect.ValueOf(constant.MakeFromLiteral("\"Performance.getMetrics\"", token.STRING, 0)),
+		"CommandPerformanceSetTimeDomain":                        reflect.ValueOf(constant.MakeFromLiteral("\"Performance.setTimeDomain\"", token.STRING, 0)),
+		"CommandProfilerDisable":                                 reflect.ValueOf(constant.MakeFromLiteral("\"Profiler.disable\"", token.STRING, 0)),
+		"CommandProfilerDisableRuntimeCallStats":                 reflect.ValueOf(constant.MakeFromLiteral("\"Profiler.disableRuntimeCallStats\"", token.STRING, 0)),
+		"CommandProfilerEnable":                                  reflect.ValueOf(constant.MakeFromLiteral("\"Profiler.enable\"", token.STRING, 0)),
+		"CommandProfilerEnableRuntimeCallStats":                  reflect.ValueOf(constant.MakeFromLiteral("\"Profiler.enableRuntimeCallStats\"", token.STRING, 0)),
+		"CommandProfilerGetBestEffortCoverage":                   reflect.ValueOf(constant.MakeFromLiteral("\"Profiler.getBestEffortCoverage\"", token.STRING, 0)),
+		"CommandProfilerGetRuntimeCallStats":                     reflect.ValueOf(constant.MakeFromLiteral("\"Profiler.getRuntimeCallStats\"", token.STRING, 0)),
+		"CommandProfilerSetSamplingInterval":                     reflect.ValueOf(constant.MakeFromLiteral("\"Profiler.setSamplingInterval\"", token.STRING, 0)),
+		"CommandProfilerStart":                                   reflect.ValueOf(constant.MakeFromLiteral("\"Profiler.start\"", token.STRING, 0)),
+		"CommandProfilerStartPreciseCoverage":                    reflect.ValueOf(constant.MakeFromLiteral("\"Profiler.startPreciseCoverage\"", token.STRING, 0)),
+		"CommandProfilerStartTypeProfile":                        reflect.ValueOf(constant.MakeFromLiteral("\"Profiler.startTypeProfile\"", token.STRING, 0)),
+		"CommandProfilerStop":                                    reflect.ValueOf(constant.MakeFromLiteral("\"Profiler.stop\"", token.STRING, 0)),
+		"CommandProfilerStopPreciseCoverage":                     reflect.ValueOf(constant.MakeFromLiteral("\"Profiler.stopPreciseCoverage\"", token.STRING, 0)),
+		"CommandProfilerStopTypeProfile":                         reflect.ValueOf(constant.MakeFromLiteral("\"Profiler.stopTypeProfile\"", token.STRING, 0)),
+		"CommandProfilerTakePreciseCoverage":                     reflect.ValueOf(constant.MakeFromLiteral("\"Profiler.takePreciseCoverage\"", token.STRING, 0)),
+		"CommandProfilerTakeTypeProfile":                         reflect.ValueOf(constant.MakeFromLiteral("\"Profiler.takeTypeProfile\"", token.STRING, 0)),
+		"CommandRuntimeAddBinding":                               reflect.ValueOf(constant.MakeFromLiteral("\"Runtime.addBinding\"", token.STRING, 0)),
+		"CommandRuntimeAwaitPromise":                             reflect.ValueOf(constant.MakeFromLiteral("\"Runtime.awaitPromise\"", token.STRING, 0)),
+		"CommandRuntimeCallFunctionOn":                           reflect.ValueOf(constant.MakeFromLiteral("\"Runtime.callFunctionOn\"", token.STRING, 0)),
+		"CommandRuntimeCompileScript":                            reflect.ValueOf(constant.MakeFromLiteral("\"Runtime.compileScript\"", token.STRING, 0)),
+		"CommandRuntimeDisable":                                  reflect.ValueOf(constant.MakeFromLiteral("\"Runtime.disable\"", token.STRING, 0)),
+		"CommandRuntimeDiscardConsoleEntries":                    reflect.ValueOf(constant.MakeFromLiteral("\"Runtime.discardConsoleEntries\"", token.STRING, 0)),
+		"CommandRuntimeEnable":                                   reflect.ValueOf(constant.MakeFromLiteral("\"Runtime.enable\"", token.STRING, 0)),
+		"CommandRuntimeEvaluate":                                 reflect.ValueOf(constant.MakeFromLiteral("\"Runtime.evaluate\"", token.STRING, 0)),
+		"CommandRuntimeGetHeapUsage":                             reflect.ValueOf(constant.MakeFromLiteral("\"Runtime.getHeapUsage\"", token.STRING, 0)),
+		"CommandRuntimeGetIsolateID":                             reflect.ValueOf(constant.MakeFromLiteral("\"Runtime.getIsolateId\"", token.STRING, 0)),
+		"CommandRuntimeGetProperties":                            reflect.ValueOf(constant.MakeFromLiteral("\"Runtime.getProperties\"", token.STRING, 0)),
+		"CommandRuntimeGlobalLexicalScopeNames":                  reflect.ValueOf(constant.MakeFromLiteral("\"Runtime.globalLexicalScopeNames\"", token.STRING, 0)),
+		"CommandRuntimeQueryObjects":                             reflect.ValueOf(constant.MakeFromLiteral("\"Runtime.queryObjects\"", token.STRING, 0)),
+		"CommandRuntimeReleaseObject":                            reflect.ValueOf(constant.MakeFromLiteral("\"Runtime.releaseObject\"", token.STRING, 0)),
+		"CommandRuntimeReleaseObjectGroup":                       reflect.ValueOf(constant.MakeFromLiteral("\"Runtime.releaseObjectGroup\"", token.STRING, 0)),
+		"CommandRuntimeRemoveBinding":                            reflect.ValueOf(constant.MakeFromLiteral("\"Runtime.removeBinding\"", token.STRING, 0)),
+		"CommandRuntimeRunIfWaitingForDebugger":                  reflect.ValueOf(constant.MakeFromLiteral("\"Runtime.runIfWaitingForDebugger\"", token.STRING, 0)),
+		"CommandRuntimeRunScript":                                reflect.ValueOf(constant.MakeFromLiteral("\"Runtime.runScript\"", token.STRING, 0)),
+		"CommandRuntimeSetCustomObjectFormatterEnabled":          reflect.ValueOf(constant.MakeFromLiteral("\"Runtime.setCustomObjectFormatterEnabled\"", token.STRING, 0)),
+		"CommandRuntimeSetMaxCallStackSizeToCapture":             reflect.ValueOf(constant.MakeFromLiteral("\"Runtime.setMaxCallStackSizeToCapture\"", token.STRING, 0)),
+		"CommandRuntimeTerminateExecution":                       reflect.ValueOf(constant.MakeFromLiteral("\"Runtime.terminateExecution\"", token.STRING, 0)),
+		"CommandSecurityDisable":                                 reflect.ValueOf(constant.MakeFromLiteral("\"Security.disable\"", token.STRING, 0)),
+		"CommandSecurityEnable":                                  reflect.ValueOf(constant.MakeFromLiteral("\"Security.enable\"", token.STRING, 0)),
+		"CommandSecuritySetIgnoreCertificateErrors":              reflect.ValueOf(constant.MakeFromLiteral("\"Security.setIgnoreCertificateErrors\"", token.STRING, 0)),
+		"CommandServiceWorkerDeliverPushMessage":                 reflect.ValueOf(constant.MakeFromLiteral("\"ServiceWorker.deliverPushMessage\"", token.STRING, 0)),
+		"CommandServiceWorkerDisable":                            reflect.ValueOf(constant.MakeFromLiteral("\"ServiceWorker.disable\"", token.STRING, 0)),
+		"CommandServiceWorkerDispatchPeriodicSyncEvent":          reflect.ValueOf(constant.MakeFromLiteral("\"ServiceWorker.dispatchPeriodicSyncEvent\"", token.STRING, 0)),
+		"CommandServiceWorkerDispatchSyncEvent":                  reflect.ValueOf(constant.MakeFromLiteral("\"ServiceWorker.dispatchSyncEvent\"", token.STRING, 0)),
+		"CommandServiceWorkerEnable":                             reflect.ValueOf(constant.MakeFromLiteral("\"ServiceWorker.enable\"", token.STRING, 0)),
+		"CommandServiceWorkerInspectWorker":                      reflect.ValueOf(constant.MakeFromLiteral("\"ServiceWorker.inspectWorker\"", token.STRING, 0)),
+		"CommandServiceWorkerSetForceUpdateOnPageLoad":           reflect.ValueOf(constant.MakeFromLiteral("\"ServiceWorker.setForceUpdateOnPageLoad\"", token.STRING, 0)),
+		"CommandServiceWorkerSkipWaiting":                        reflect.ValueOf(constant.MakeFromLiteral("\"ServiceWorker.skipWaiting\"", token.STRING, 0)),
+		"CommandServiceWorkerStartWorker":                        reflect.ValueOf(constant.MakeFromLiteral("\"ServiceWorker.startWorker\"", token.STRING, 0)),
+		"CommandServiceWorkerStopAllWorkers":                     reflect.ValueOf(constant.MakeFromLiteral("\"ServiceWorker.stopAllWorkers\"", token.STRING, 0)),
+		"CommandServiceWorkerStopWorker":                         reflect.ValueOf(constant.MakeFromLiteral("\"ServiceWorker.stopWorker\"", token.STRING, 0)),
+		"CommandServiceWorkerUnregister":                         reflect.ValueOf(constant.MakeFromLiteral("\"ServiceWorker.unregister\"", token.STRING, 0)),
+		"CommandServiceWorkerUpdateRegistration":                 reflect.ValueOf(constant.MakeFromLiteral("\"ServiceWorker.updateRegistration\"", token.STRING, 0)),
+		"CommandStorageClearDataForOrigin":                       reflect.ValueOf(constant.MakeFromLiteral("\"Storage.clearDataForOrigin\"", token.STRING, 0)),
+		"CommandStorageGetUsageAndQuota":                         reflect.ValueOf(constant.MakeFromLiteral("\"Storage.getUsageAndQuota\"", token.STRING, 0)),
+		"CommandStorageTrackCacheStorageForOrigin":               reflect.ValueOf(constant.MakeFromLiteral("\"Storage.trackCacheStorageForOrigin\"", token.STRING, 0)),
+		"CommandStorageTrackIndexedDBForOrigin":                  reflect.ValueOf(constant.MakeFromLiteral("\"Storage.trackIndexedDBForOrigin\"", token.STRING, 0)),
+		"CommandStorageUntrackCacheStorageForOrigin":             reflect.ValueOf(constant.MakeFromLiteral("\"Storage.untrackCacheStorageForOrigin\"", token.STRING, 0)),
+		"CommandStorageUntrackIndexedDBForOrigin":                reflect.ValueOf(constant.MakeFromLiteral("\"Storage.untrackIndexedDBForOrigin\"", token.STRING, 0)),
+		"CommandSystemInfoGetInfo":                               reflect.ValueOf(constant.MakeFromLiteral("\"SystemInfo.getInfo\"", token.STRING, 0)),
+		"CommandSystemInfoGetProcessInfo":                        reflect.ValueOf(constant.MakeFromLiteral("\"SystemInfo.getProcessInfo\"", token.STRING, 0)),
+		"CommandTargetActivateTarget":                            reflect.ValueOf(constant.MakeFromLiteral("\"Target.activateTarget\"", token.STRING, 0)),
+		"CommandTargetAttachToBrowserTarget":                     reflect.ValueOf(constant.MakeFromLiteral("\"Target.attachToBrowserTarget\"", token.STRING, 0)),
+		"CommandTargetAttachToTarget":                            reflect.ValueOf(constant.MakeFromLiteral("\"Target.attachToTarget\"", token.STRING, 0)),
+		"CommandTargetCloseTarget":                               reflect.ValueOf(constant.MakeFromLiteral("\"Target.closeTarget\"", token.STRING, 0)),
+		"CommandTargetCreateBrowserContext":                      reflect.ValueOf(constant.MakeFromLiteral("\"Target.createBrowserContext\"", token.STRING, 0)),
+		"CommandTargetCreateTarget":                              reflect.ValueOf(constant.MakeFromLiteral("\"Target.createTarget\"", token.STRING, 0)),
+		"CommandTargetDetachFromTarget":                          reflect.ValueOf(constant.MakeFromLiteral("\"Target.detachFromTarget\"", token.STRING, 0)),
+		"CommandTargetDisposeBrowserContext":                     reflect.ValueOf(constant.MakeFromLiteral("\"Target.disposeBrowserContext\"", token.STRING, 0)),
+		"CommandTargetExposeDevToolsProtocol":                    reflect.ValueOf(constant.MakeFromLiteral("\"Target.exposeDevToolsProtocol\"", token.STRING, 0)),
+		"CommandTargetGetBrowserContexts":                        reflect.ValueOf(constant.MakeFromLiteral("\"Target.getBrowserContexts\"", token.STRING, 0)),
+		"CommandTargetGetTargetInfo":                             reflect.ValueOf(constant.MakeFromLiteral("\"Target.getTargetInfo\"", token.STRING, 0)),
+		"CommandTargetGetTargets":                                reflect.ValueOf(constant.MakeFromLiteral("\"Target.getTargets\"", token.STRING, 0)),
+		"CommandTargetSetAutoAttach":                             reflect.ValueOf(constant.MakeFromLiteral("\"Target.setAutoAttach\"", token.STRING, 0)),
+		"CommandTargetSetDiscoverTargets":                        reflect.ValueOf(constant.MakeFromLiteral("\"Target.setDiscoverTargets\"", token.STRING, 0)),
+		"CommandTargetSetRemoteLocations":                        reflect.ValueOf(constant.MakeFromLiteral("\"Target.setRemoteLocations\"", token.STRING, 0)),
+		"CommandTetheringBind":                                   reflect.ValueOf(constant.MakeFromLiteral("\"Tethering.bind\"", token.STRING, 0)),
+		"CommandTetheringUnbind":                                 reflect.ValueOf(constant.MakeFromLiteral("\"Tethering.unbind\"", token.STRING, 0)),
+		"CommandTracingEnd":                                      reflect.ValueOf(constant.MakeFromLiteral("\"Tracing.end\"", token.STRING, 0)),
+		"CommandTracingGetCategories":                            reflect.ValueOf(constant.MakeFromLiteral("\"Tracing.getCategories\"", token.STRING, 0)),
+		"CommandTracingRecordClockSyncMarker":                    reflect.ValueOf(constant.MakeFromLiteral("\"Tracing.recordClockSyncMarker\"", token.STRING, 0)),
+		"CommandTracingRequestMemoryDump":                        reflect.ValueOf(constant.MakeFromLiteral("\"Tracing.requestMemoryDump\"", token.STRING, 0)),
+		"CommandTracingStart":                                    reflect.ValueOf(constant.MakeFromLiteral("\"Tracing.start\"", token.STRING, 0)),
+		"CommandWebAudioDisable":                                 reflect.ValueOf(constant.MakeFromLiteral("\"WebAudio.disable\"", token.STRING, 0)),
+		"CommandWebAudioEnable":                                  reflect.ValueOf(constant.MakeFromLiteral("\"WebAudio.enable\"", token.STRING, 0)),
+		"CommandWebAudioGetRealtimeData":                         reflect.ValueOf(constant.MakeFromLiteral("\"WebAudio.getRealtimeData\"", token.STRING, 0)),
+		"CommandWebAuthnAddCredential":                           reflect.ValueOf(constant.MakeFromLiteral("\"WebAuthn.addCredential\"", token.STRING, 0)),
+		"CommandWebAuthnAddVirtualAuthenticator":                 reflect.ValueOf(constant.MakeFromLiteral("\"WebAuthn.addVirtualAuthenticator\"", token.STRING, 0)),
+		"CommandWebAuthnClearCredentials":                        reflect.ValueOf(constant.MakeFromLiteral("\"WebAuthn.clearCredentials\"", token.STRING, 0)),
+		"CommandWebAuthnDisable":                                 reflect.ValueOf(constant.MakeFromLiteral("\"WebAuthn.disable\"", token.STRING, 0)),
+		"CommandWebAuthnEnable":                                  reflect.ValueOf(constant.MakeFromLiteral("\"WebAuthn.enable\"", token.STRING, 0)),
+		"CommandWebAuthnGetCredential":                           reflect.ValueOf(constant.MakeFromLiteral("\"WebAuthn.getCredential\"", token.STRING, 0)),
+		"CommandWebAuthnGetCredentials":                          reflect.ValueOf(constant.MakeFromLiteral("\"WebAuthn.getCredentials\"", token.STRING, 0)),
+		"CommandWebAuthnRemoveCredential":                        reflect.ValueOf(constant.MakeFromLiteral("\"WebAuthn.removeCredential\"", token.STRING, 0)),
+		"CommandWebAuthnRemoveVirtualAuthenticator":              reflect.ValueOf(constant.MakeFromLiteral("\"WebAuthn.removeVirtualAuthenticator\"", token.STRING, 0)),
+		"CommandWebAuthnSetUserVerified":                         reflect.ValueOf(constant.MakeFromLiteral("\"WebAuthn.setUserVerified\"", token.STRING, 0)),
+		"EventAnimationAnimationCanceled":                        reflect.ValueOf(constant.MakeFromLiteral("\"Animation.animationCanceled\"", token.STRING, 0)),
+		"EventAnimationAnimationCreated":                         reflect.ValueOf(constant.MakeFromLiteral("\"Animation.animationCreated\"", token.STRING, 0)),
+		"EventAnimationAnimationStarted":                         reflect.ValueOf(constant.MakeFromLiteral("\"Animation.animationStarted\"", token.STRING, 0)),
+		"EventApplicationCacheApplicationCacheStatusUpdated":     reflect.ValueOf(constant.MakeFromLiteral("\"ApplicationCache.applicationCacheStatusUpdated\"", token.STRING, 0)),
+		"EventApplicationCacheNetworkStateUpdated":               reflect.ValueOf(constant.MakeFromLiteral("\"ApplicationCache.networkStateUpdated\"", token.STRING, 0)),
+		"EventBackgroundServiceBackgroundServiceEventReceived":   reflect.ValueOf(constant.MakeFromLiteral("\"BackgroundService.backgroundServiceEventReceived\"", token.STRING, 0)),
+		"EventBackgroundServiceRecordingStateChanged":            reflect.ValueOf(constant.MakeFromLiteral("\"BackgroundService.recordingStateChanged\"", token.STRING, 0)),
+		"EventCSSFontsUpdated":                                   reflect.ValueOf(constant.MakeFromLiteral("\"CSS.fontsUpdated\"", token.STRING, 0)),
+		"EventCSSMediaQueryResultChanged":                        reflect.ValueOf(constant.MakeFromLiteral("\"CSS.mediaQueryResultChanged\"", token.STRING, 0)),
+		"EventCSSStyleSheetAdded":                                reflect.ValueOf(constant.MakeFromLiteral("\"CSS.styleSheetAdded\"", token.STRING, 0)),
+		"EventCSSStyleSheetChanged":                              reflect.ValueOf(constant.MakeFromLiteral("\"CSS.styleSheetChanged\"", token.STRING, 0)),
+		"EventCSSStyleSheetRemoved":                              reflect.ValueOf(constant.MakeFromLiteral("\"CSS.styleSheetRemoved\"", token.STRING, 0)),
+		"EventCastIssueUpdated":                                  reflect.ValueOf(constant.MakeFromLiteral("\"Cast.issueUpdated\"", token.STRING, 0)),
+		"EventCastSinksUpdated":                                  reflect.ValueOf(constant.MakeFromLiteral("\"Cast.sinksUpdated\"", token.STRING, 0)),
+		"EventDOMAttributeModified":                              reflect.ValueOf(constant.MakeFromLiteral("\"DOM.attributeModified\"", token.STRING, 0)),
+		"EventDOMAttributeRemoved":                               reflect.ValueOf(constant.MakeFromLiteral("\"DOM.attributeRemoved\"", token.STRING, 0)),
+		"EventDOMCharacterDataModified":                          reflect.ValueOf(constant.MakeFromLiteral("\"DOM.characterDataModified\"", token.STRING, 0)),
+		"EventDOMChildNodeCountUpdated":                          reflect.ValueOf(constant.MakeFromLiteral("\"DOM.childNodeCountUpdated\"", token.STRING, 0)),
+		"EventDOMChildNodeInserted":                              reflect.ValueOf(constant.MakeFromLiteral("\"DOM.childNodeInserted\"", token.STRING, 0)),
+		"EventDOMChildNodeRemoved":                               reflect.ValueOf(constant.MakeFromLiteral("\"DOM.childNodeRemoved\"", token.STRING, 0)),
+		"EventDOMDistributedNodesUpdated":                        reflect.ValueOf(constant.MakeFromLiteral("\"DOM.distributedNodesUpdated\"", token.STRING, 0)),
+		"EventDOMDocumentUpdated":                                reflect.ValueOf(constant.MakeFromLiteral("\"DOM.documentUpdated\"", token.STRING, 0)),
+		"EventDOMInlineStyleInvalidated":                         reflect.ValueOf(constant.MakeFromLiteral("\"DOM.inlineStyleInvalidated\"", token.STRING, 0)),
+		"EventDOMPseudoElementAdded":                             reflect.ValueOf(constant.MakeFromLiteral("\"DOM.pseudoElementAdded\"", token.STRING, 0)),
+		"EventDOMPseudoElementRemoved":                           reflect.ValueOf(constant.MakeFromLiteral("\"DOM.pseudoElementRemoved\"", token.STRING, 0)),
+		"EventDOMSetChildNodes":                                  reflect.ValueOf(constant.MakeFromLiteral("\"DOM.setChildNodes\"", token.STRING, 0)),
+		"EventDOMShadowRootPopped":                               reflect.ValueOf(constant.MakeFromLiteral("\"DOM.shadowRootPopped\"", token.STRING, 0)),
+		"EventDOMShadowRootPushed":                               reflect.ValueOf(constant.MakeFromLiteral("\"DOM.shadowRootPushed\"", token.STRING, 0)),
+		"EventDOMStorageDomStorageItemAdded":                     reflect.ValueOf(constant.MakeFromLiteral("\"DOMStorage.domStorageItemAdded\"", token.STRING, 0)),
+		"EventDOMStorageDomStorageItemRemoved":                   reflect.ValueOf(constant.MakeFromLiteral("\"DOMStorage.domStorageItemRemoved\"", token.STRING, 0)),
+		"EventDOMStorageDomStorageItemUpdated":                   reflect.ValueOf(constant.MakeFromLiteral("\"DOMStorage.domStorageItemUpdated\"", token.STRING, 0)),
+		"EventDOMStorageDomStorageItemsCleared":                  reflect.ValueOf(constant.MakeFromLiteral("\"DOMStorage.domStorageItemsCleared\"", token.STRING, 0)),
+		"EventDatabaseAddDatabase":                               reflect.ValueOf(constant.MakeFromLiteral("\"Database.addDatabase\"", token.STRING, 0)),
+		"EventDebuggerBreakpointResolved":                        reflect.ValueOf(constant.MakeFromLiteral("\"Debugger.breakpointResolved\"", token.STRING, 0)),
+		"EventDebuggerPaused":                                    reflect.ValueOf(constant.MakeFromLiteral("\"Debugger.paused\"", token.STRING, 0)),
+		"EventDebuggerResumed":                                   reflect.ValueOf(constant.MakeFromLiteral("\"Debugger.resumed\"", token.STRING, 0)),
+		"EventDebuggerScriptFailedToParse":                       reflect.ValueOf(constant.MakeFromLiteral("\"Debugger.scriptFailedToParse\"", token.STRING, 0)),
+		"EventDebuggerScriptParsed":                              reflect.ValueOf(constant.MakeFromLiteral("\"Debugger.scriptParsed\"", token.STRING, 0)),
+		"EventEmulationVirtualTimeBudgetExpired":                 reflect.ValueOf(constant.MakeFromLiteral("\"Emulation.virtualTimeBudgetExpired\"", token.STRING, 0)),
+		"EventFetchAuthRequired":                                 reflect.ValueOf(constant.MakeFromLiteral("\"Fetch.authRequired\"", token.STRING, 0)),
+		"EventFetchRequestPaused":                                reflect.ValueOf(constant.MakeFromLiteral("\"Fetch.requestPaused\"", token.STRING, 0)),
+		"EventHeapProfilerAddHeapSnapshotChunk":                  reflect.ValueOf(constant.MakeFromLiteral("\"HeapProfiler.addHeapSnapshotChunk\"", token.STRING, 0)),
+		"EventHeapProfilerHeapStatsUpdate":                       reflect.ValueOf(constant.MakeFromLiteral("\"HeapProfiler.heapStatsUpdate\"", token.STRING, 0)),
+		"EventHeapProfilerLastSeenObjectID":                      reflect.ValueOf(constant.MakeFromLiteral("\"HeapProfiler.lastSeenObjectId\"", token.STRING, 0)),
+		"EventHeapProfilerReportHeapSnapshotProgress":            reflect.ValueOf(constant.MakeFromLiteral("\"HeapProfiler.reportHeapSnapshotProgress\"", token.STRING, 0)),
+		"EventHeapProfilerResetProfiles":                         reflect.ValueOf(constant.MakeFromLiteral("\"HeapProfiler.resetProfiles\"", token.STRING, 0)),
+		"EventInspectorDetached":                                 reflect.ValueOf(constant.MakeFromLiteral("\"Inspector.detached\"", token.STRING, 0)),
+		"EventInspectorTargetCrashed":                            reflect.ValueOf(constant.MakeFromLiteral("\"Inspector.targetCrashed\"", token.STRING, 0)),
+		"EventInspectorTargetReloadedAfterCrash":                 reflect.ValueOf(constant.MakeFromLiteral("\"Inspector.targetReloadedAfterCrash\"", token.STRING, 0)),
+		"EventLayerTreeLayerPainted":                             reflect.ValueOf(constant.MakeFromLiteral("\"LayerTree.layerPainted\"", token.STRING, 0)),
+		"EventLayerTreeLayerTreeDidChange":                       reflect.ValueOf(constant.MakeFromLiteral("\"LayerTree.layerTreeDidChange\"", token.STRING, 0)),
+		"EventLogEntryAdded":                                     reflect.ValueOf(constant.MakeFromLiteral("\"Log.entryAdded\"", token.STRING, 0)),
+		"EventMediaPlayerEventsAdded":                            reflect.ValueOf(constant.MakeFromLiteral("\"Media.playerEventsAdded\"", token.STRING, 0)),
+		"EventMediaPlayerPropertiesChanged":                      reflect.ValueOf(constant.MakeFromLiteral("\"Media.playerPropertiesChanged\"", token.STRING, 0)),
+		"EventMediaPlayersCreated":                               reflect.ValueOf(constant.MakeFromLiteral("\"Media.playersCreated\"", token.STRING, 0)),
+		"EventNetworkDataReceived":                               reflect.ValueOf(constant.MakeFromLiteral("\"Network.dataReceived\"", token.STRING, 0)),
+		"EventNetworkEventSourceMessageReceived":                 reflect.ValueOf(constant.MakeFromLiteral("\"Network.eventSourceMessageReceived\"", token.STRING, 0)),
+		"EventNetworkLoadingFailed":                              reflect.ValueOf(constant.MakeFromLiteral("\"Network.loadingFailed\"", token.STRING, 0)),
+		"EventNetworkLoadingFinished":                            reflect.ValueOf(constant.MakeFromLiteral("\"Network.loadingFinished\"", token.STRING, 0)),
+		"EventNetworkRequestServedFromCache":                     reflect.ValueOf(constant.MakeFromLiteral("\"Network.requestServedFromCache\"", token.STRING, 0)),
+		"EventNetworkRequestWillBeSent":                          reflect.ValueOf(constant.MakeFromLiteral("\"Network.requestWillBeSent\"", token.STRING, 0)),
+		"EventNetworkRequestWillBeSentExtraInfo":                 reflect.ValueOf(constant.MakeFromLiteral("\"Network.requestWillBeSentExtraInfo\"", token.STRING, 0)),
+		"EventNetworkResourceChangedPriority":                    reflect.ValueOf(constant.MakeFromLiteral("\"Network.resourceChangedPriority\"", token.STRING, 0)),
+		"EventNetworkResponseReceived":                           reflect.ValueOf(constant.MakeFromLiteral("\"Network.responseReceived\"", token.STRING, 0)),
+		"EventNetworkResponseReceivedExtraInfo":                  reflect.ValueOf(constant.MakeFromLiteral("\"Network.responseReceivedExtraInfo\"", token.STRING, 0)),
+		"EventNetworkSignedExchangeReceived":                     reflect.ValueOf(constant.MakeFromLiteral("\"Network.signedExchangeReceived\"", token.STRING, 0)),
+		"EventNetworkWebSocketClosed":                            reflect.ValueOf(constant.MakeFromLiteral("\"Network.webSocketClosed\"", token.STRING, 0)),
+		"EventNetworkWebSocketCreated":                           reflect.ValueOf(constant.MakeFromLiteral("\"Network.webSocketCreated\"", token.STRING, 0)),
+		"EventNetworkWebSocketFrameError":                        reflect.ValueOf(constant.MakeFromLiteral("\"Network.webSocketFrameError\"", token.STRING, 0)),
+		"EventNetworkWebSocketFrameReceived":                     reflect.ValueOf(constant.MakeFromLiteral("\"Network.webSocketFrameReceived\"", token.STRING, 0)),
+		"EventNetworkWebSocketFrameSent":                         reflect.ValueOf(constant.MakeFromLiteral("\"Network.webSocketFrameSent\"", token.STRING, 0)),
+		"EventNetworkWebSocketHandshakeResponseReceived":         reflect.ValueOf(constant.MakeFromLiteral("\"Network.webSocketHandshakeResponseReceived\"", token.STRING, 0)),
+		"EventNetworkWebSocketWillSendHandshakeRequest":          reflect.ValueOf(constant.MakeFromLiteral("\"Network.webSocketWillSendHandshakeRequest\"", token.STRING, 0)),
+		"EventOverlayInspectModeCanceled":                        reflect.ValueOf(constant.MakeFromLiteral("\"Overlay.inspectModeCanceled\"", token.STRING, 0)),
+		"EventOverlayInspectNodeRequested":                       reflect.ValueOf(constant.MakeFromLiteral("\"Overlay.inspectNodeRequested\"", token.STRING, 0)),
+		"EventOverlayNodeHighlightRequested":                     reflect.ValueOf(constant.MakeFromLiteral("\"Overlay.nodeHighlightRequested\"", token.STRING, 0)),
+		"EventOverlayScreenshotRequested":                        reflect.ValueOf(constant.MakeFromLiteral("\"Overlay.screenshotRequested\"", token.STRING, 0)),
+		"EventPageCompilationCacheProduced":                      reflect.ValueOf(constant.MakeFromLiteral("\"Page.compilationCacheProduced\"", token.STRING, 0)),
+		"EventPageDomContentEventFired":                          reflect.ValueOf(constant.MakeFromLiteral("\"Page.domContentEventFired\"", token.STRING, 0)),
+		"EventPageDownloadWillBegin":                             reflect.ValueOf(constant.MakeFromLiteral("\"Page.downloadWillBegin\"", token.STRING, 0)),
+		"EventPageFileChooserOpened":                             reflect.ValueOf(constant.MakeFromLiteral("\"Page.fileChooserOpened\"", token.STRING, 0)),
+		"EventPageFrameAttached":                                 reflect.ValueOf(constant.MakeFromLiteral("\"Page.frameAttached\"", token.STRING, 0)),
+		"EventPageFrameDetached":                                 reflect.ValueOf(constant.MakeFromLiteral("\"Page.frameDetached\"", token.STRING, 0)),
+		"EventPageFrameNavigated":                                reflect.ValueOf(constant.MakeFromLiteral("\"Page.frameNavigated\"", token.STRING, 0)),
+		"EventPageFrameRequestedNavigation":                      reflect.ValueOf(constant.MakeFromLiteral("\"Page.frameRequestedNavigation\"", token.STRING, 0)),
+		"EventPageFrameResized":                                  reflect.ValueOf(constant.MakeFromLiteral("\"Page.frameResized\"", token.STRING, 0)),
+		"EventPageFrameStartedLoading":                           reflect.ValueOf(constant.MakeFromLiteral("\"Page.frameStartedLoading\"", token.STRING, 0)),
+		"EventPageFrameStoppedLoading":                           reflect.ValueOf(constant.MakeFromLiteral("\"Page.frameStoppedLoading\"", token.STRING, 0)),
+		"EventPageInterstitialHidden":                            reflect.ValueOf(constant.MakeFromLiteral("\"Page.interstitialHidden\"", token.STRING, 0)),
+		"EventPageInterstitialShown":                             reflect.ValueOf(constant.MakeFromLiteral("\"Page.interstitialShown\"", token.STRING, 0)),
+		"EventPageJavascriptDialogClosed":                        reflect.ValueOf(constant.MakeFromLiteral("\"Page.javascriptDialogClosed\"", token.STRING, 0)),
+		"EventPageJavascriptDialogOpening":                       reflect.ValueOf(constant.MakeFromLiteral("\"Page.javascriptDialogOpening\"", token.STRING, 0)),
+		"EventPageLifecycleEvent":                                reflect.ValueOf(constant.MakeFromLiteral("\"Page.lifecycleEvent\"", token.STRING, 0)),
+		"EventPageLoadEventFired":                                reflect.ValueOf(constant.MakeFromLiteral("\"Page.loadEventFired\"", token.STRING, 0)),
+		"EventPageNavigatedWithinDocument":                       reflect.ValueOf(constant.MakeFromLiteral("\"Page.navigatedWithinDocument\"", token.STRING, 0)),
+		"EventPageScreencastFrame":                               reflect.ValueOf(constant.MakeFromLiteral("\"Page.screencastFrame\"", token.STRING, 0)),
+		"EventPageScreencastVisibilityChanged":                   reflect.ValueOf(constant.MakeFromLiteral("\"Page.screencastVisibilityChanged\"", token.STRING, 0)),
+		"EventPageWindowOpen":                                    reflect.ValueOf(constant.MakeFromLiteral("\"Page.windowOpen\"", token.STRING, 0)),
+		"EventPerformanceMetrics":                                reflect.ValueOf(constant.MakeFromLiteral("\"Performance.metrics\"", token.STRING, 0)),
+		"EventProfilerConsoleProfileFinished":                    reflect.ValueOf(constant.MakeFromLiteral("\"Profiler.consoleProfileFinished\"", token.STRING, 0)),
+		"EventProfilerConsoleProfileStarted":                     reflect.ValueOf(constant.MakeFromLiteral("\"Profiler.consoleProfileStarted\"", token.STRING, 0)),
+		"EventRuntimeBindingCalled":                              reflect.ValueOf(constant.MakeFromLiteral("\"Runtime.bindingCalled\"", token.STRING, 0)),
+		"EventRuntimeConsoleAPICalled":                           reflect.ValueOf(constant.MakeFromLiteral("\"Runtime.consoleAPICalled\"", token.STRING, 0)),
+		"EventRuntimeExceptionRevoked":                           reflect.ValueOf(constant.MakeFromLiteral("\"Runtime.exceptionRevoked\"", token.STRING, 0)),
+		"EventRuntimeExceptionThrown":                            reflect.ValueOf(constant.MakeFromLiteral("\"Runtime.exceptionThrown\"", token.STRING, 0)),
+		"EventRuntimeExecutionContextCreated":                    reflect.ValueOf(constant.MakeFromLiteral("\"Runtime.executionContextCreated\"", token.STRING, 0)),
+		"EventRuntimeExecutionContextDestroyed":                  reflect.ValueOf(constant.MakeFromLiteral("\"Runtime.executionContextDestroyed\"", token.STRING, 0)),
+		"EventRuntimeExecutionContextsCleared":                   reflect.ValueOf(constant.MakeFromLiteral("\"Runtime.executionContextsCleared\"", token.STRING, 0)),
+		"EventRuntimeInspectRequested":                           reflect.ValueOf(constant.MakeFromLiteral("\"Runtime.inspectRequested\"", token.STRING, 0)),
+		"EventSecuritySecurityStateChanged":                      reflect.ValueOf(constant.MakeFromLiteral("\"Security.securityStateChanged\"", token.STRING, 0)),
+		"EventSecurityVisibleSecurityStateChanged":               reflect.ValueOf(constant.MakeFromLiteral("\"Security.visibleSecurityStateChanged\"", token.STRING, 0)),
+		"EventServiceWorkerWorkerErrorReported":                  reflect.ValueOf(constant.MakeFromLiteral("\"ServiceWorker.workerErrorReported\"", token.STRING, 0)),
+		"EventServiceWorkerWorkerRegistrationUpdated":            reflect.ValueOf(constant.MakeFromLiteral("\"ServiceWorker.workerRegistrationUpdated\"", token.STRING, 0)),
+		"EventServiceWorkerWorkerVersionUpdated":                 reflect.ValueOf(constant.MakeFromLiteral("\"ServiceWorker.workerVersionUpdated\"", token.STRING, 0)),
+		"EventStorageCacheStorageContentUpdated":                 reflect.ValueOf(constant.MakeFromLiteral("\"Storage.cacheStorageContentUpdated\"", token.STRING, 0)),
+		"EventStorageCacheStorageListUpdated":                    reflect.ValueOf(constant.MakeFromLiteral("\"Storage.cacheStorageListUpdated\"", token.STRING, 0)),
+		"EventStorageIndexedDBContentUpdated":                    reflect.ValueOf(constant.MakeFromLiteral("\"Storage.indexedDBContentUpdated\"", token.STRING, 0)),
+		"EventStorageIndexedDBListUpdated":                       reflect.ValueOf(constant.MakeFromLiteral("\"Storage.indexedDBListUpdated\"", token.STRING, 0)),
+		"EventTargetAttachedToTarget":                            reflect.ValueOf(constant.MakeFromLiteral("\"Target.attachedToTarget\"", token.STRING, 0)),
+		"EventTargetDetachedFromTarget":                          reflect.ValueOf(constant.MakeFromLiteral("\"Target.detachedFromTarget\"", token.STRING, 0)),
+		"EventTargetReceivedMessageFromTarget":                   reflect.ValueOf(constant.MakeFromLiteral("\"Target.receivedMessageFromTarget\"", token.STRING, 0)),
+		"EventTargetTargetCrashed":                               reflect.ValueOf(constant.MakeFromLiteral("\"Target.targetCrashed\"", token.STRING, 0)),
+		"EventTargetTargetCreated":                               reflect.ValueOf(constant.MakeFromLiteral("\"Target.targetCreated\"", token.STRING, 0)),
+		"EventTargetTargetDestroyed":                             reflect.ValueOf(constant.MakeFromLiteral("\"Target.targetDestroyed\"", token.STRING, 0)),
+		"EventTargetTargetInfoChanged":                           reflect.ValueOf(constant.MakeFromLiteral("\"Target.targetInfoChanged\"", token.STRING, 0)),
+		"EventTetheringAccepted":                                 reflect.ValueOf(constant.MakeFromLiteral("\"Tethering.accepted\"", token.STRING, 0)),
+		"EventTracingBufferUsage":                                reflect.ValueOf(constant.MakeFromLiteral("\"Tracing.bufferUsage\"", token.STRING, 0)),
+		"EventTracingDataCollected":                              reflect.ValueOf(constant.MakeFromLiteral("\"Tracing.dataCollected\"", token.STRING, 0)),
+		"EventTracingTracingComplete":                            reflect.ValueOf(constant.MakeFromLiteral("\"Tracing.tracingComplete\"", token.STRING, 0)),
+		"EventWebAudioAudioListenerCreated":                      reflect.ValueOf(constant.MakeFromLiteral("\"WebAudio.audioListenerCreated\"", token.STRING, 0)),
+		"EventWebAudioAudioListenerWillBeDestroyed":              reflect.ValueOf(constant.MakeFromLiteral("\"WebAudio.audioListenerWillBeDestroyed\"", token.STRING, 0)),
+		"EventWebAudioAudioNodeCreated":                          reflect.ValueOf(constant.MakeFromLiteral("\"WebAudio.audioNodeCreated\"", token.STRING, 0)),
+		"EventWebAudioAudioNodeWillBeDestroyed":                  reflect.ValueOf(constant.MakeFromLiteral("\"WebAudio.audioNodeWillBeDestroyed\"", token.STRING, 0)),
+		"EventWebAudioAudioParamCreated":                         reflect.ValueOf(constant.MakeFromLiteral("\"WebAudio.audioParamCreated\"", token.STRING, 0)),
+		"EventWebAudioAudioParamWillBeDestroyed":                 reflect.ValueOf(constant.MakeFromLiteral("\"WebAudio.audioParamWillBeDestroyed\"", token.STRING, 0)),
+		"EventWebAudioContextChanged":                            reflect.ValueOf(constant.MakeFromLiteral("\"WebAudio.contextChanged\"", token.STRING, 0)),
+		"EventWebAudioContextCreated":                            reflect.ValueOf(constant.MakeFromLiteral("\"WebAudio.contextCreated\"", token.STRING, 0)),
+		"EventWebAudioContextWillBeDestroyed":                    reflect.ValueOf(constant.MakeFromLiteral("\"WebAudio.contextWillBeDestroyed\"", token.STRING, 0)),
+		"EventWebAudioNodeParamConnected":                        reflect.ValueOf(constant.MakeFromLiteral("\"WebAudio.nodeParamConnected\"", token.STRING, 0)),
+		"EventWebAudioNodeParamDisconnected":                     reflect.ValueOf(constant.MakeFromLiteral("\"WebAudio.nodeParamDisconnected\"", token.STRING, 0)),
+		"EventWebAudioNodesConnected":                            reflect.ValueOf(constant.MakeFromLiteral("\"WebAudio.nodesConnected\"", token.STRING, 0)),
+		"EventWebAudioNodesDisconnected":                         reflect.ValueOf(constant.MakeFromLiteral("\"WebAudio.nodesDisconnected\"", token.STRING, 0)),
+		"UnmarshalMessage":                                       reflect.ValueOf(cdproto.UnmarshalMessage),
+
+		// type definitions
+		"Error":      reflect.ValueOf((*cdproto.Error)(nil)),
+		"Message":    reflect.ValueOf((*cdproto.Message)(nil)),
+		"MethodType": reflect.ValueOf((*cdproto.MethodType)(nil)),
+	}
+}
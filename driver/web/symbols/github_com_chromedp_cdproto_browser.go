@@ -0,0 +1,102 @@
+// Code generated by 'yaegi extract github.com/chromedp/cdproto/browser'. DO NOT EDIT.
+
+package symbols
+
+import (
+	"github.com/chromedp/cdproto/browser"
+	"go/constant"
+	"go/token"
+	"reflect"
+)
+
+func init() {
+	Symbols["github.com/chromedp/cdproto/browser/browser"] = map[string]reflect.Value{
+		// function, constant and variable definitions
+		"Close":                                  reflect.ValueOf(browser.Close),
+		"CommandClose":                           reflect.ValueOf(constant.MakeFromLiteral("\"Browser.close\"", token.STRING, 0)),
+		"CommandCrash":                           reflect.ValueOf(constant.MakeFromLiteral("\"Browser.crash\"", token.STRING, 0)),
+		"CommandCrashGpuProcess":                 reflect.ValueOf(constant.MakeFromLiteral("\"Browser.crashGpuProcess\"", token.STRING, 0)),
+		"CommandGetBrowserCommandLine":           reflect.ValueOf(constant.MakeFromLiteral("\"Browser.getBrowserCommandLine\"", token.STRING, 0)),
+		"CommandGetHistogram":                    reflect.ValueOf(constant.MakeFromLiteral("\"Browser.getHistogram\"", token.STRING, 0)),
+		"CommandGetHistograms":                   reflect.ValueOf(constant.MakeFromLiteral("\"Browser.getHistograms\"", token.STRING, 0)),
+		"CommandGetVersion":                      reflect.ValueOf(constant.MakeFromLiteral("\"Browser.getVersion\"", token.STRING, 0)),
+		"CommandGetWindowBounds":                 reflect.ValueOf(constant.MakeFromLiteral("\"Browser.getWindowBounds\"", token.STRING, 0)),
+		"CommandGetWindowForTarget":              reflect.ValueOf(constant.MakeFromLiteral("\"Browser.getWindowForTarget\"", token.STRING, 0)),
+		"CommandGrantPermissions":                reflect.ValueOf(constant.MakeFromLiteral("\"Browser.grantPermissions\"", token.STRING, 0)),
+		"CommandResetPermissions":                reflect.ValueOf(constant.MakeFromLiteral("\"Browser.resetPermissions\"", token.STRING, 0)),
+		"CommandSetDockTile":                     reflect.ValueOf(constant.MakeFromLiteral("\"Browser.setDockTile\"", token.STRING, 0)),
+		"CommandSetPermission":                   reflect.ValueOf(constant.MakeFromLiteral("\"Browser.setPermission\"", token.STRING, 0)),
+		"CommandSetWindowBounds":                 reflect.ValueOf(constant.MakeFromLiteral("\"Browser.setWindowBounds\"", token.STRING, 0)),
+		"Crash":                                  reflect.ValueOf(browser.Crash),
+		"CrashGpuProcess":                        reflect.ValueOf(browser.CrashGpuProcess),
+		"GetBrowserCommandLine":                  reflect.ValueOf(browser.GetBrowserCommandLine),
+		"GetHistogram":                           reflect.ValueOf(browser.GetHistogram),
+		"GetHistograms":                          reflect.ValueOf(browser.GetHistograms),
+		"GetVersion":                             reflect.ValueOf(browser.GetVersion),
+		"GetWindowBounds":                        reflect.ValueOf(browser.GetWindowBounds),
+		"GetWindowForTarget":                     reflect.ValueOf(browser.GetWindowForTarget),
+		"GrantPermissions":                       reflect.ValueOf(browser.GrantPermissions),
+		"PermissionSettingDenied":                reflect.ValueOf(browser.PermissionSettingDenied),
+		"PermissionSettingGranted":               reflect.ValueOf(browser.PermissionSettingGranted),
+		"PermissionSettingPrompt":                reflect.ValueOf(browser.PermissionSettingPrompt),
+		"PermissionTypeAccessibilityEvents":      reflect.ValueOf(browser.PermissionTypeAccessibilityEvents),
+		"PermissionTypeAudioCapture":             reflect.ValueOf(browser.PermissionTypeAudioCapture),
+		"PermissionTypeBackgroundFetch":          reflect.ValueOf(browser.PermissionTypeBackgroundFetch),
+		"PermissionTypeBackgroundSync":           reflect.ValueOf(browser.PermissionTypeBackgroundSync),
+		"PermissionTypeClipboardRead":            reflect.ValueOf(browser.PermissionTypeClipboardRead),
+		"PermissionTypeClipboardWrite":           reflect.ValueOf(browser.PermissionTypeClipboardWrite),
+		"PermissionTypeDurableStorage":           reflect.ValueOf(browser.PermissionTypeDurableStorage),
+		"PermissionTypeFlash":                    reflect.ValueOf(browser.PermissionTypeFlash),
+		"PermissionTypeGeolocation":              reflect.ValueOf(browser.PermissionTypeGeolocation),
+		"PermissionTypeIdleDetection":            reflect.ValueOf(browser.PermissionTypeIdleDetection),
+		"PermissionTypeMidi":                     reflect.ValueOf(browser.PermissionTypeMidi),
+		"PermissionTypeMidiSysex":                reflect.ValueOf(browser.PermissionTypeMidiSysex),
+		"PermissionTypeNfc":                      reflect.ValueOf(browser.PermissionTypeNfc),
+		"PermissionTypeNotifications":            reflect.ValueOf(browser.PermissionTypeNotifications),
+		"PermissionTypePaymentHandler":           reflect.ValueOf(browser.PermissionTypePaymentHandler),
+		"PermissionTypePeriodicBackgroundSync":   reflect.ValueOf(browser.PermissionTypePeriodicBackgroundSync),
+		"PermissionTypeProtectedMediaIdentifier": reflect.ValueOf(browser.PermissionTypeProtectedMediaIdentifier),
+		"PermissionTypeSensors":                  reflect.ValueOf(browser.PermissionTypeSensors),
+		"PermissionTypeVideoCapture":             reflect.ValueOf(browser.PermissionTypeVideoCapture),
+		"PermissionTypeWakeLockScreen":           reflect.ValueOf(browser.PermissionTypeWakeLockScreen),
+		"PermissionTypeWakeLockSystem":           reflect.ValueOf(browser.PermissionTypeWakeLockSystem),
+		"ResetPermissions":                       reflect.ValueOf(browser.ResetPermissions),
+		"SetDockTile":                            reflect.ValueOf(browser.SetDockTile),
+		"SetPermission":                          reflect.ValueOf(browser.SetPermission),
+		"SetWindowBounds":                        reflect.ValueOf(browser.SetWindowBounds),
+		"WindowStateFullscreen":                  reflect.ValueOf(browser.WindowStateFullscreen),
+		"WindowStateMaximized":                   reflect.ValueOf(browser.WindowStateMaximized),
+		"WindowStateMinimized":                   reflect.ValueOf(browser.WindowStateMinimized),
+		"WindowStateNormal":                      reflect.ValueOf(browser.WindowStateNormal),
+
+		// type definitions
+		"Bounds":                       reflect.ValueOf((*browser.Bounds)(nil)),
+		"Bucket":                       reflect.ValueOf((*browser.Bucket)(nil)),
+		"CloseParams":                  reflect.ValueOf((*browser.CloseParams)(nil)),
+		"CrashGpuProcessParams":        reflect.ValueOf((*browser.CrashGpuProcessParams)(nil)),
+		"CrashParams":                  reflect.ValueOf((*browser.CrashParams)(nil)),
+		"GetBrowserCommandLineParams":  reflect.ValueOf((*browser.GetBrowserCommandLineParams)(nil)),
+		"GetBrowserCommandLineReturns": reflect.ValueOf((*browser.GetBrowserCommandLineReturns)(nil)),
+		"GetHistogramParams":           reflect.ValueOf((*browser.GetHistogramParams)(nil)),
+		"GetHistogramReturns":          reflect.ValueOf((*browser.GetHistogramReturns)(nil)),
+		"GetHistogramsParams":          reflect.ValueOf((*browser.GetHistogramsParams)(nil)),
+		"GetHistogramsReturns":         reflect.ValueOf((*browser.GetHistogramsReturns)(nil)),
+		"GetVersionParams":             reflect.ValueOf((*browser.GetVersionParams)(nil)),
+		"GetVersionReturns":            reflect.ValueOf((*browser.GetVersionReturns)(nil)),
+		"GetWindowBoundsParams":        reflect.ValueOf((*browser.GetWindowBoundsParams)(nil)),
+		"GetWindowBoundsReturns":       reflect.ValueOf((*browser.GetWindowBoundsReturns)(nil)),
+		"GetWindowForTargetParams":     reflect.ValueOf((*browser.GetWindowForTargetParams)(nil)),
+		"GetWindowForTargetReturns":    reflect.ValueOf((*browser.GetWindowForTargetReturns)(nil)),
+		"GrantPermissionsParams":       reflect.ValueOf((*browser.GrantPermissionsParams)(nil)),
+		"Histogram":                    reflect.ValueOf((*browser.Histogram)(nil)),
+		"PermissionDescriptor":         reflect.ValueOf((*browser.PermissionDescriptor)(nil)),
+		"PermissionSetting":            reflect.ValueOf((*browser.PermissionSetting)(nil)),
+		"PermissionType":               reflect.ValueOf((*browser.PermissionType)(nil)),
+		"ResetPermissionsParams":       reflect.ValueOf((*browser.ResetPermissionsParams)(nil)),
+		"SetDockTileParams":            reflect.ValueOf((*browser.SetDockTileParams)(nil)),
+		"SetPermissionParams":          reflect.ValueOf((*browser.SetPermissionParams)(nil)),
+		"SetWindowBoundsParams":        reflect.ValueOf((*browser.SetWindowBoundsParams)(nil)),
+		"WindowID":                     reflect.ValueOf((*browser.WindowID)(nil)),
+		"WindowState":                  reflect.ValueOf((*browser.WindowState)(nil)),
+	}
+}
@@ -0,0 +1,96 @@
+// Code generated by 'yaegi extract github.com/chromedp/cdproto/cdp'. DO NOT EDIT.
+
+package symbols
+
+import (
+	"context"
+	"github.com/chromedp/cdproto/cdp"
+	"github.com/mailru/easyjson"
+	"reflect"
+)
+
+func init() {
+	Symbols["github.com/chromedp/cdproto/cdp/cdp"] = map[string]reflect.Value{
+		// function, constant and variable definitions
+		"EmptyFrameID":                  reflect.ValueOf(cdp.EmptyFrameID),
+		"EmptyNodeID":                   reflect.ValueOf(cdp.EmptyNodeID),
+		"ErrInvalidContext":             reflect.ValueOf(cdp.ErrInvalidContext),
+		"ErrMsgMissingParamsOrResult":   reflect.ValueOf(cdp.ErrMsgMissingParamsOrResult),
+		"Execute":                       reflect.ValueOf(cdp.Execute),
+		"ExecutorFromContext":           reflect.ValueOf(cdp.ExecutorFromContext),
+		"FrameAttached":                 reflect.ValueOf(cdp.FrameAttached),
+		"FrameDOMContentEventFired":     reflect.ValueOf(cdp.FrameDOMContentEventFired),
+		"FrameLoadEventFired":           reflect.ValueOf(cdp.FrameLoadEventFired),
+		"FrameLoading":                  reflect.ValueOf(cdp.FrameLoading),
+		"FrameNavigated":                reflect.ValueOf(cdp.FrameNavigated),
+		"FrameScheduledNavigation":      reflect.ValueOf(cdp.FrameScheduledNavigation),
+		"MonotonicTimeEpoch":            reflect.ValueOf(&cdp.MonotonicTimeEpoch).Elem(),
+		"NodeHighlighted":               reflect.ValueOf(cdp.NodeHighlighted),
+		"NodeReady":                     reflect.ValueOf(cdp.NodeReady),
+		"NodeTypeAttribute":             reflect.ValueOf(cdp.NodeTypeAttribute),
+		"NodeTypeCDATA":                 reflect.ValueOf(cdp.NodeTypeCDATA),
+		"NodeTypeComment":               reflect.ValueOf(cdp.NodeTypeComment),
+		"NodeTypeDocument":              reflect.ValueOf(cdp.NodeTypeDocument),
+		"NodeTypeDocumentFragment":      reflect.ValueOf(cdp.NodeTypeDocumentFragment),
+		"NodeTypeDocumentType":          reflect.ValueOf(cdp.NodeTypeDocumentType),
+		"NodeTypeElement":               reflect.ValueOf(cdp.NodeTypeElement),
+		"NodeTypeEntity":                reflect.ValueOf(cdp.NodeTypeEntity),
+		"NodeTypeEntityReference":       reflect.ValueOf(cdp.NodeTypeEntityReference),
+		"NodeTypeNotation":              reflect.ValueOf(cdp.NodeTypeNotation),
+		"NodeTypeProcessingInstruction": reflect.ValueOf(cdp.NodeTypeProcessingInstruction),
+		"NodeTypeText":                  reflect.ValueOf(cdp.NodeTypeText),
+		"NodeVisible":                   reflect.ValueOf(cdp.NodeVisible),
+		"PseudoTypeAfter":               reflect.ValueOf(cdp.PseudoTypeAfter),
+		"PseudoTypeBackdrop":            reflect.ValueOf(cdp.PseudoTypeBackdrop),
+		"PseudoTypeBefore":              reflect.ValueOf(cdp.PseudoTypeBefore),
+		"PseudoTypeFirstLetter":         reflect.ValueOf(cdp.PseudoTypeFirstLetter),
+		"PseudoTypeFirstLine":           reflect.ValueOf(cdp.PseudoTypeFirstLine),
+		"PseudoTypeFirstLineInherited":  reflect.ValueOf(cdp.PseudoTypeFirstLineInherited),
+		"PseudoTypeInputListButton":     reflect.ValueOf(cdp.PseudoTypeInputListButton),
+		"PseudoTypeResizer":             reflect.ValueOf(cdp.PseudoTypeResizer),
+		"PseudoTypeScrollbar":           reflect.ValueOf(cdp.PseudoTypeScrollbar),
+		"PseudoTypeScrollbarButton":     reflect.ValueOf(cdp.PseudoTypeScrollbarButton),
+		"PseudoTypeScrollbarCorner":     reflect.ValueOf(cdp.PseudoTypeScrollbarCorner),
+		"PseudoTypeScrollbarThumb":      reflect.ValueOf(cdp.PseudoTypeScrollbarThumb),
+		"PseudoTypeScrollbarTrack":      reflect.ValueOf(cdp.PseudoTypeScrollbarTrack),
+		"PseudoTypeScrollbarTrackPiece": reflect.ValueOf(cdp.PseudoTypeScrollbarTrackPiece),
+		"PseudoTypeSelection":           reflect.ValueOf(cdp.PseudoTypeSelection),
+		"ShadowRootTypeClosed":          reflect.ValueOf(cdp.ShadowRootTypeClosed),
+		"ShadowRootTypeOpen":            reflect.ValueOf(cdp.ShadowRootTypeOpen),
+		"ShadowRootTypeUserAgent":       reflect.ValueOf(cdp.ShadowRootTypeUserAgent),
+		"WithExecutor":                  reflect.ValueOf(cdp.WithExecutor),
+
+		// type definitions
+		"BackendNode":              reflect.ValueOf((*cdp.BackendNode)(nil)),
+		"BackendNodeID":            reflect.ValueOf((*cdp.BackendNodeID)(nil)),
+		"ErrUnknownCommandOrEvent": reflect.ValueOf((*cdp.ErrUnknownCommandOrEvent)(nil)),
+		"Error":                    reflect.ValueOf((*cdp.Error)(nil)),
+		"Executor":                 reflect.ValueOf((*cdp.Executor)(nil)),
+		"Frame":                    reflect.ValueOf((*cdp.Frame)(nil)),
+		"FrameID":                  reflect.ValueOf((*cdp.FrameID)(nil)),
+		"FrameState":               reflect.ValueOf((*cdp.FrameState)(nil)),
+		"LoaderID":                 reflect.ValueOf((*cdp.LoaderID)(nil)),
+		"MonotonicTime":            reflect.ValueOf((*cdp.MonotonicTime)(nil)),
+		"Node":                     reflect.ValueOf((*cdp.Node)(nil)),
+		"NodeID":                   reflect.ValueOf((*cdp.NodeID)(nil)),
+		"NodeState":                reflect.ValueOf((*cdp.NodeState)(nil)),
+		"NodeType":                 reflect.ValueOf((*cdp.NodeType)(nil)),
+		"PseudoType":               reflect.ValueOf((*cdp.PseudoType)(nil)),
+		"RGBA":                     reflect.ValueOf((*cdp.RGBA)(nil)),
+		"ShadowRootType":           reflect.ValueOf((*cdp.ShadowRootType)(nil)),
+		"TimeSinceEpoch":           reflect.ValueOf((*cdp.TimeSinceEpoch)(nil)),
+
+		// interface wrapper definitions
+		"_Executor": reflect.ValueOf((*_github_com_chromedp_cdproto_cdp_Executor)(nil)),
+	}
+}
+
+// _github_com_chromedp_cdproto_cdp_Executor is an interface wrapper for Executor type
+type _github_com_chromedp_cdproto_cdp_Executor struct {
+	IValue   interface{}
+	WExecute func(a0 context.Context, a1 string, a2 easyjson.Marshaler, a3 easyjson.Unmarshaler) error
+}
+
+func (W _github_com_chromedp_cdproto_cdp_Executor) Execute(a0 context.Context, a1 string, a2 easyjson.Marshaler, a3 easyjson.Unmarshaler) error {
+	return W.WExecute(a0, a1, a2, a3)
+}
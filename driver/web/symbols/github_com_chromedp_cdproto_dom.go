@@ -0,0 +1,183 @@
+// Code generated by 'yaegi extract github.com/chromedp/cdproto/dom'. DO NOT EDIT.
+
+package symbols
+
+import (
+	"github.com/chromedp/cdproto/dom"
+	"go/constant"
+	"go/token"
+	"reflect"
+)
+
+func init() {
+	Symbols["github.com/chromedp/cdproto/dom/dom"] = map[string]reflect.Value{
+		// function, constant and variable definitions
+		"CollectClassNamesFromSubtree":           reflect.ValueOf(dom.CollectClassNamesFromSubtree),
+		"CommandCollectClassNamesFromSubtree":    reflect.ValueOf(constant.MakeFromLiteral("\"DOM.collectClassNamesFromSubtree\"", token.STRING, 0)),
+		"CommandCopyTo":                          reflect.ValueOf(constant.MakeFromLiteral("\"DOM.copyTo\"", token.STRING, 0)),
+		"CommandDescribeNode":                    reflect.ValueOf(constant.MakeFromLiteral("\"DOM.describeNode\"", token.STRING, 0)),
+		"CommandDisable":                         reflect.ValueOf(constant.MakeFromLiteral("\"DOM.disable\"", token.STRING, 0)),
+		"CommandDiscardSearchResults":            reflect.ValueOf(constant.MakeFromLiteral("\"DOM.discardSearchResults\"", token.STRING, 0)),
+		"CommandEnable":                          reflect.ValueOf(constant.MakeFromLiteral("\"DOM.enable\"", token.STRING, 0)),
+		"CommandFocus":                           reflect.ValueOf(constant.MakeFromLiteral("\"DOM.focus\"", token.STRING, 0)),
+		"CommandGetAttributes":                   reflect.ValueOf(constant.MakeFromLiteral("\"DOM.getAttributes\"", token.STRING, 0)),
+		"CommandGetBoxModel":                     reflect.ValueOf(constant.MakeFromLiteral("\"DOM.getBoxModel\"", token.STRING, 0)),
+		"CommandGetContentQuads":                 reflect.ValueOf(constant.MakeFromLiteral("\"DOM.getContentQuads\"", token.STRING, 0)),
+		"CommandGetDocument":                     reflect.ValueOf(constant.MakeFromLiteral("\"DOM.getDocument\"", token.STRING, 0)),
+		"CommandGetFileInfo":                     reflect.ValueOf(constant.MakeFromLiteral("\"DOM.getFileInfo\"", token.STRING, 0)),
+		"CommandGetFlattenedDocument":            reflect.ValueOf(constant.MakeFromLiteral("\"DOM.getFlattenedDocument\"", token.STRING, 0)),
+		"CommandGetFrameOwner":                   reflect.ValueOf(constant.MakeFromLiteral("\"DOM.getFrameOwner\"", token.STRING, 0)),
+		"CommandGetNodeForLocation":              reflect.ValueOf(constant.MakeFromLiteral("\"DOM.getNodeForLocation\"", token.STRING, 0)),
+		"CommandGetNodeStackTraces":              reflect.ValueOf(constant.MakeFromLiteral("\"DOM.getNodeStackTraces\"", token.STRING, 0)),
+		"CommandGetOuterHTML":                    reflect.ValueOf(constant.MakeFromLiteral("\"DOM.getOuterHTML\"", token.STRING, 0)),
+		"CommandGetRelayoutBoundary":             reflect.ValueOf(constant.MakeFromLiteral("\"DOM.getRelayoutBoundary\"", token.STRING, 0)),
+		"CommandGetSearchResults":                reflect.ValueOf(constant.MakeFromLiteral("\"DOM.getSearchResults\"", token.STRING, 0)),
+		"CommandMarkUndoableState":               reflect.ValueOf(constant.MakeFromLiteral("\"DOM.markUndoableState\"", token.STRING, 0)),
+		"CommandMoveTo":                          reflect.ValueOf(constant.MakeFromLiteral("\"DOM.moveTo\"", token.STRING, 0)),
+		"CommandPerformSearch":                   reflect.ValueOf(constant.MakeFromLiteral("\"DOM.performSearch\"", token.STRING, 0)),
+		"CommandPushNodeByPathToFrontend":        reflect.ValueOf(constant.MakeFromLiteral("\"DOM.pushNodeByPathToFrontend\"", token.STRING, 0)),
+		"CommandPushNodesByBackendIdsToFrontend": reflect.ValueOf(constant.MakeFromLiteral("\"DOM.pushNodesByBackendIdsToFrontend\"", token.STRING, 0)),
+		"CommandQuerySelector":                   reflect.ValueOf(constant.MakeFromLiteral("\"DOM.querySelector\"", token.STRING, 0)),
+		"CommandQuerySelectorAll":                reflect.ValueOf(constant.MakeFromLiteral("\"DOM.querySelectorAll\"", token.STRING, 0)),
+		"CommandRedo":                            reflect.ValueOf(constant.MakeFromLiteral("\"DOM.redo\"", token.STRING, 0)),
+		"CommandRemoveAttribute":                 reflect.ValueOf(constant.MakeFromLiteral("\"DOM.removeAttribute\"", token.STRING, 0)),
+		"CommandRemoveNode":                      reflect.ValueOf(constant.MakeFromLiteral("\"DOM.removeNode\"", token.STRING, 0)),
+		"CommandRequestChildNodes":               reflect.ValueOf(constant.MakeFromLiteral("\"DOM.requestChildNodes\"", token.STRING, 0)),
+		"CommandRequestNode":                     reflect.ValueOf(constant.MakeFromLiteral("\"DOM.requestNode\"", token.STRING, 0)),
+		"CommandResolveNode":                     reflect.ValueOf(constant.MakeFromLiteral("\"DOM.resolveNode\"", token.STRING, 0)),
+		"CommandSetAttributeValue":               reflect.ValueOf(constant.MakeFromLiteral("\"DOM.setAttributeValue\"", token.STRING, 0)),
+		"CommandSetAttributesAsText":             reflect.ValueOf(constant.MakeFromLiteral("\"DOM.setAttributesAsText\"", token.STRING, 0)),
+		"CommandSetFileInputFiles":               reflect.ValueOf(constant.MakeFromLiteral("\"DOM.setFileInputFiles\"", token.STRING, 0)),
+		"CommandSetInspectedNode":                reflect.ValueOf(constant.MakeFromLiteral("\"DOM.setInspectedNode\"", token.STRING, 0)),
+		"CommandSetNodeName":                     reflect.ValueOf(constant.MakeFromLiteral("\"DOM.setNodeName\"", token.STRING, 0)),
+		"CommandSetNodeStackTracesEnabled":       reflect.ValueOf(constant.MakeFromLiteral("\"DOM.setNodeStackTracesEnabled\"", token.STRING, 0)),
+		"CommandSetNodeValue":                    reflect.ValueOf(constant.MakeFromLiteral("\"DOM.setNodeValue\"", token.STRING, 0)),
+		"CommandSetOuterHTML":                    reflect.ValueOf(constant.MakeFromLiteral("\"DOM.setOuterHTML\"", token.STRING, 0)),
+		"CommandUndo":                            reflect.ValueOf(constant.MakeFromLiteral("\"DOM.undo\"", token.STRING, 0)),
+		"CopyTo":                                 reflect.ValueOf(dom.CopyTo),
+		"DescribeNode":                           reflect.ValueOf(dom.DescribeNode),
+		"Disable":                                reflect.ValueOf(dom.Disable),
+		"DiscardSearchResults":                   reflect.ValueOf(dom.DiscardSearchResults),
+		"Enable":                                 reflect.ValueOf(dom.Enable),
+		"Focus":                                  reflect.ValueOf(dom.Focus),
+		"GetAttributes":                          reflect.ValueOf(dom.GetAttributes),
+		"GetBoxModel":                            reflect.ValueOf(dom.GetBoxModel),
+		"GetContentQuads":                        reflect.ValueOf(dom.GetContentQuads),
+		"GetDocument":                            reflect.ValueOf(dom.GetDocument),
+		"GetFileInfo":                            reflect.ValueOf(dom.GetFileInfo),
+		"GetFlattenedDocument":                   reflect.ValueOf(dom.GetFlattenedDocument),
+		"GetFrameOwner":                          reflect.ValueOf(dom.GetFrameOwner),
+		"GetNodeForLocation":                     reflect.ValueOf(dom.GetNodeForLocation),
+		"GetNodeStackTraces":                     reflect.ValueOf(dom.GetNodeStackTraces),
+		"GetOuterHTML":                           reflect.ValueOf(dom.GetOuterHTML),
+		"GetRelayoutBoundary":                    reflect.ValueOf(dom.GetRelayoutBoundary),
+		"GetSearchResults":                       reflect.ValueOf(dom.GetSearchResults),
+		"MarkUndoableState":                      reflect.ValueOf(dom.MarkUndoableState),
+		"MoveTo":                                 reflect.ValueOf(dom.MoveTo),
+		"PerformSearch":                          reflect.ValueOf(dom.PerformSearch),
+		"PushNodeByPathToFrontend":               reflect.ValueOf(dom.PushNodeByPathToFrontend),
+		"PushNodesByBackendIdsToFrontend":        reflect.ValueOf(dom.PushNodesByBackendIdsToFrontend),
+		"QuerySelector":                          reflect.ValueOf(dom.QuerySelector),
+		"QuerySelectorAll":                       reflect.ValueOf(dom.QuerySelectorAll),
+		"Redo":                                   reflect.ValueOf(dom.Redo),
+		"RemoveAttribute":                        reflect.ValueOf(dom.RemoveAttribute),
+		"RemoveNode":                             reflect.ValueOf(dom.RemoveNode),
+		"RequestChildNodes":                      reflect.ValueOf(dom.RequestChildNodes),
+		"RequestNode":                            reflect.ValueOf(dom.RequestNode),
+		"ResolveNode":                            reflect.ValueOf(dom.ResolveNode),
+		"SetAttributeValue":                      reflect.ValueOf(dom.SetAttributeValue),
+		"SetAttributesAsText":                    reflect.ValueOf(dom.SetAttributesAsText),
+		"SetFileInputFiles":                      reflect.ValueOf(dom.SetFileInputFiles),
+		"SetInspectedNode":                       reflect.ValueOf(dom.SetInspectedNode),
+		"SetNodeName":                            reflect.ValueOf(dom.SetNodeName),
+		"SetNodeStackTracesEnabled":              reflect.ValueOf(dom.SetNodeStackTracesEnabled),
+		"SetNodeValue":                           reflect.ValueOf(dom.SetNodeValue),
+		"SetOuterHTML":                           reflect.ValueOf(dom.SetOuterHTML),
+		"Undo":                                   reflect.ValueOf(dom.Undo),
+
+		// type definitions
+		"BoxModel":                               reflect.ValueOf((*dom.BoxModel)(nil)),
+		"CollectClassNamesFromSubtreeParams":     reflect.ValueOf((*dom.CollectClassNamesFromSubtreeParams)(nil)),
+		"CollectClassNamesFromSubtreeReturns":    reflect.ValueOf((*dom.CollectClassNamesFromSubtreeReturns)(nil)),
+		"CopyToParams":                           reflect.ValueOf((*dom.CopyToParams)(nil)),
+		"CopyToReturns":                          reflect.ValueOf((*dom.CopyToReturns)(nil)),
+		"DescribeNodeParams":                     reflect.ValueOf((*dom.DescribeNodeParams)(nil)),
+		"DescribeNodeReturns":                    reflect.ValueOf((*dom.DescribeNodeReturns)(nil)),
+		"DisableParams":                          reflect.ValueOf((*dom.DisableParams)(nil)),
+		"DiscardSearchResultsParams":             reflect.ValueOf((*dom.DiscardSearchResultsParams)(nil)),
+		"EnableParams":                           reflect.ValueOf((*dom.EnableParams)(nil)),
+		"EventAttributeModified":                 reflect.ValueOf((*dom.EventAttributeModified)(nil)),
+		"EventAttributeRemoved":                  reflect.ValueOf((*dom.EventAttributeRemoved)(nil)),
+		"EventCharacterDataModified":             reflect.ValueOf((*dom.EventCharacterDataModified)(nil)),
+		"EventChildNodeCountUpdated":             reflect.ValueOf((*dom.EventChildNodeCountUpdated)(nil)),
+		"EventChildNodeInserted":                 reflect.ValueOf((*dom.EventChildNodeInserted)(nil)),
+		"EventChildNodeRemoved":                  reflect.ValueOf((*dom.EventChildNodeRemoved)(nil)),
+		"EventDistributedNodesUpdated":           reflect.ValueOf((*dom.EventDistributedNodesUpdated)(nil)),
+		"EventDocumentUpdated":                   reflect.ValueOf((*dom.EventDocumentUpdated)(nil)),
+		"EventInlineStyleInvalidated":            reflect.ValueOf((*dom.EventInlineStyleInvalidated)(nil)),
+		"EventPseudoElementAdded":                reflect.ValueOf((*dom.EventPseudoElementAdded)(nil)),
+		"EventPseudoElementRemoved":              reflect.ValueOf((*dom.EventPseudoElementRemoved)(nil)),
+		"EventSetChildNodes":                     reflect.ValueOf((*dom.EventSetChildNodes)(nil)),
+		"EventShadowRootPopped":                  reflect.ValueOf((*dom.EventShadowRootPopped)(nil)),
+		"EventShadowRootPushed":                  reflect.ValueOf((*dom.EventShadowRootPushed)(nil)),
+		"FocusParams":                            reflect.ValueOf((*dom.FocusParams)(nil)),
+		"GetAttributesParams":                    reflect.ValueOf((*dom.GetAttributesParams)(nil)),
+		"GetAttributesReturns":                   reflect.ValueOf((*dom.GetAttributesReturns)(nil)),
+		"GetBoxModelParams":                      reflect.ValueOf((*dom.GetBoxModelParams)(nil)),
+		"GetBoxModelReturns":                     reflect.ValueOf((*dom.GetBoxModelReturns)(nil)),
+		"GetContentQuadsParams":                  reflect.ValueOf((*dom.GetContentQuadsParams)(nil)),
+		"GetContentQuadsReturns":                 reflect.ValueOf((*dom.GetContentQuadsReturns)(nil)),
+		"GetDocumentParams":                      reflect.ValueOf((*dom.GetDocumentParams)(nil)),
+		"GetDocumentReturns":                     reflect.ValueOf((*dom.GetDocumentReturns)(nil)),
+		"GetFileInfoParams":                      reflect.ValueOf((*dom.GetFileInfoParams)(nil)),
+		"GetFileInfoReturns":                     reflect.ValueOf((*dom.GetFileInfoReturns)(nil)),
+		"GetFlattenedDocumentParams":             reflect.ValueOf((*dom.GetFlattenedDocumentParams)(nil)),
+		"GetFlattenedDocumentReturns":            reflect.ValueOf((*dom.GetFlattenedDocumentReturns)(nil)),
+		"GetFrameOwnerParams":                    reflect.ValueOf((*dom.GetFrameOwnerParams)(nil)),
+		"GetFrameOwnerReturns":                   reflect.ValueOf((*dom.GetFrameOwnerReturns)(nil)),
+		"GetNodeForLocationParams":               reflect.ValueOf((*dom.GetNodeForLocationParams)(nil)),
+		"GetNodeForLocationReturns":              reflect.ValueOf((*dom.GetNodeForLocationReturns)(nil)),
+		"GetNodeStackTracesParams":               reflect.ValueOf((*dom.GetNodeStackTracesParams)(nil)),
+		"GetNodeStackTracesReturns":              reflect.ValueOf((*dom.GetNodeStackTracesReturns)(nil)),
+		"GetOuterHTMLParams":                     reflect.ValueOf((*dom.GetOuterHTMLParams)(nil)),
+		"GetOuterHTMLReturns":                    reflect.ValueOf((*dom.GetOuterHTMLReturns)(nil)),
+		"GetRelayoutBoundaryParams":              reflect.ValueOf((*dom.GetRelayoutBoundaryParams)(nil)),
+		"GetRelayoutBoundaryReturns":             reflect.ValueOf((*dom.GetRelayoutBoundaryReturns)(nil)),
+		"GetSearchResultsParams":                 reflect.ValueOf((*dom.GetSearchResultsParams)(nil)),
+		"GetSearchResultsReturns":                reflect.ValueOf((*dom.GetSearchResultsReturns)(nil)),
+		"MarkUndoableStateParams":                reflect.ValueOf((*dom.MarkUndoableStateParams)(nil)),
+		"MoveToParams":                           reflect.ValueOf((*dom.MoveToParams)(nil)),
+		"MoveToReturns":                          reflect.ValueOf((*dom.MoveToReturns)(nil)),
+		"PerformSearchParams":                    reflect.ValueOf((*dom.PerformSearchParams)(nil)),
+		"PerformSearchReturns":                   reflect.ValueOf((*dom.PerformSearchReturns)(nil)),
+		"PushNodeByPathToFrontendParams":         reflect.ValueOf((*dom.PushNodeByPathToFrontendParams)(nil)),
+		"PushNodeByPathToFrontendReturns":        reflect.ValueOf((*dom.PushNodeByPathToFrontendReturns)(nil)),
+		"PushNodesByBackendIdsToFrontendParams":  reflect.ValueOf((*dom.PushNodesByBackendIdsToFrontendParams)(nil)),
+		"PushNodesByBackendIdsToFrontendReturns": reflect.ValueOf((*dom.PushNodesByBackendIdsToFrontendReturns)(nil)),
+		"Quad":                                   reflect.ValueOf((*dom.Quad)(nil)),
+		"QuerySelectorAllParams":                 reflect.ValueOf((*dom.QuerySelectorAllParams)(nil)),
+		"QuerySelectorAllReturns":                reflect.ValueOf((*dom.QuerySelectorAllReturns)(nil)),
+		"QuerySelectorParams":                    reflect.ValueOf((*dom.QuerySelectorParams)(nil)),
+		"QuerySelectorReturns":                   reflect.ValueOf((*dom.QuerySelectorReturns)(nil)),
+		"Rect":                                   reflect.ValueOf((*dom.Rect)(nil)),
+		"RedoParams":                             reflect.ValueOf((*dom.RedoParams)(nil)),
+		"RemoveAttributeParams":                  reflect.ValueOf((*dom.RemoveAttributeParams)(nil)),
+		"RemoveNodeParams":                       reflect.ValueOf((*dom.RemoveNodeParams)(nil)),
+		"RequestChildNodesParams":                reflect.ValueOf((*dom.RequestChildNodesParams)(nil)),
+		"RequestNodeParams":                      reflect.ValueOf((*dom.RequestNodeParams)(nil)),
+		"RequestNodeReturns":                     reflect.ValueOf((*dom.RequestNodeReturns)(nil)),
+		"ResolveNodeParams":                      reflect.ValueOf((*dom.ResolveNodeParams)(nil)),
+		"ResolveNodeReturns":                     reflect.ValueOf((*dom.ResolveNodeReturns)(nil)),
+		"SetAttributeValueParams":                reflect.ValueOf((*dom.SetAttributeValueParams)(nil)),
+		"SetAttributesAsTextParams":              reflect.ValueOf((*dom.SetAttributesAsTextParams)(nil)),
+		"SetFileInputFilesParams":                reflect.ValueOf((*dom.SetFileInputFilesParams)(nil)),
+		"SetInspectedNodeParams":                 reflect.ValueOf((*dom.SetInspectedNodeParams)(nil)),
+		"SetNodeNameParams":                      reflect.ValueOf((*dom.SetNodeNameParams)(nil)),
+		"SetNodeNameReturns":                     reflect.ValueOf((*dom.SetNodeNameReturns)(nil)),
+		"SetNodeStackTracesEnabledParams":        reflect.ValueOf((*dom.SetNodeStackTracesEnabledParams)(nil)),
+		"SetNodeValueParams":                     reflect.ValueOf((*dom.SetNodeValueParams)(nil)),
+		"SetOuterHTMLParams":                     reflect.ValueOf((*dom.SetOuterHTMLParams)(nil)),
+		"ShapeOutsideInfo":                       reflect.ValueOf((*dom.ShapeOutsideInfo)(nil)),
+		"UndoParams":                             reflect.ValueOf((*dom.UndoParams)(nil)),
+	}
+}
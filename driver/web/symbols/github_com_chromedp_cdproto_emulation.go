@@ -0,0 +1,92 @@
+// Code generated by 'yaegi extract github.com/chromedp/cdproto/emulation'. DO NOT EDIT.
+
+package symbols
+
+import (
+	"github.com/chromedp/cdproto/emulation"
+	"go/constant"
+	"go/token"
+	"reflect"
+)
+
+func init() {
+	Symbols["github.com/chromedp/cdproto/emulation/emulation"] = map[string]reflect.Value{
+		// function, constant and variable definitions
+		"CanEmulate":                                     reflect.ValueOf(emulation.CanEmulate),
+		"ClearDeviceMetricsOverride":                     reflect.ValueOf(emulation.ClearDeviceMetricsOverride),
+		"ClearGeolocationOverride":                       reflect.ValueOf(emulation.ClearGeolocationOverride),
+		"CommandCanEmulate":                              reflect.ValueOf(constant.MakeFromLiteral("\"Emulation.canEmulate\"", token.STRING, 0)),
+		"CommandClearDeviceMetricsOverride":              reflect.ValueOf(constant.MakeFromLiteral("\"Emulation.clearDeviceMetricsOverride\"", token.STRING, 0)),
+		"CommandClearGeolocationOverride":                reflect.ValueOf(constant.MakeFromLiteral("\"Emulation.clearGeolocationOverride\"", token.STRING, 0)),
+		"CommandResetPageScaleFactor":                    reflect.ValueOf(constant.MakeFromLiteral("\"Emulation.resetPageScaleFactor\"", token.STRING, 0)),
+		"CommandSetCPUThrottlingRate":                    reflect.ValueOf(constant.MakeFromLiteral("\"Emulation.setCPUThrottlingRate\"", token.STRING, 0)),
+		"CommandSetDefaultBackgroundColorOverride":       reflect.ValueOf(constant.MakeFromLiteral("\"Emulation.setDefaultBackgroundColorOverride\"", token.STRING, 0)),
+		"CommandSetDeviceMetricsOverride":                reflect.ValueOf(constant.MakeFromLiteral("\"Emulation.setDeviceMetricsOverride\"", token.STRING, 0)),
+		"CommandSetDocumentCookieDisabled":               reflect.ValueOf(constant.MakeFromLiteral("\"Emulation.setDocumentCookieDisabled\"", token.STRING, 0)),
+		"CommandSetEmitTouchEventsForMouse":              reflect.ValueOf(constant.MakeFromLiteral("\"Emulation.setEmitTouchEventsForMouse\"", token.STRING, 0)),
+		"CommandSetEmulatedMedia":                        reflect.ValueOf(constant.MakeFromLiteral("\"Emulation.setEmulatedMedia\"", token.STRING, 0)),
+		"CommandSetFocusEmulationEnabled":                reflect.ValueOf(constant.MakeFromLiteral("\"Emulation.setFocusEmulationEnabled\"", token.STRING, 0)),
+		"CommandSetGeolocationOverride":                  reflect.ValueOf(constant.MakeFromLiteral("\"Emulation.setGeolocationOverride\"", token.STRING, 0)),
+		"CommandSetPageScaleFactor":                      reflect.ValueOf(constant.MakeFromLiteral("\"Emulation.setPageScaleFactor\"", token.STRING, 0)),
+		"CommandSetScriptExecutionDisabled":              reflect.ValueOf(constant.MakeFromLiteral("\"Emulation.setScriptExecutionDisabled\"", token.STRING, 0)),
+		"CommandSetScrollbarsHidden":                     reflect.ValueOf(constant.MakeFromLiteral("\"Emulation.setScrollbarsHidden\"", token.STRING, 0)),
+		"CommandSetTimezoneOverride":                     reflect.ValueOf(constant.MakeFromLiteral("\"Emulation.setTimezoneOverride\"", token.STRING, 0)),
+		"CommandSetTouchEmulationEnabled":                reflect.ValueOf(constant.MakeFromLiteral("\"Emulation.setTouchEmulationEnabled\"", token.STRING, 0)),
+		"CommandSetUserAgentOverride":                    reflect.ValueOf(constant.MakeFromLiteral("\"Emulation.setUserAgentOverride\"", token.STRING, 0)),
+		"CommandSetVirtualTimePolicy":                    reflect.ValueOf(constant.MakeFromLiteral("\"Emulation.setVirtualTimePolicy\"", token.STRING, 0)),
+		"OrientationTypeLandscapePrimary":                reflect.ValueOf(emulation.OrientationTypeLandscapePrimary),
+		"OrientationTypeLandscapeSecondary":              reflect.ValueOf(emulation.OrientationTypeLandscapeSecondary),
+		"OrientationTypePortraitPrimary":                 reflect.ValueOf(emulation.OrientationTypePortraitPrimary),
+		"OrientationTypePortraitSecondary":               reflect.ValueOf(emulation.OrientationTypePortraitSecondary),
+		"ResetPageScaleFactor":                           reflect.ValueOf(emulation.ResetPageScaleFactor),
+		"SetCPUThrottlingRate":                           reflect.ValueOf(emulation.SetCPUThrottlingRate),
+		"SetDefaultBackgroundColorOverride":              reflect.ValueOf(emulation.SetDefaultBackgroundColorOverride),
+		"SetDeviceMetricsOverride":                       reflect.ValueOf(emulation.SetDeviceMetricsOverride),
+		"SetDocumentCookieDisabled":                      reflect.ValueOf(emulation.SetDocumentCookieDisabled),
+		"SetEmitTouchEventsForMouse":                     reflect.ValueOf(emulation.SetEmitTouchEventsForMouse),
+		"SetEmitTouchEventsForMouseConfigurationDesktop": reflect.ValueOf(emulation.SetEmitTouchEventsForMouseConfigurationDesktop),
+		"SetEmitTouchEventsForMouseConfigurationMobile":  reflect.ValueOf(emulation.SetEmitTouchEventsForMouseConfigurationMobile),
+		"SetEmulatedMedia":                               reflect.ValueOf(emulation.SetEmulatedMedia),
+		"SetFocusEmulationEnabled":                       reflect.ValueOf(emulation.SetFocusEmulationEnabled),
+		"SetGeolocationOverride":                         reflect.ValueOf(emulation.SetGeolocationOverride),
+		"SetPageScaleFactor":                             reflect.ValueOf(emulation.SetPageScaleFactor),
+		"SetScriptExecutionDisabled":                     reflect.ValueOf(emulation.SetScriptExecutionDisabled),
+		"SetScrollbarsHidden":                            reflect.ValueOf(emulation.SetScrollbarsHidden),
+		"SetTimezoneOverride":                            reflect.ValueOf(emulation.SetTimezoneOverride),
+		"SetTouchEmulationEnabled":                       reflect.ValueOf(emulation.SetTouchEmulationEnabled),
+		"SetUserAgentOverride":                           reflect.ValueOf(emulation.SetUserAgentOverride),
+		"SetVirtualTimePolicy":                           reflect.ValueOf(emulation.SetVirtualTimePolicy),
+		"VirtualTimePolicyAdvance":                       reflect.ValueOf(emulation.VirtualTimePolicyAdvance),
+		"VirtualTimePolicyPause":                         reflect.ValueOf(emulation.VirtualTimePolicyPause),
+		"VirtualTimePolicyPauseIfNetworkFetchesPending":  reflect.ValueOf(emulation.VirtualTimePolicyPauseIfNetworkFetchesPending),
+
+		// type definitions
+		"CanEmulateParams":                        reflect.ValueOf((*emulation.CanEmulateParams)(nil)),
+		"CanEmulateReturns":                       reflect.ValueOf((*emulation.CanEmulateReturns)(nil)),
+		"ClearDeviceMetricsOverrideParams":        reflect.ValueOf((*emulation.ClearDeviceMetricsOverrideParams)(nil)),
+		"ClearGeolocationOverrideParams":          reflect.ValueOf((*emulation.ClearGeolocationOverrideParams)(nil)),
+		"EventVirtualTimeBudgetExpired":           reflect.ValueOf((*emulation.EventVirtualTimeBudgetExpired)(nil)),
+		"MediaFeature":                            reflect.ValueOf((*emulation.MediaFeature)(nil)),
+		"OrientationType":                         reflect.ValueOf((*emulation.OrientationType)(nil)),
+		"ResetPageScaleFactorParams":              reflect.ValueOf((*emulation.ResetPageScaleFactorParams)(nil)),
+		"ScreenOrientation":                       reflect.ValueOf((*emulation.ScreenOrientation)(nil)),
+		"SetCPUThrottlingRateParams":              reflect.ValueOf((*emulation.SetCPUThrottlingRateParams)(nil)),
+		"SetDefaultBackgroundColorOverrideParams": reflect.ValueOf((*emulation.SetDefaultBackgroundColorOverrideParams)(nil)),
+		"SetDeviceMetricsOverrideParams":          reflect.ValueOf((*emulation.SetDeviceMetricsOverrideParams)(nil)),
+		"SetDocumentCookieDisabledParams":         reflect.ValueOf((*emulation.SetDocumentCookieDisabledParams)(nil)),
+		"SetEmitTouchEventsForMouseConfiguration": reflect.ValueOf((*emulation.SetEmitTouchEventsForMouseConfiguration)(nil)),
+		"SetEmitTouchEventsForMouseParams":        reflect.ValueOf((*emulation.SetEmitTouchEventsForMouseParams)(nil)),
+		"SetEmulatedMediaParams":                  reflect.ValueOf((*emulation.SetEmulatedMediaParams)(nil)),
+		"SetFocusEmulationEnabledParams":          reflect.ValueOf((*emulation.SetFocusEmulationEnabledParams)(nil)),
+		"SetGeolocationOverrideParams":            reflect.ValueOf((*emulation.SetGeolocationOverrideParams)(nil)),
+		"SetPageScaleFactorParams":                reflect.ValueOf((*emulation.SetPageScaleFactorParams)(nil)),
+		"SetScriptExecutionDisabledParams":        reflect.ValueOf((*emulation.SetScriptExecutionDisabledParams)(nil)),
+		"SetScrollbarsHiddenParams":               reflect.ValueOf((*emulation.SetScrollbarsHiddenParams)(nil)),
+		"SetTimezoneOverrideParams":               reflect.ValueOf((*emulation.SetTimezoneOverrideParams)(nil)),
+		"SetTouchEmulationEnabledParams":          reflect.ValueOf((*emulation.SetTouchEmulationEnabledParams)(nil)),
+		"SetUserAgentOverrideParams":              reflect.ValueOf((*emulation.SetUserAgentOverrideParams)(nil)),
+		"SetVirtualTimePolicyParams":              reflect.ValueOf((*emulation.SetVirtualTimePolicyParams)(nil)),
+		"SetVirtualTimePolicyReturns":             reflect.ValueOf((*emulation.SetVirtualTimePolicyReturns)(nil)),
+		"VirtualTimePolicy":                       reflect.ValueOf((*emulation.VirtualTimePolicy)(nil)),
+	}
+}
@@ -0,0 +1,61 @@
+// Code generated by 'yaegi extract github.com/chromedp/cdproto/fetch'. DO NOT EDIT.
+
+package symbols
+
+import (
+	"github.com/chromedp/cdproto/fetch"
+	"go/constant"
+	"go/token"
+	"reflect"
+)
+
+func init() {
+	Symbols["github.com/chromedp/cdproto/fetch/fetch"] = map[string]reflect.Value{
+		// function, constant and variable definitions
+		"AuthChallengeResponseResponseCancelAuth":         reflect.ValueOf(fetch.AuthChallengeResponseResponseCancelAuth),
+		"AuthChallengeResponseResponseDefault":            reflect.ValueOf(fetch.AuthChallengeResponseResponseDefault),
+		"AuthChallengeResponseResponseProvideCredentials": reflect.ValueOf(fetch.AuthChallengeResponseResponseProvideCredentials),
+		"AuthChallengeSourceProxy":                        reflect.ValueOf(fetch.AuthChallengeSourceProxy),
+		"AuthChallengeSourceServer":                       reflect.ValueOf(fetch.AuthChallengeSourceServer),
+		"CommandContinueRequest":                          reflect.ValueOf(constant.MakeFromLiteral("\"Fetch.continueRequest\"", token.STRING, 0)),
+		"CommandContinueWithAuth":                         reflect.ValueOf(constant.MakeFromLiteral("\"Fetch.continueWithAuth\"", token.STRING, 0)),
+		"CommandDisable":                                  reflect.ValueOf(constant.MakeFromLiteral("\"Fetch.disable\"", token.STRING, 0)),
+		"CommandEnable":                                   reflect.ValueOf(constant.MakeFromLiteral("\"Fetch.enable\"", token.STRING, 0)),
+		"CommandFailRequest":                              reflect.ValueOf(constant.MakeFromLiteral("\"Fetch.failRequest\"", token.STRING, 0)),
+		"CommandFulfillRequest":                           reflect.ValueOf(constant.MakeFromLiteral("\"Fetch.fulfillRequest\"", token.STRING, 0)),
+		"CommandGetResponseBody":                          reflect.ValueOf(constant.MakeFromLiteral("\"Fetch.getResponseBody\"", token.STRING, 0)),
+		"CommandTakeResponseBodyAsStream":                 reflect.ValueOf(constant.MakeFromLiteral("\"Fetch.takeResponseBodyAsStream\"", token.STRING, 0)),
+		"ContinueRequest":                                 reflect.ValueOf(fetch.ContinueRequest),
+		"ContinueWithAuth":                                reflect.ValueOf(fetch.ContinueWithAuth),
+		"Disable":                                         reflect.ValueOf(fetch.Disable),
+		"Enable":                                          reflect.ValueOf(fetch.Enable),
+		"FailRequest":                                     reflect.ValueOf(fetch.FailRequest),
+		"FulfillRequest":                                  reflect.ValueOf(fetch.FulfillRequest),
+		"GetResponseBody":                                 reflect.ValueOf(fetch.GetResponseBody),
+		"RequestStageRequest":                             reflect.ValueOf(fetch.RequestStageRequest),
+		"RequestStageResponse":                            reflect.ValueOf(fetch.RequestStageResponse),
+		"TakeResponseBodyAsStream":                        reflect.ValueOf(fetch.TakeResponseBodyAsStream),
+
+		// type definitions
+		"AuthChallenge":                   reflect.ValueOf((*fetch.AuthChallenge)(nil)),
+		"AuthChallengeResponse":           reflect.ValueOf((*fetch.AuthChallengeResponse)(nil)),
+		"AuthChallengeResponseResponse":   reflect.ValueOf((*fetch.AuthChallengeResponseResponse)(nil)),
+		"AuthChallengeSource":             reflect.ValueOf((*fetch.AuthChallengeSource)(nil)),
+		"ContinueRequestParams":           reflect.ValueOf((*fetch.ContinueRequestParams)(nil)),
+		"ContinueWithAuthParams":          reflect.ValueOf((*fetch.ContinueWithAuthParams)(nil)),
+		"DisableParams":                   reflect.ValueOf((*fetch.DisableParams)(nil)),
+		"EnableParams":                    reflect.ValueOf((*fetch.EnableParams)(nil)),
+		"EventAuthRequired":               reflect.ValueOf((*fetch.EventAuthRequired)(nil)),
+		"EventRequestPaused":              reflect.ValueOf((*fetch.EventRequestPaused)(nil)),
+		"FailRequestParams":               reflect.ValueOf((*fetch.FailRequestParams)(nil)),
+		"FulfillRequestParams":            reflect.ValueOf((*fetch.FulfillRequestParams)(nil)),
+		"GetResponseBodyParams":           reflect.ValueOf((*fetch.GetResponseBodyParams)(nil)),
+		"GetResponseBodyReturns":          reflect.ValueOf((*fetch.GetResponseBodyReturns)(nil)),
+		"HeaderEntry":                     reflect.ValueOf((*fetch.HeaderEntry)(nil)),
+		"RequestID":                       reflect.ValueOf((*fetch.RequestID)(nil)),
+		"RequestPattern":                  reflect.ValueOf((*fetch.RequestPattern)(nil)),
+		"RequestStage":                    reflect.ValueOf((*fetch.RequestStage)(nil)),
+		"TakeResponseBodyAsStreamParams":  reflect.ValueOf((*fetch.TakeResponseBodyAsStreamParams)(nil)),
+		"TakeResponseBodyAsStreamReturns": reflect.ValueOf((*fetch.TakeResponseBodyAsStreamReturns)(nil)),
+	}
+}
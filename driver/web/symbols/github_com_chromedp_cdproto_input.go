@@ -0,0 +1,83 @@
+// Code generated by 'yaegi extract github.com/chromedp/cdproto/input'. DO NOT EDIT.
+
+package symbols
+
+import (
+	"github.com/chromedp/cdproto/input"
+	"go/constant"
+	"go/token"
+	"reflect"
+)
+
+func init() {
+	Symbols["github.com/chromedp/cdproto/input/input"] = map[string]reflect.Value{
+		// function, constant and variable definitions
+		"ButtonBack":                        reflect.ValueOf(input.ButtonBack),
+		"ButtonForward":                     reflect.ValueOf(input.ButtonForward),
+		"ButtonLeft":                        reflect.ValueOf(input.ButtonLeft),
+		"ButtonMiddle":                      reflect.ValueOf(input.ButtonMiddle),
+		"ButtonNone":                        reflect.ValueOf(input.ButtonNone),
+		"ButtonRight":                       reflect.ValueOf(input.ButtonRight),
+		"CommandDispatchKeyEvent":           reflect.ValueOf(constant.MakeFromLiteral("\"Input.dispatchKeyEvent\"", token.STRING, 0)),
+		"CommandDispatchMouseEvent":         reflect.ValueOf(constant.MakeFromLiteral("\"Input.dispatchMouseEvent\"", token.STRING, 0)),
+		"CommandDispatchTouchEvent":         reflect.ValueOf(constant.MakeFromLiteral("\"Input.dispatchTouchEvent\"", token.STRING, 0)),
+		"CommandEmulateTouchFromMouseEvent": reflect.ValueOf(constant.MakeFromLiteral("\"Input.emulateTouchFromMouseEvent\"", token.STRING, 0)),
+		"CommandInsertText":                 reflect.ValueOf(constant.MakeFromLiteral("\"Input.insertText\"", token.STRING, 0)),
+		"CommandSetIgnoreInputEvents":       reflect.ValueOf(constant.MakeFromLiteral("\"Input.setIgnoreInputEvents\"", token.STRING, 0)),
+		"CommandSynthesizePinchGesture":     reflect.ValueOf(constant.MakeFromLiteral("\"Input.synthesizePinchGesture\"", token.STRING, 0)),
+		"CommandSynthesizeScrollGesture":    reflect.ValueOf(constant.MakeFromLiteral("\"Input.synthesizeScrollGesture\"", token.STRING, 0)),
+		"CommandSynthesizeTapGesture":       reflect.ValueOf(constant.MakeFromLiteral("\"Input.synthesizeTapGesture\"", token.STRING, 0)),
+		"DispatchKeyEvent":                  reflect.ValueOf(input.DispatchKeyEvent),
+		"DispatchMouseEvent":                reflect.ValueOf(input.DispatchMouseEvent),
+		"DispatchTouchEvent":                reflect.ValueOf(input.DispatchTouchEvent),
+		"EmulateTouchFromMouseEvent":        reflect.ValueOf(input.EmulateTouchFromMouseEvent),
+		"GestureDefault":                    reflect.ValueOf(input.GestureDefault),
+		"GestureMouse":                      reflect.ValueOf(input.GestureMouse),
+		"GestureTouch":                      reflect.ValueOf(input.GestureTouch),
+		"InsertText":                        reflect.ValueOf(input.InsertText),
+		"KeyChar":                           reflect.ValueOf(input.KeyChar),
+		"KeyDown":                           reflect.ValueOf(input.KeyDown),
+		"KeyRawDown":                        reflect.ValueOf(input.KeyRawDown),
+		"KeyUp":                             reflect.ValueOf(input.KeyUp),
+		"ModifierAlt":                       reflect.ValueOf(input.ModifierAlt),
+		"ModifierCommand":                   reflect.ValueOf(input.ModifierCommand),
+		"ModifierCtrl":                      reflect.ValueOf(input.ModifierCtrl),
+		"ModifierMeta":                      reflect.ValueOf(input.ModifierMeta),
+		"ModifierNone":                      reflect.ValueOf(input.ModifierNone),
+		"ModifierShift":                     reflect.ValueOf(input.ModifierShift),
+		"Mouse":                             reflect.ValueOf(input.Mouse),
+		"MouseMoved":                        reflect.ValueOf(input.MouseMoved),
+		"MousePressed":                      reflect.ValueOf(input.MousePressed),
+		"MouseReleased":                     reflect.ValueOf(input.MouseReleased),
+		"MouseWheel":                        reflect.ValueOf(input.MouseWheel),
+		"Pen":                               reflect.ValueOf(input.Pen),
+		"SetIgnoreInputEvents":              reflect.ValueOf(input.SetIgnoreInputEvents),
+		"SynthesizePinchGesture":            reflect.ValueOf(input.SynthesizePinchGesture),
+		"SynthesizeScrollGesture":           reflect.ValueOf(input.SynthesizeScrollGesture),
+		"SynthesizeTapGesture":              reflect.ValueOf(input.SynthesizeTapGesture),
+		"TouchCancel":                       reflect.ValueOf(input.TouchCancel),
+		"TouchEnd":                          reflect.ValueOf(input.TouchEnd),
+		"TouchMove":                         reflect.ValueOf(input.TouchMove),
+		"TouchStart":                        reflect.ValueOf(input.TouchStart),
+
+		// type definitions
+		"ButtonType":                       reflect.ValueOf((*input.ButtonType)(nil)),
+		"DispatchKeyEventParams":           reflect.ValueOf((*input.DispatchKeyEventParams)(nil)),
+		"DispatchMouseEventParams":         reflect.ValueOf((*input.DispatchMouseEventParams)(nil)),
+		"DispatchMouseEventPointerType":    reflect.ValueOf((*input.DispatchMouseEventPointerType)(nil)),
+		"DispatchTouchEventParams":         reflect.ValueOf((*input.DispatchTouchEventParams)(nil)),
+		"EmulateTouchFromMouseEventParams": reflect.ValueOf((*input.EmulateTouchFromMouseEventParams)(nil)),
+		"GestureType":                      reflect.ValueOf((*input.GestureType)(nil)),
+		"InsertTextParams":                 reflect.ValueOf((*input.InsertTextParams)(nil)),
+		"KeyType":                          reflect.ValueOf((*input.KeyType)(nil)),
+		"Modifier":                         reflect.ValueOf((*input.Modifier)(nil)),
+		"MouseType":                        reflect.ValueOf((*input.MouseType)(nil)),
+		"SetIgnoreInputEventsParams":       reflect.ValueOf((*input.SetIgnoreInputEventsParams)(nil)),
+		"SynthesizePinchGestureParams":     reflect.ValueOf((*input.SynthesizePinchGestureParams)(nil)),
+		"SynthesizeScrollGestureParams":    reflect.ValueOf((*input.SynthesizeScrollGestureParams)(nil)),
+		"SynthesizeTapGestureParams":       reflect.ValueOf((*input.SynthesizeTapGestureParams)(nil)),
+		"TimeSinceEpoch":                   reflect.ValueOf((*input.TimeSinceEpoch)(nil)),
+		"TouchPoint":                       reflect.ValueOf((*input.TouchPoint)(nil)),
+		"TouchType":                        reflect.ValueOf((*input.TouchType)(nil)),
+	}
+}
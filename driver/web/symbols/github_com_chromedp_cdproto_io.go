@@ -0,0 +1,30 @@
+// Code generated by 'yaegi extract github.com/chromedp/cdproto/io'. DO NOT EDIT.
+
+package symbols
+
+import (
+	"github.com/chromedp/cdproto/io"
+	"go/constant"
+	"go/token"
+	"reflect"
+)
+
+func init() {
+	Symbols["github.com/chromedp/cdproto/io/io"] = map[string]reflect.Value{
+		// function, constant and variable definitions
+		"Close":              reflect.ValueOf(io.Close),
+		"CommandClose":       reflect.ValueOf(constant.MakeFromLiteral("\"IO.close\"", token.STRING, 0)),
+		"CommandRead":        reflect.ValueOf(constant.MakeFromLiteral("\"IO.read\"", token.STRING, 0)),
+		"CommandResolveBlob": reflect.ValueOf(constant.MakeFromLiteral("\"IO.resolveBlob\"", token.STRING, 0)),
+		"Read":               reflect.ValueOf(io.Read),
+		"ResolveBlob":        reflect.ValueOf(io.ResolveBlob),
+
+		// type definitions
+		"CloseParams":        reflect.ValueOf((*io.CloseParams)(nil)),
+		"ReadParams":         reflect.ValueOf((*io.ReadParams)(nil)),
+		"ReadReturns":        reflect.ValueOf((*io.ReadReturns)(nil)),
+		"ResolveBlobParams":  reflect.ValueOf((*io.ResolveBlobParams)(nil)),
+		"ResolveBlobReturns": reflect.ValueOf((*io.ResolveBlobReturns)(nil)),
+		"StreamHandle":       reflect.ValueOf((*io.StreamHandle)(nil)),
+	}
+}
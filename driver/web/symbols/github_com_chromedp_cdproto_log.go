@@ -0,0 +1,63 @@
+// Code generated by 'yaegi extract github.com/chromedp/cdproto/log'. DO NOT EDIT.
+
+package symbols
+
+import (
+	"github.com/chromedp/cdproto/log"
+	"go/constant"
+	"go/token"
+	"reflect"
+)
+
+func init() {
+	Symbols["github.com/chromedp/cdproto/log/log"] = map[string]reflect.Value{
+		// function, constant and variable definitions
+		"Clear":                        reflect.ValueOf(log.Clear),
+		"CommandClear":                 reflect.ValueOf(constant.MakeFromLiteral("\"Log.clear\"", token.STRING, 0)),
+		"CommandDisable":               reflect.ValueOf(constant.MakeFromLiteral("\"Log.disable\"", token.STRING, 0)),
+		"CommandEnable":                reflect.ValueOf(constant.MakeFromLiteral("\"Log.enable\"", token.STRING, 0)),
+		"CommandStartViolationsReport": reflect.ValueOf(constant.MakeFromLiteral("\"Log.startViolationsReport\"", token.STRING, 0)),
+		"CommandStopViolationsReport":  reflect.ValueOf(constant.MakeFromLiteral("\"Log.stopViolationsReport\"", token.STRING, 0)),
+		"Disable":                      reflect.ValueOf(log.Disable),
+		"Enable":                       reflect.ValueOf(log.Enable),
+		"LevelError":                   reflect.ValueOf(log.LevelError),
+		"LevelInfo":                    reflect.ValueOf(log.LevelInfo),
+		"LevelVerbose":                 reflect.ValueOf(log.LevelVerbose),
+		"LevelWarning":                 reflect.ValueOf(log.LevelWarning),
+		"SourceAppcache":               reflect.ValueOf(log.SourceAppcache),
+		"SourceDeprecation":            reflect.ValueOf(log.SourceDeprecation),
+		"SourceIntervention":           reflect.ValueOf(log.SourceIntervention),
+		"SourceJavascript":             reflect.ValueOf(log.SourceJavascript),
+		"SourceNetwork":                reflect.ValueOf(log.SourceNetwork),
+		"SourceOther":                  reflect.ValueOf(log.SourceOther),
+		"SourceRecommendation":         reflect.ValueOf(log.SourceRecommendation),
+		"SourceRendering":              reflect.ValueOf(log.SourceRendering),
+		"SourceSecurity":               reflect.ValueOf(log.SourceSecurity),
+		"SourceStorage":                reflect.ValueOf(log.SourceStorage),
+		"SourceViolation":              reflect.ValueOf(log.SourceViolation),
+		"SourceWorker":                 reflect.ValueOf(log.SourceWorker),
+		"SourceXML":                    reflect.ValueOf(log.SourceXML),
+		"StartViolationsReport":        reflect.ValueOf(log.StartViolationsReport),
+		"StopViolationsReport":         reflect.ValueOf(log.StopViolationsReport),
+		"ViolationBlockedEvent":        reflect.ValueOf(log.ViolationBlockedEvent),
+		"ViolationBlockedParser":       reflect.ValueOf(log.ViolationBlockedParser),
+		"ViolationDiscouragedAPIUse":   reflect.ValueOf(log.ViolationDiscouragedAPIUse),
+		"ViolationHandler":             reflect.ValueOf(log.ViolationHandler),
+		"ViolationLongLayout":          reflect.ValueOf(log.ViolationLongLayout),
+		"ViolationLongTask":            reflect.ValueOf(log.ViolationLongTask),
+		"ViolationRecurringHandler":    reflect.ValueOf(log.ViolationRecurringHandler),
+
+		// type definitions
+		"ClearParams":                 reflect.ValueOf((*log.ClearParams)(nil)),
+		"DisableParams":               reflect.ValueOf((*log.DisableParams)(nil)),
+		"EnableParams":                reflect.ValueOf((*log.EnableParams)(nil)),
+		"Entry":                       reflect.ValueOf((*log.Entry)(nil)),
+		"EventEntryAdded":             reflect.ValueOf((*log.EventEntryAdded)(nil)),
+		"Level":                       reflect.ValueOf((*log.Level)(nil)),
+		"Source":                      reflect.ValueOf((*log.Source)(nil)),
+		"StartViolationsReportParams": reflect.ValueOf((*log.StartViolationsReportParams)(nil)),
+		"StopViolationsReportParams":  reflect.ValueOf((*log.StopViolationsReportParams)(nil)),
+		"Violation":                   reflect.ValueOf((*log.Violation)(nil)),
+		"ViolationSetting":            reflect.ValueOf((*log.ViolationSetting)(nil)),
+	}
+}
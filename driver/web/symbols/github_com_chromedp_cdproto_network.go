@@ -0,0 +1,255 @@
+// Code generated by 'yaegi extract github.com/chromedp/cdproto/network'. DO NOT EDIT.
+
+package symbols
+
+import (
+	"github.com/chromedp/cdproto/network"
+	"go/constant"
+	"go/token"
+	"reflect"
+)
+
+func init() {
+	Symbols["github.com/chromedp/cdproto/network/network"] = map[string]reflect.Value{
+		// function, constant and variable definitions
+		"AuthChallengeResponseResponseCancelAuth":               reflect.ValueOf(network.AuthChallengeResponseResponseCancelAuth),
+		"AuthChallengeResponseResponseDefault":                  reflect.ValueOf(network.AuthChallengeResponseResponseDefault),
+		"AuthChallengeResponseResponseProvideCredentials":       reflect.ValueOf(network.AuthChallengeResponseResponseProvideCredentials),
+		"AuthChallengeSourceProxy":                              reflect.ValueOf(network.AuthChallengeSourceProxy),
+		"AuthChallengeSourceServer":                             reflect.ValueOf(network.AuthChallengeSourceServer),
+		"BlockedReasonCollapsedByClient":                        reflect.ValueOf(network.BlockedReasonCollapsedByClient),
+		"BlockedReasonContentType":                              reflect.ValueOf(network.BlockedReasonContentType),
+		"BlockedReasonCsp":                                      reflect.ValueOf(network.BlockedReasonCsp),
+		"BlockedReasonInspector":                                reflect.ValueOf(network.BlockedReasonInspector),
+		"BlockedReasonMixedContent":                             reflect.ValueOf(network.BlockedReasonMixedContent),
+		"BlockedReasonOrigin":                                   reflect.ValueOf(network.BlockedReasonOrigin),
+		"BlockedReasonOther":                                    reflect.ValueOf(network.BlockedReasonOther),
+		"BlockedReasonSubresourceFilter":                        reflect.ValueOf(network.BlockedReasonSubresourceFilter),
+		"CertificateTransparencyComplianceCompliant":            reflect.ValueOf(network.CertificateTransparencyComplianceCompliant),
+		"CertificateTransparencyComplianceNotCompliant":         reflect.ValueOf(network.CertificateTransparencyComplianceNotCompliant),
+		"CertificateTransparencyComplianceUnknown":              reflect.ValueOf(network.CertificateTransparencyComplianceUnknown),
+		"ClearBrowserCache":                                     reflect.ValueOf(network.ClearBrowserCache),
+		"ClearBrowserCookies":                                   reflect.ValueOf(network.ClearBrowserCookies),
+		"CommandClearBrowserCache":                              reflect.ValueOf(constant.MakeFromLiteral("\"Network.clearBrowserCache\"", token.STRING, 0)),
+		"CommandClearBrowserCookies":                            reflect.ValueOf(constant.MakeFromLiteral("\"Network.clearBrowserCookies\"", token.STRING, 0)),
+		"CommandDeleteCookies":                                  reflect.ValueOf(constant.MakeFromLiteral("\"Network.deleteCookies\"", token.STRING, 0)),
+		"CommandDisable":                                        reflect.ValueOf(constant.MakeFromLiteral("\"Network.disable\"", token.STRING, 0)),
+		"CommandEmulateNetworkConditions":                       reflect.ValueOf(constant.MakeFromLiteral("\"Network.emulateNetworkConditions\"", token.STRING, 0)),
+		"CommandEnable":                                         reflect.ValueOf(constant.MakeFromLiteral("\"Network.enable\"", token.STRING, 0)),
+		"CommandGetAllCookies":                                  reflect.ValueOf(constant.MakeFromLiteral("\"Network.getAllCookies\"", token.STRING, 0)),
+		"CommandGetCertificate":                                 reflect.ValueOf(constant.MakeFromLiteral("\"Network.getCertificate\"", token.STRING, 0)),
+		"CommandGetCookies":                                     reflect.ValueOf(constant.MakeFromLiteral("\"Network.getCookies\"", token.STRING, 0)),
+		"CommandGetRequestPostData":                             reflect.ValueOf(constant.MakeFromLiteral("\"Network.getRequestPostData\"", token.STRING, 0)),
+		"CommandGetResponseBody":                                reflect.ValueOf(constant.MakeFromLiteral("\"Network.getResponseBody\"", token.STRING, 0)),
+		"CommandGetResponseBodyForInterception":                 reflect.ValueOf(constant.MakeFromLiteral("\"Network.getResponseBodyForInterception\"", token.STRING, 0)),
+		"CommandReplayXHR":                                      reflect.ValueOf(constant.MakeFromLiteral("\"Network.replayXHR\"", token.STRING, 0)),
+		"CommandSearchInResponseBody":                           reflect.ValueOf(constant.MakeFromLiteral("\"Network.searchInResponseBody\"", token.STRING, 0)),
+		"CommandSetBlockedURLS":                                 reflect.ValueOf(constant.MakeFromLiteral("\"Network.setBlockedURLs\"", token.STRING, 0)),
+		"CommandSetBypassServiceWorker":                         reflect.ValueOf(constant.MakeFromLiteral("\"Network.setBypassServiceWorker\"", token.STRING, 0)),
+		"CommandSetCacheDisabled":                               reflect.ValueOf(constant.MakeFromLiteral("\"Network.setCacheDisabled\"", token.STRING, 0)),
+		"CommandSetCookie":                                      reflect.ValueOf(constant.MakeFromLiteral("\"Network.setCookie\"", token.STRING, 0)),
+		"CommandSetCookies":                                     reflect.ValueOf(constant.MakeFromLiteral("\"Network.setCookies\"", token.STRING, 0)),
+		"CommandSetDataSizeLimitsForTest":                       reflect.ValueOf(constant.MakeFromLiteral("\"Network.setDataSizeLimitsForTest\"", token.STRING, 0)),
+		"CommandSetExtraHTTPHeaders":                            reflect.ValueOf(constant.MakeFromLiteral("\"Network.setExtraHTTPHeaders\"", token.STRING, 0)),
+		"CommandTakeResponseBodyForInterceptionAsStream":        reflect.ValueOf(constant.MakeFromLiteral("\"Network.takeResponseBodyForInterceptionAsStream\"", token.STRING, 0)),
+		"ConnectionTypeBluetooth":                               reflect.ValueOf(network.ConnectionTypeBluetooth),
+		"ConnectionTypeCellular2g":                              reflect.ValueOf(network.ConnectionTypeCellular2g),
+		"ConnectionTypeCellular3g":                              reflect.ValueOf(network.ConnectionTypeCellular3g),
+		"ConnectionTypeCellular4g":                              reflect.ValueOf(network.ConnectionTypeCellular4g),
+		"ConnectionTypeEthernet":                                reflect.ValueOf(network.ConnectionTypeEthernet),
+		"ConnectionTypeNone":                                    reflect.ValueOf(network.ConnectionTypeNone),
+		"ConnectionTypeOther":                                   reflect.ValueOf(network.ConnectionTypeOther),
+		"ConnectionTypeWifi":                                    reflect.ValueOf(network.ConnectionTypeWifi),
+		"ConnectionTypeWimax":                                   reflect.ValueOf(network.ConnectionTypeWimax),
+		"CookieBlockedReasonDomainMismatch":                     reflect.ValueOf(network.CookieBlockedReasonDomainMismatch),
+		"CookieBlockedReasonNotOnPath":                          reflect.ValueOf(network.CookieBlockedReasonNotOnPath),
+		"CookieBlockedReasonSameSiteLax":                        reflect.ValueOf(network.CookieBlockedReasonSameSiteLax),
+		"CookieBlockedReasonSameSiteNoneInsecure":               reflect.ValueOf(network.CookieBlockedReasonSameSiteNoneInsecure),
+		"CookieBlockedReasonSameSiteStrict":                     reflect.ValueOf(network.CookieBlockedReasonSameSiteStrict),
+		"CookieBlockedReasonSameSiteUnspecifiedTreatedAsLax":    reflect.ValueOf(network.CookieBlockedReasonSameSiteUnspecifiedTreatedAsLax),
+		"CookieBlockedReasonSecureOnly":                         reflect.ValueOf(network.CookieBlockedReasonSecureOnly),
+		"CookieBlockedReasonUnknownError":                       reflect.ValueOf(network.CookieBlockedReasonUnknownError),
+		"CookieBlockedReasonUserPreferences":                    reflect.ValueOf(network.CookieBlockedReasonUserPreferences),
+		"CookieSameSiteLax":                                     reflect.ValueOf(network.CookieSameSiteLax),
+		"CookieSameSiteNone":                                    reflect.ValueOf(network.CookieSameSiteNone),
+		"CookieSameSiteStrict":                                  reflect.ValueOf(network.CookieSameSiteStrict),
+		"DeleteCookies":                                         reflect.ValueOf(network.DeleteCookies),
+		"Disable":                                               reflect.ValueOf(network.Disable),
+		"EmulateNetworkConditions":                              reflect.ValueOf(network.EmulateNetworkConditions),
+		"Enable":                                                reflect.ValueOf(network.Enable),
+		"ErrorReasonAborted":                                    reflect.ValueOf(network.ErrorReasonAborted),
+		"ErrorReasonAccessDenied":                               reflect.ValueOf(network.ErrorReasonAccessDenied),
+		"ErrorReasonAddressUnreachable":                         reflect.ValueOf(network.ErrorReasonAddressUnreachable),
+		"ErrorReasonBlockedByClient":                            reflect.ValueOf(network.ErrorReasonBlockedByClient),
+		"ErrorReasonBlockedByResponse":                          reflect.ValueOf(network.ErrorReasonBlockedByResponse),
+		"ErrorReasonConnectionAborted":                          reflect.ValueOf(network.ErrorReasonConnectionAborted),
+		"ErrorReasonConnectionClosed":                           reflect.ValueOf(network.ErrorReasonConnectionClosed),
+		"ErrorReasonConnectionFailed":                           reflect.ValueOf(network.ErrorReasonConnectionFailed),
+		"ErrorReasonConnectionRefused":                          reflect.ValueOf(network.ErrorReasonConnectionRefused),
+		"ErrorReasonConnectionReset":                            reflect.ValueOf(network.ErrorReasonConnectionReset),
+		"ErrorReasonFailed":                                     reflect.ValueOf(network.ErrorReasonFailed),
+		"ErrorReasonInternetDisconnected":                       reflect.ValueOf(network.ErrorReasonInternetDisconnected),
+		"ErrorReasonNameNotResolved":                            reflect.ValueOf(network.ErrorReasonNameNotResolved),
+		"ErrorReasonTimedOut":                                   reflect.ValueOf(network.ErrorReasonTimedOut),
+		"GetAllCookies":                                         reflect.ValueOf(network.GetAllCookies),
+		"GetCertificate":                                        reflect.ValueOf(network.GetCertificate),
+		"GetCookies":                                            reflect.ValueOf(network.GetCookies),
+		"GetRequestPostData":                                    reflect.ValueOf(network.GetRequestPostData),
+		"GetResponseBody":                                       reflect.ValueOf(network.GetResponseBody),
+		"GetResponseBodyForInterception":                        reflect.ValueOf(network.GetResponseBodyForInterception),
+		"InitiatorTypeOther":                                    reflect.ValueOf(network.InitiatorTypeOther),
+		"InitiatorTypeParser":                                   reflect.ValueOf(network.InitiatorTypeParser),
+		"InitiatorTypePreload":                                  reflect.ValueOf(network.InitiatorTypePreload),
+		"InitiatorTypeScript":                                   reflect.ValueOf(network.InitiatorTypeScript),
+		"InitiatorTypeSignedExchange":                           reflect.ValueOf(network.InitiatorTypeSignedExchange),
+		"InterceptionStageHeadersReceived":                      reflect.ValueOf(network.InterceptionStageHeadersReceived),
+		"InterceptionStageRequest":                              reflect.ValueOf(network.InterceptionStageRequest),
+		"ReferrerPolicyNoReferrer":                              reflect.ValueOf(network.ReferrerPolicyNoReferrer),
+		"ReferrerPolicyNoReferrerWhenDowngrade":                 reflect.ValueOf(network.ReferrerPolicyNoReferrerWhenDowngrade),
+		"ReferrerPolicyOrigin":                                  reflect.ValueOf(network.ReferrerPolicyOrigin),
+		"ReferrerPolicyOriginWhenCrossOrigin":                   reflect.ValueOf(network.ReferrerPolicyOriginWhenCrossOrigin),
+		"ReferrerPolicySameOrigin":                              reflect.ValueOf(network.ReferrerPolicySameOrigin),
+		"ReferrerPolicyStrictOrigin":                            reflect.ValueOf(network.ReferrerPolicyStrictOrigin),
+		"ReferrerPolicyStrictOriginWhenCrossOrigin":             reflect.ValueOf(network.ReferrerPolicyStrictOriginWhenCrossOrigin),
+		"ReferrerPolicyUnsafeURL":                               reflect.ValueOf(network.ReferrerPolicyUnsafeURL),
+		"ReplayXHR":                                             reflect.ValueOf(network.ReplayXHR),
+		"ResourcePriorityHigh":                                  reflect.ValueOf(network.ResourcePriorityHigh),
+		"ResourcePriorityLow":                                   reflect.ValueOf(network.ResourcePriorityLow),
+		"ResourcePriorityMedium":                                reflect.ValueOf(network.ResourcePriorityMedium),
+		"ResourcePriorityVeryHigh":                              reflect.ValueOf(network.ResourcePriorityVeryHigh),
+		"ResourcePriorityVeryLow":                               reflect.ValueOf(network.ResourcePriorityVeryLow),
+		"ResourceTypeCSPViolationReport":                        reflect.ValueOf(network.ResourceTypeCSPViolationReport),
+		"ResourceTypeDocument":                                  reflect.ValueOf(network.ResourceTypeDocument),
+		"ResourceTypeEventSource":                               reflect.ValueOf(network.ResourceTypeEventSource),
+		"ResourceTypeFetch":                                     reflect.ValueOf(network.ResourceTypeFetch),
+		"ResourceTypeFont":                                      reflect.ValueOf(network.ResourceTypeFont),
+		"ResourceTypeImage":                                     reflect.ValueOf(network.ResourceTypeImage),
+		"ResourceTypeManifest":                                  reflect.ValueOf(network.ResourceTypeManifest),
+		"ResourceTypeMedia":                                     reflect.ValueOf(network.ResourceTypeMedia),
+		"ResourceTypeOther":                                     reflect.ValueOf(network.ResourceTypeOther),
+		"ResourceTypePing":                                      reflect.ValueOf(network.ResourceTypePing),
+		"ResourceTypeScript":                                    reflect.ValueOf(network.ResourceTypeScript),
+		"ResourceTypeSignedExchange":                            reflect.ValueOf(network.ResourceTypeSignedExchange),
+		"ResourceTypeStylesheet":                                reflect.ValueOf(network.ResourceTypeStylesheet),
+		"ResourceTypeTextTrack":                                 reflect.ValueOf(network.ResourceTypeTextTrack),
+		"ResourceTypeWebSocket":                                 reflect.ValueOf(network.ResourceTypeWebSocket),
+		"ResourceTypeXHR":                                       reflect.ValueOf(network.ResourceTypeXHR),
+		"SearchInResponseBody":                                  reflect.ValueOf(network.SearchInResponseBody),
+		"SetBlockedURLS":                                        reflect.ValueOf(network.SetBlockedURLS),
+		"SetBypassServiceWorker":                                reflect.ValueOf(network.SetBypassServiceWorker),
+		"SetCacheDisabled":                                      reflect.ValueOf(network.SetCacheDisabled),
+		"SetCookie":                                             reflect.ValueOf(network.SetCookie),
+		"SetCookieBlockedReasonInvalidDomain":                   reflect.ValueOf(network.SetCookieBlockedReasonInvalidDomain),
+		"SetCookieBlockedReasonInvalidPrefix":                   reflect.ValueOf(network.SetCookieBlockedReasonInvalidPrefix),
+		"SetCookieBlockedReasonOverwriteSecure":                 reflect.ValueOf(network.SetCookieBlockedReasonOverwriteSecure),
+		"SetCookieBlockedReasonSameSiteLax":                     reflect.ValueOf(network.SetCookieBlockedReasonSameSiteLax),
+		"SetCookieBlockedReasonSameSiteNoneInsecure":            reflect.ValueOf(network.SetCookieBlockedReasonSameSiteNoneInsecure),
+		"SetCookieBlockedReasonSameSiteStrict":                  reflect.ValueOf(network.SetCookieBlockedReasonSameSiteStrict),
+		"SetCookieBlockedReasonSameSiteUnspecifiedTreatedAsLax": reflect.ValueOf(network.SetCookieBlockedReasonSameSiteUnspecifiedTreatedAsLax),
+		"SetCookieBlockedReasonSchemeNotSupported":              reflect.ValueOf(network.SetCookieBlockedReasonSchemeNotSupported),
+		"SetCookieBlockedReasonSecureOnly":                      reflect.ValueOf(network.SetCookieBlockedReasonSecureOnly),
+		"SetCookieBlockedReasonSyntaxError":                     reflect.ValueOf(network.SetCookieBlockedReasonSyntaxError),
+		"SetCookieBlockedReasonUnknownError":                    reflect.ValueOf(network.SetCookieBlockedReasonUnknownError),
+		"SetCookieBlockedReasonUserPreferences":                 reflect.ValueOf(network.SetCookieBlockedReasonUserPreferences),
+		"SetCookies":                                            reflect.ValueOf(network.SetCookies),
+		"SetDataSizeLimitsForTest":                              reflect.ValueOf(network.SetDataSizeLimitsForTest),
+		"SetExtraHTTPHeaders":                                   reflect.ValueOf(network.SetExtraHTTPHeaders),
+		"SignedExchangeErrorFieldSignatureCertSha256":           reflect.ValueOf(network.SignedExchangeErrorFieldSignatureCertSha256),
+		"SignedExchangeErrorFieldSignatureCertURL":              reflect.ValueOf(network.SignedExchangeErrorFieldSignatureCertURL),
+		"SignedExchangeErrorFieldSignatureIntegrity":            reflect.ValueOf(network.SignedExchangeErrorFieldSignatureIntegrity),
+		"SignedExchangeErrorFieldSignatureSig":                  reflect.ValueOf(network.SignedExchangeErrorFieldSignatureSig),
+		"SignedExchangeErrorFieldSignatureTimestamps":           reflect.ValueOf(network.SignedExchangeErrorFieldSignatureTimestamps),
+		"SignedExchangeErrorFieldSignatureValidityURL":          reflect.ValueOf(network.SignedExchangeErrorFieldSignatureValidityURL),
+		"TakeResponseBodyForInterceptionAsStream":               reflect.ValueOf(network.TakeResponseBodyForInterceptionAsStream),
+
+		// type definitions
+		"AuthChallenge":                                  reflect.ValueOf((*network.AuthChallenge)(nil)),
+		"AuthChallengeResponse":                          reflect.ValueOf((*network.AuthChallengeResponse)(nil)),
+		"AuthChallengeResponseResponse":                  reflect.ValueOf((*network.AuthChallengeResponseResponse)(nil)),
+		"AuthChallengeSource":                            reflect.ValueOf((*network.AuthChallengeSource)(nil)),
+		"BlockedCookieWithReason":                        reflect.ValueOf((*network.BlockedCookieWithReason)(nil)),
+		"BlockedReason":                                  reflect.ValueOf((*network.BlockedReason)(nil)),
+		"BlockedSetCookieWithReason":                     reflect.ValueOf((*network.BlockedSetCookieWithReason)(nil)),
+		"CachedResource":                                 reflect.ValueOf((*network.CachedResource)(nil)),
+		"CertificateTransparencyCompliance":              reflect.ValueOf((*network.CertificateTransparencyCompliance)(nil)),
+		"ClearBrowserCacheParams":                        reflect.ValueOf((*network.ClearBrowserCacheParams)(nil)),
+		"ClearBrowserCookiesParams":                      reflect.ValueOf((*network.ClearBrowserCookiesParams)(nil)),
+		"ConnectionType":                                 reflect.ValueOf((*network.ConnectionType)(nil)),
+		"Cookie":                                         reflect.ValueOf((*network.Cookie)(nil)),
+		"CookieBlockedReason":                            reflect.ValueOf((*network.CookieBlockedReason)(nil)),
+		"CookieParam":                                    reflect.ValueOf((*network.CookieParam)(nil)),
+		"CookieSameSite":                                 reflect.ValueOf((*network.CookieSameSite)(nil)),
+		"DeleteCookiesParams":                            reflect.ValueOf((*network.DeleteCookiesParams)(nil)),
+		"DisableParams":                                  reflect.ValueOf((*network.DisableParams)(nil)),
+		"EmulateNetworkConditionsParams":                 reflect.ValueOf((*network.EmulateNetworkConditionsParams)(nil)),
+		"EnableParams":                                   reflect.ValueOf((*network.EnableParams)(nil)),
+		"ErrorReason":                                    reflect.ValueOf((*network.ErrorReason)(nil)),
+		"EventDataReceived":                              reflect.ValueOf((*network.EventDataReceived)(nil)),
+		"EventEventSourceMessageReceived":                reflect.ValueOf((*network.EventEventSourceMessageReceived)(nil)),
+		"EventLoadingFailed":                             reflect.ValueOf((*network.EventLoadingFailed)(nil)),
+		"EventLoadingFinished":                           reflect.ValueOf((*network.EventLoadingFinished)(nil)),
+		"EventRequestServedFromCache":                    reflect.ValueOf((*network.EventRequestServedFromCache)(nil)),
+		"EventRequestWillBeSent":                         reflect.ValueOf((*network.EventRequestWillBeSent)(nil)),
+		"EventRequestWillBeSentExtraInfo":                reflect.ValueOf((*network.EventRequestWillBeSentExtraInfo)(nil)),
+		"EventResourceChangedPriority":                   reflect.ValueOf((*network.EventResourceChangedPriority)(nil)),
+		"EventResponseReceived":                          reflect.ValueOf((*network.EventResponseReceived)(nil)),
+		"EventResponseReceivedExtraInfo":                 reflect.ValueOf((*network.EventResponseReceivedExtraInfo)(nil)),
+		"EventSignedExchangeReceived":                    reflect.ValueOf((*network.EventSignedExchangeReceived)(nil)),
+		"EventWebSocketClosed":                           reflect.ValueOf((*network.EventWebSocketClosed)(nil)),
+		"EventWebSocketCreated":                          reflect.ValueOf((*network.EventWebSocketCreated)(nil)),
+		"EventWebSocketFrameError":                       reflect.ValueOf((*network.EventWebSocketFrameError)(nil)),
+		"EventWebSocketFrameReceived":                    reflect.ValueOf((*network.EventWebSocketFrameReceived)(nil)),
+		"EventWebSocketFrameSent":                        reflect.ValueOf((*network.EventWebSocketFrameSent)(nil)),
+		"EventWebSocketHandshakeResponseReceived":        reflect.ValueOf((*network.EventWebSocketHandshakeResponseReceived)(nil)),
+		"EventWebSocketWillSendHandshakeRequest":         reflect.ValueOf((*network.EventWebSocketWillSendHandshakeRequest)(nil)),
+		"GetAllCookiesParams":                            reflect.ValueOf((*network.GetAllCookiesParams)(nil)),
+		"GetAllCookiesReturns":                           reflect.ValueOf((*network.GetAllCookiesReturns)(nil)),
+		"GetCertificateParams":                           reflect.ValueOf((*network.GetCertificateParams)(nil)),
+		"GetCertificateReturns":                          reflect.ValueOf((*network.GetCertificateReturns)(nil)),
+		"GetCookiesParams":                               reflect.ValueOf((*network.GetCookiesParams)(nil)),
+		"GetCookiesReturns":                              reflect.ValueOf((*network.GetCookiesReturns)(nil)),
+		"GetRequestPostDataParams":                       reflect.ValueOf((*network.GetRequestPostDataParams)(nil)),
+		"GetRequestPostDataReturns":                      reflect.ValueOf((*network.GetRequestPostDataReturns)(nil)),
+		"GetResponseBodyForInterceptionParams":           reflect.ValueOf((*network.GetResponseBodyForInterceptionParams)(nil)),
+		"GetResponseBodyForInterceptionReturns":          reflect.ValueOf((*network.GetResponseBodyForInterceptionReturns)(nil)),
+		"GetResponseBodyParams":                          reflect.ValueOf((*network.GetResponseBodyParams)(nil)),
+		"GetResponseBodyReturns":                         reflect.ValueOf((*network.GetResponseBodyReturns)(nil)),
+		"Headers":                                        reflect.ValueOf((*network.Headers)(nil)),
+		"Initiator":                                      reflect.ValueOf((*network.Initiator)(nil)),
+		"InitiatorType":                                  reflect.ValueOf((*network.InitiatorType)(nil)),
+		"InterceptionID":                                 reflect.ValueOf((*network.InterceptionID)(nil)),
+		"InterceptionStage":                              reflect.ValueOf((*network.InterceptionStage)(nil)),
+		"ReferrerPolicy":                                 reflect.ValueOf((*network.ReferrerPolicy)(nil)),
+		"ReplayXHRParams":                                reflect.ValueOf((*network.ReplayXHRParams)(nil)),
+		"Request":                                        reflect.ValueOf((*network.Request)(nil)),
+		"RequestID":                                      reflect.ValueOf((*network.RequestID)(nil)),
+		"RequestPattern":                                 reflect.ValueOf((*network.RequestPattern)(nil)),
+		"ResourcePriority":                               reflect.ValueOf((*network.ResourcePriority)(nil)),
+		"ResourceTiming":                                 reflect.ValueOf((*network.ResourceTiming)(nil)),
+		"ResourceType":                                   reflect.ValueOf((*network.ResourceType)(nil)),
+		"Response":                                       reflect.ValueOf((*network.Response)(nil)),
+		"SearchInResponseBodyParams":                     reflect.ValueOf((*network.SearchInResponseBodyParams)(nil)),
+		"SearchInResponseBodyReturns":                    reflect.ValueOf((*network.SearchInResponseBodyReturns)(nil)),
+		"SecurityDetails":                                reflect.ValueOf((*network.SecurityDetails)(nil)),
+		"SetBlockedURLSParams":                           reflect.ValueOf((*network.SetBlockedURLSParams)(nil)),
+		"SetBypassServiceWorkerParams":                   reflect.ValueOf((*network.SetBypassServiceWorkerParams)(nil)),
+		"SetCacheDisabledParams":                         reflect.ValueOf((*network.SetCacheDisabledParams)(nil)),
+		"SetCookieBlockedReason":                         reflect.ValueOf((*network.SetCookieBlockedReason)(nil)),
+		"SetCookieParams":                                reflect.ValueOf((*network.SetCookieParams)(nil)),
+		"SetCookieReturns":                               reflect.ValueOf((*network.SetCookieReturns)(nil)),
+		"SetCookiesParams":                               reflect.ValueOf((*network.SetCookiesParams)(nil)),
+		"SetDataSizeLimitsForTestParams":                 reflect.ValueOf((*network.SetDataSizeLimitsForTestParams)(nil)),
+		"SetExtraHTTPHeadersParams":                      reflect.ValueOf((*network.SetExtraHTTPHeadersParams)(nil)),
+		"SignedCertificateTimestamp":                     reflect.ValueOf((*network.SignedCertificateTimestamp)(nil)),
+		"SignedExchangeError":                            reflect.ValueOf((*network.SignedExchangeError)(nil)),
+		"SignedExchangeErrorField":                       reflect.ValueOf((*network.SignedExchangeErrorField)(nil)),
+		"SignedExchangeHeader":                           reflect.ValueOf((*network.SignedExchangeHeader)(nil)),
+		"SignedExchangeInfo":                             reflect.ValueOf((*network.SignedExchangeInfo)(nil)),
+		"SignedExchangeSignature":                        reflect.ValueOf((*network.SignedExchangeSignature)(nil)),
+		"TakeResponseBodyForInterceptionAsStreamParams":  reflect.ValueOf((*network.TakeResponseBodyForInterceptionAsStreamParams)(nil)),
+		"TakeResponseBodyForInterceptionAsStreamReturns": reflect.ValueOf((*network.TakeResponseBodyForInterceptionAsStreamReturns)(nil)),
+		"WebSocketFrame":                                 reflect.ValueOf((*network.WebSocketFrame)(nil)),
+		"WebSocketRequest":                               reflect.ValueOf((*network.WebSocketRequest)(nil)),
+		"WebSocketResponse":                              reflect.ValueOf((*network.WebSocketResponse)(nil)),
+	}
+}
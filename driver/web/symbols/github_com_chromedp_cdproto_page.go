@@ -0,0 +1,246 @@
+// Code generated by 'yaegi extract github.com/chromedp/cdproto/page'. DO NOT EDIT.
+
+package symbols
+
+import (
+	"github.com/chromedp/cdproto/page"
+	"go/constant"
+	"go/token"
+	"reflect"
+)
+
+func init() {
+	Symbols["github.com/chromedp/cdproto/page/page"] = map[string]reflect.Value{
+		// function, constant and variable definitions
+		"AddCompilationCache":                         reflect.ValueOf(page.AddCompilationCache),
+		"AddScriptToEvaluateOnNewDocument":            reflect.ValueOf(page.AddScriptToEvaluateOnNewDocument),
+		"BringToFront":                                reflect.ValueOf(page.BringToFront),
+		"CaptureScreenshot":                           reflect.ValueOf(page.CaptureScreenshot),
+		"CaptureScreenshotFormatJpeg":                 reflect.ValueOf(page.CaptureScreenshotFormatJpeg),
+		"CaptureScreenshotFormatPng":                  reflect.ValueOf(page.CaptureScreenshotFormatPng),
+		"CaptureSnapshot":                             reflect.ValueOf(page.CaptureSnapshot),
+		"CaptureSnapshotFormatMhtml":                  reflect.ValueOf(page.CaptureSnapshotFormatMhtml),
+		"ClearCompilationCache":                       reflect.ValueOf(page.ClearCompilationCache),
+		"ClientNavigationReasonFormSubmissionGet":     reflect.ValueOf(page.ClientNavigationReasonFormSubmissionGet),
+		"ClientNavigationReasonFormSubmissionPost":    reflect.ValueOf(page.ClientNavigationReasonFormSubmissionPost),
+		"ClientNavigationReasonHTTPHeaderRefresh":     reflect.ValueOf(page.ClientNavigationReasonHTTPHeaderRefresh),
+		"ClientNavigationReasonMetaTagRefresh":        reflect.ValueOf(page.ClientNavigationReasonMetaTagRefresh),
+		"ClientNavigationReasonPageBlockInterstitial": reflect.ValueOf(page.ClientNavigationReasonPageBlockInterstitial),
+		"ClientNavigationReasonReload":                reflect.ValueOf(page.ClientNavigationReasonReload),
+		"ClientNavigationReasonScriptInitiated":       reflect.ValueOf(page.ClientNavigationReasonScriptInitiated),
+		"Close":                                       reflect.ValueOf(page.Close),
+		"CommandAddCompilationCache":                  reflect.ValueOf(constant.MakeFromLiteral("\"Page.addCompilationCache\"", token.STRING, 0)),
+		"CommandAddScriptToEvaluateOnNewDocument":     reflect.ValueOf(constant.MakeFromLiteral("\"Page.addScriptToEvaluateOnNewDocument\"", token.STRING, 0)),
+		"CommandBringToFront":                         reflect.ValueOf(constant.MakeFromLiteral("\"Page.bringToFront\"", token.STRING, 0)),
+		"CommandCaptureScreenshot":                    reflect.ValueOf(constant.MakeFromLiteral("\"Page.captureScreenshot\"", token.STRING, 0)),
+		"CommandCaptureSnapshot":                      reflect.ValueOf(constant.MakeFromLiteral("\"Page.captureSnapshot\"", token.STRING, 0)),
+		"CommandClearCompilationCache":                reflect.ValueOf(constant.MakeFromLiteral("\"Page.clearCompilationCache\"", token.STRING, 0)),
+		"CommandClose":                                reflect.ValueOf(constant.MakeFromLiteral("\"Page.close\"", token.STRING, 0)),
+		"CommandCrash":                                reflect.ValueOf(constant.MakeFromLiteral("\"Page.crash\"", token.STRING, 0)),
+		"CommandCreateIsolatedWorld":                  reflect.ValueOf(constant.MakeFromLiteral("\"Page.createIsolatedWorld\"", token.STRING, 0)),
+		"CommandDisable":                              reflect.ValueOf(constant.MakeFromLiteral("\"Page.disable\"", token.STRING, 0)),
+		"CommandEnable":                               reflect.ValueOf(constant.MakeFromLiteral("\"Page.enable\"", token.STRING, 0)),
+		"CommandGenerateTestReport":                   reflect.ValueOf(constant.MakeFromLiteral("\"Page.generateTestReport\"", token.STRING, 0)),
+		"CommandGetAppManifest":                       reflect.ValueOf(constant.MakeFromLiteral("\"Page.getAppManifest\"", token.STRING, 0)),
+		"CommandGetFrameTree":                         reflect.ValueOf(constant.MakeFromLiteral("\"Page.getFrameTree\"", token.STRING, 0)),
+		"CommandGetInstallabilityErrors":              reflect.ValueOf(constant.MakeFromLiteral("\"Page.getInstallabilityErrors\"", token.STRING, 0)),
+		"CommandGetLayoutMetrics":                     reflect.ValueOf(constant.MakeFromLiteral("\"Page.getLayoutMetrics\"", token.STRING, 0)),
+		"CommandGetNavigationHistory":                 reflect.ValueOf(constant.MakeFromLiteral("\"Page.getNavigationHistory\"", token.STRING, 0)),
+		"CommandGetResourceContent":                   reflect.ValueOf(constant.MakeFromLiteral("\"Page.getResourceContent\"", token.STRING, 0)),
+		"CommandGetResourceTree":                      reflect.ValueOf(constant.MakeFromLiteral("\"Page.getResourceTree\"", token.STRING, 0)),
+		"CommandHandleFileChooser":                    reflect.ValueOf(constant.MakeFromLiteral("\"Page.handleFileChooser\"", token.STRING, 0)),
+		"CommandHandleJavaScriptDialog":               reflect.ValueOf(constant.MakeFromLiteral("\"Page.handleJavaScriptDialog\"", token.STRING, 0)),
+		"CommandNavigate":                             reflect.ValueOf(constant.MakeFromLiteral("\"Page.navigate\"", token.STRING, 0)),
+		"CommandNavigateToHistoryEntry":               reflect.ValueOf(constant.MakeFromLiteral("\"Page.navigateToHistoryEntry\"", token.STRING, 0)),
+		"CommandPrintToPDF":                           reflect.ValueOf(constant.MakeFromLiteral("\"Page.printToPDF\"", token.STRING, 0)),
+		"CommandReload":                               reflect.ValueOf(constant.MakeFromLiteral("\"Page.reload\"", token.STRING, 0)),
+		"CommandRemoveScriptToEvaluateOnNewDocument":  reflect.ValueOf(constant.MakeFromLiteral("\"Page.removeScriptToEvaluateOnNewDocument\"", token.STRING, 0)),
+		"CommandResetNavigationHistory":               reflect.ValueOf(constant.MakeFromLiteral("\"Page.resetNavigationHistory\"", token.STRING, 0)),
+		"CommandScreencastFrameAck":                   reflect.ValueOf(constant.MakeFromLiteral("\"Page.screencastFrameAck\"", token.STRING, 0)),
+		"CommandSearchInResource":                     reflect.ValueOf(constant.MakeFromLiteral("\"Page.searchInResource\"", token.STRING, 0)),
+		"CommandSetAdBlockingEnabled":                 reflect.ValueOf(constant.MakeFromLiteral("\"Page.setAdBlockingEnabled\"", token.STRING, 0)),
+		"CommandSetBypassCSP":                         reflect.ValueOf(constant.MakeFromLiteral("\"Page.setBypassCSP\"", token.STRING, 0)),
+		"CommandSetDocumentContent":                   reflect.ValueOf(constant.MakeFromLiteral("\"Page.setDocumentContent\"", token.STRING, 0)),
+		"CommandSetDownloadBehavior":                  reflect.ValueOf(constant.MakeFromLiteral("\"Page.setDownloadBehavior\"", token.STRING, 0)),
+		"CommandSetFontFamilies":                      reflect.ValueOf(constant.MakeFromLiteral("\"Page.setFontFamilies\"", token.STRING, 0)),
+		"CommandSetFontSizes":                         reflect.ValueOf(constant.MakeFromLiteral("\"Page.setFontSizes\"", token.STRING, 0)),
+		"CommandSetInterceptFileChooserDialog":        reflect.ValueOf(constant.MakeFromLiteral("\"Page.setInterceptFileChooserDialog\"", token.STRING, 0)),
+		"CommandSetLifecycleEventsEnabled":            reflect.ValueOf(constant.MakeFromLiteral("\"Page.setLifecycleEventsEnabled\"", token.STRING, 0)),
+		"CommandSetProduceCompilationCache":           reflect.ValueOf(constant.MakeFromLiteral("\"Page.setProduceCompilationCache\"", token.STRING, 0)),
+		"CommandSetWebLifecycleState":                 reflect.ValueOf(constant.MakeFromLiteral("\"Page.setWebLifecycleState\"", token.STRING, 0)),
+		"CommandStartScreencast":                      reflect.ValueOf(constant.MakeFromLiteral("\"Page.startScreencast\"", token.STRING, 0)),
+		"CommandStopLoading":                          reflect.ValueOf(constant.MakeFromLiteral("\"Page.stopLoading\"", token.STRING, 0)),
+		"CommandStopScreencast":                       reflect.ValueOf(constant.MakeFromLiteral("\"Page.stopScreencast\"", token.STRING, 0)),
+		"CommandWaitForDebugger":                      reflect.ValueOf(constant.MakeFromLiteral("\"Page.waitForDebugger\"", token.STRING, 0)),
+		"Crash":                                       reflect.ValueOf(page.Crash),
+		"CreateIsolatedWorld":                         reflect.ValueOf(page.CreateIsolatedWorld),
+		"DialogTypeAlert":                             reflect.ValueOf(page.DialogTypeAlert),
+		"DialogTypeBeforeunload":                      reflect.ValueOf(page.DialogTypeBeforeunload),
+		"DialogTypeConfirm":                           reflect.ValueOf(page.DialogTypeConfirm),
+		"DialogTypePrompt":                            reflect.ValueOf(page.DialogTypePrompt),
+		"Disable":                                     reflect.ValueOf(page.Disable),
+		"Enable":                                      reflect.ValueOf(page.Enable),
+		"FileChooserOpenedModeSelectMultiple":         reflect.ValueOf(page.FileChooserOpenedModeSelectMultiple),
+		"FileChooserOpenedModeSelectSingle":           reflect.ValueOf(page.FileChooserOpenedModeSelectSingle),
+		"GenerateTestReport":                          reflect.ValueOf(page.GenerateTestReport),
+		"GetAppManifest":                              reflect.ValueOf(page.GetAppManifest),
+		"GetFrameTree":                                reflect.ValueOf(page.GetFrameTree),
+		"GetInstallabilityErrors":                     reflect.ValueOf(page.GetInstallabilityErrors),
+		"GetLayoutMetrics":                            reflect.ValueOf(page.GetLayoutMetrics),
+		"GetNavigationHistory":                        reflect.ValueOf(page.GetNavigationHistory),
+		"GetResourceContent":                          reflect.ValueOf(page.GetResourceContent),
+		"GetResourceTree":                             reflect.ValueOf(page.GetResourceTree),
+		"HandleFileChooser":                           reflect.ValueOf(page.HandleFileChooser),
+		"HandleFileChooserActionAccept":               reflect.ValueOf(page.HandleFileChooserActionAccept),
+		"HandleFileChooserActionCancel":               reflect.ValueOf(page.HandleFileChooserActionCancel),
+		"HandleFileChooserActionFallback":             reflect.ValueOf(page.HandleFileChooserActionFallback),
+		"HandleJavaScriptDialog":                      reflect.ValueOf(page.HandleJavaScriptDialog),
+		"Navigate":                                    reflect.ValueOf(page.Navigate),
+		"NavigateToHistoryEntry":                      reflect.ValueOf(page.NavigateToHistoryEntry),
+		"PrintToPDF":                                  reflect.ValueOf(page.PrintToPDF),
+		"PrintToPDFTransferModeReturnAsBase64":        reflect.ValueOf(page.PrintToPDFTransferModeReturnAsBase64),
+		"PrintToPDFTransferModeReturnAsStream":        reflect.ValueOf(page.PrintToPDFTransferModeReturnAsStream),
+		"Reload":                                      reflect.ValueOf(page.Reload),
+		"RemoveScriptToEvaluateOnNewDocument":         reflect.ValueOf(page.RemoveScriptToEvaluateOnNewDocument),
+		"ResetNavigationHistory":                      reflect.ValueOf(page.ResetNavigationHistory),
+		"ScreencastFormatJpeg":                        reflect.ValueOf(page.ScreencastFormatJpeg),
+		"ScreencastFormatPng":                         reflect.ValueOf(page.ScreencastFormatPng),
+		"ScreencastFrameAck":                          reflect.ValueOf(page.ScreencastFrameAck),
+		"SearchInResource":                            reflect.ValueOf(page.SearchInResource),
+		"SetAdBlockingEnabled":                        reflect.ValueOf(page.SetAdBlockingEnabled),
+		"SetBypassCSP":                                reflect.ValueOf(page.SetBypassCSP),
+		"SetDocumentContent":                          reflect.ValueOf(page.SetDocumentContent),
+		"SetDownloadBehavior":                         reflect.ValueOf(page.SetDownloadBehavior),
+		"SetDownloadBehaviorBehaviorAllow":            reflect.ValueOf(page.SetDownloadBehaviorBehaviorAllow),
+		"SetDownloadBehaviorBehaviorDefault":          reflect.ValueOf(page.SetDownloadBehaviorBehaviorDefault),
+		"SetDownloadBehaviorBehaviorDeny":             reflect.ValueOf(page.SetDownloadBehaviorBehaviorDeny),
+		"SetFontFamilies":                             reflect.ValueOf(page.SetFontFamilies),
+		"SetFontSizes":                                reflect.ValueOf(page.SetFontSizes),
+		"SetInterceptFileChooserDialog":               reflect.ValueOf(page.SetInterceptFileChooserDialog),
+		"SetLifecycleEventsEnabled":                   reflect.ValueOf(page.SetLifecycleEventsEnabled),
+		"SetProduceCompilationCache":                  reflect.ValueOf(page.SetProduceCompilationCache),
+		"SetWebLifecycleState":                        reflect.ValueOf(page.SetWebLifecycleState),
+		"SetWebLifecycleStateStateActive":             reflect.ValueOf(page.SetWebLifecycleStateStateActive),
+		"SetWebLifecycleStateStateFrozen":             reflect.ValueOf(page.SetWebLifecycleStateStateFrozen),
+		"StartScreencast":                             reflect.ValueOf(page.StartScreencast),
+		"StopLoading":                                 reflect.ValueOf(page.StopLoading),
+		"StopScreencast":                              reflect.ValueOf(page.StopScreencast),
+		"TransitionTypeAddressBar":                    reflect.ValueOf(page.TransitionTypeAddressBar),
+		"TransitionTypeAutoBookmark":                  reflect.ValueOf(page.TransitionTypeAutoBookmark),
+		"TransitionTypeAutoSubframe":                  reflect.ValueOf(page.TransitionTypeAutoSubframe),
+		"TransitionTypeAutoToplevel":                  reflect.ValueOf(page.TransitionTypeAutoToplevel),
+		"TransitionTypeFormSubmit":                    reflect.ValueOf(page.TransitionTypeFormSubmit),
+		"TransitionTypeGenerated":                     reflect.ValueOf(page.TransitionTypeGenerated),
+		"TransitionTypeKeyword":                       reflect.ValueOf(page.TransitionTypeKeyword),
+		"TransitionTypeKeywordGenerated":              reflect.ValueOf(page.TransitionTypeKeywordGenerated),
+		"TransitionTypeLink":                          reflect.ValueOf(page.TransitionTypeLink),
+		"TransitionTypeManualSubframe":                reflect.ValueOf(page.TransitionTypeManualSubframe),
+		"TransitionTypeOther":                         reflect.ValueOf(page.TransitionTypeOther),
+		"TransitionTypeReload":                        reflect.ValueOf(page.TransitionTypeReload),
+		"TransitionTypeTyped":                         reflect.ValueOf(page.TransitionTypeTyped),
+		"WaitForDebugger":                             reflect.ValueOf(page.WaitForDebugger),
+
+		// type definitions
+		"AddCompilationCacheParams":                 reflect.ValueOf((*page.AddCompilationCacheParams)(nil)),
+		"AddScriptToEvaluateOnNewDocumentParams":    reflect.ValueOf((*page.AddScriptToEvaluateOnNewDocumentParams)(nil)),
+		"AddScriptToEvaluateOnNewDocumentReturns":   reflect.ValueOf((*page.AddScriptToEvaluateOnNewDocumentReturns)(nil)),
+		"AppManifestError":                          reflect.ValueOf((*page.AppManifestError)(nil)),
+		"BringToFrontParams":                        reflect.ValueOf((*page.BringToFrontParams)(nil)),
+		"CaptureScreenshotFormat":                   reflect.ValueOf((*page.CaptureScreenshotFormat)(nil)),
+		"CaptureScreenshotParams":                   reflect.ValueOf((*page.CaptureScreenshotParams)(nil)),
+		"CaptureScreenshotReturns":                  reflect.ValueOf((*page.CaptureScreenshotReturns)(nil)),
+		"CaptureSnapshotFormat":                     reflect.ValueOf((*page.CaptureSnapshotFormat)(nil)),
+		"CaptureSnapshotParams":                     reflect.ValueOf((*page.CaptureSnapshotParams)(nil)),
+		"CaptureSnapshotReturns":                    reflect.ValueOf((*page.CaptureSnapshotReturns)(nil)),
+		"ClearCompilationCacheParams":               reflect.ValueOf((*page.ClearCompilationCacheParams)(nil)),
+		"ClientNavigationReason":                    reflect.ValueOf((*page.ClientNavigationReason)(nil)),
+		"CloseParams":                               reflect.ValueOf((*page.CloseParams)(nil)),
+		"CrashParams":                               reflect.ValueOf((*page.CrashParams)(nil)),
+		"CreateIsolatedWorldParams":                 reflect.ValueOf((*page.CreateIsolatedWorldParams)(nil)),
+		"CreateIsolatedWorldReturns":                reflect.ValueOf((*page.CreateIsolatedWorldReturns)(nil)),
+		"DialogType":                                reflect.ValueOf((*page.DialogType)(nil)),
+		"DisableParams":                             reflect.ValueOf((*page.DisableParams)(nil)),
+		"EnableParams":                              reflect.ValueOf((*page.EnableParams)(nil)),
+		"EventCompilationCacheProduced":             reflect.ValueOf((*page.EventCompilationCacheProduced)(nil)),
+		"EventDomContentEventFired":                 reflect.ValueOf((*page.EventDomContentEventFired)(nil)),
+		"EventDownloadWillBegin":                    reflect.ValueOf((*page.EventDownloadWillBegin)(nil)),
+		"EventFileChooserOpened":                    reflect.ValueOf((*page.EventFileChooserOpened)(nil)),
+		"EventFrameAttached":                        reflect.ValueOf((*page.EventFrameAttached)(nil)),
+		"EventFrameDetached":                        reflect.ValueOf((*page.EventFrameDetached)(nil)),
+		"EventFrameNavigated":                       reflect.ValueOf((*page.EventFrameNavigated)(nil)),
+		"EventFrameRequestedNavigation":             reflect.ValueOf((*page.EventFrameRequestedNavigation)(nil)),
+		"EventFrameResized":                         reflect.ValueOf((*page.EventFrameResized)(nil)),
+		"EventFrameStartedLoading":                  reflect.ValueOf((*page.EventFrameStartedLoading)(nil)),
+		"EventFrameStoppedLoading":                  reflect.ValueOf((*page.EventFrameStoppedLoading)(nil)),
+		"EventInterstitialHidden":                   reflect.ValueOf((*page.EventInterstitialHidden)(nil)),
+		"EventInterstitialShown":                    reflect.ValueOf((*page.EventInterstitialShown)(nil)),
+		"EventJavascriptDialogClosed":               reflect.ValueOf((*page.EventJavascriptDialogClosed)(nil)),
+		"EventJavascriptDialogOpening":              reflect.ValueOf((*page.EventJavascriptDialogOpening)(nil)),
+		"EventLifecycleEvent":                       reflect.ValueOf((*page.EventLifecycleEvent)(nil)),
+		"EventLoadEventFired":                       reflect.ValueOf((*page.EventLoadEventFired)(nil)),
+		"EventNavigatedWithinDocument":              reflect.ValueOf((*page.EventNavigatedWithinDocument)(nil)),
+		"EventScreencastFrame":                      reflect.ValueOf((*page.EventScreencastFrame)(nil)),
+		"EventScreencastVisibilityChanged":          reflect.ValueOf((*page.EventScreencastVisibilityChanged)(nil)),
+		"EventWindowOpen":                           reflect.ValueOf((*page.EventWindowOpen)(nil)),
+		"FileChooserOpenedMode":                     reflect.ValueOf((*page.FileChooserOpenedMode)(nil)),
+		"FontFamilies":                              reflect.ValueOf((*page.FontFamilies)(nil)),
+		"FontSizes":                                 reflect.ValueOf((*page.FontSizes)(nil)),
+		"FrameResource":                             reflect.ValueOf((*page.FrameResource)(nil)),
+		"FrameResourceTree":                         reflect.ValueOf((*page.FrameResourceTree)(nil)),
+		"FrameTree":                                 reflect.ValueOf((*page.FrameTree)(nil)),
+		"GenerateTestReportParams":                  reflect.ValueOf((*page.GenerateTestReportParams)(nil)),
+		"GetAppManifestParams":                      reflect.ValueOf((*page.GetAppManifestParams)(nil)),
+		"GetAppManifestReturns":                     reflect.ValueOf((*page.GetAppManifestReturns)(nil)),
+		"GetFrameTreeParams":                        reflect.ValueOf((*page.GetFrameTreeParams)(nil)),
+		"GetFrameTreeReturns":                       reflect.ValueOf((*page.GetFrameTreeReturns)(nil)),
+		"GetInstallabilityErrorsParams":             reflect.ValueOf((*page.GetInstallabilityErrorsParams)(nil)),
+		"GetInstallabilityErrorsReturns":            reflect.ValueOf((*page.GetInstallabilityErrorsReturns)(nil)),
+		"GetLayoutMetricsParams":                    reflect.ValueOf((*page.GetLayoutMetricsParams)(nil)),
+		"GetLayoutMetricsReturns":                   reflect.ValueOf((*page.GetLayoutMetricsReturns)(nil)),
+		"GetNavigationHistoryParams":                reflect.ValueOf((*page.GetNavigationHistoryParams)(nil)),
+		"GetNavigationHistoryReturns":               reflect.ValueOf((*page.GetNavigationHistoryReturns)(nil)),
+		"GetResourceContentParams":                  reflect.ValueOf((*page.GetResourceContentParams)(nil)),
+		"GetResourceContentReturns":                 reflect.ValueOf((*page.GetResourceContentReturns)(nil)),
+		"GetResourceTreeParams":                     reflect.ValueOf((*page.GetResourceTreeParams)(nil)),
+		"GetResourceTreeReturns":                    reflect.ValueOf((*page.GetResourceTreeReturns)(nil)),
+		"HandleFileChooserAction":                   reflect.ValueOf((*page.HandleFileChooserAction)(nil)),
+		"HandleFileChooserParams":                   reflect.ValueOf((*page.HandleFileChooserParams)(nil)),
+		"HandleJavaScriptDialogParams":              reflect.ValueOf((*page.HandleJavaScriptDialogParams)(nil)),
+		"LayoutViewport":                            reflect.ValueOf((*page.LayoutViewport)(nil)),
+		"NavigateParams":                            reflect.ValueOf((*page.NavigateParams)(nil)),
+		"NavigateReturns":                           reflect.ValueOf((*page.NavigateReturns)(nil)),
+		"NavigateToHistoryEntryParams":              reflect.ValueOf((*page.NavigateToHistoryEntryParams)(nil)),
+		"NavigationEntry":                           reflect.ValueOf((*page.NavigationEntry)(nil)),
+		"PrintToPDFParams":                          reflect.ValueOf((*page.PrintToPDFParams)(nil)),
+		"PrintToPDFReturns":                         reflect.ValueOf((*page.PrintToPDFReturns)(nil)),
+		"PrintToPDFTransferMode":                    reflect.ValueOf((*page.PrintToPDFTransferMode)(nil)),
+		"ReloadParams":                              reflect.ValueOf((*page.ReloadParams)(nil)),
+		"RemoveScriptToEvaluateOnNewDocumentParams": reflect.ValueOf((*page.RemoveScriptToEvaluateOnNewDocumentParams)(nil)),
+		"ResetNavigationHistoryParams":              reflect.ValueOf((*page.ResetNavigationHistoryParams)(nil)),
+		"ScreencastFormat":                          reflect.ValueOf((*page.ScreencastFormat)(nil)),
+		"ScreencastFrameAckParams":                  reflect.ValueOf((*page.ScreencastFrameAckParams)(nil)),
+		"ScreencastFrameMetadata":                   reflect.ValueOf((*page.ScreencastFrameMetadata)(nil)),
+		"ScriptIdentifier":                          reflect.ValueOf((*page.ScriptIdentifier)(nil)),
+		"SearchInResourceParams":                    reflect.ValueOf((*page.SearchInResourceParams)(nil)),
+		"SearchInResourceReturns":                   reflect.ValueOf((*page.SearchInResourceReturns)(nil)),
+		"SetAdBlockingEnabledParams":                reflect.ValueOf((*page.SetAdBlockingEnabledParams)(nil)),
+		"SetBypassCSPParams":                        reflect.ValueOf((*page.SetBypassCSPParams)(nil)),
+		"SetDocumentContentParams":                  reflect.ValueOf((*page.SetDocumentContentParams)(nil)),
+		"SetDownloadBehaviorBehavior":               reflect.ValueOf((*page.SetDownloadBehaviorBehavior)(nil)),
+		"SetDownloadBehaviorParams":                 reflect.ValueOf((*page.SetDownloadBehaviorParams)(nil)),
+		"SetFontFamiliesParams":                     reflect.ValueOf((*page.SetFontFamiliesParams)(nil)),
+		"SetFontSizesParams":                        reflect.ValueOf((*page.SetFontSizesParams)(nil)),
+		"SetInterceptFileChooserDialogParams":       reflect.ValueOf((*page.SetInterceptFileChooserDialogParams)(nil)),
+		"SetLifecycleEventsEnabledParams":           reflect.ValueOf((*page.SetLifecycleEventsEnabledParams)(nil)),
+		"SetProduceCompilationCacheParams":          reflect.ValueOf((*page.SetProduceCompilationCacheParams)(nil)),
+		"SetWebLifecycleStateParams":                reflect.ValueOf((*page.SetWebLifecycleStateParams)(nil)),
+		"SetWebLifecycleStateState":                 reflect.ValueOf((*page.SetWebLifecycleStateState)(nil)),
+		"StartScreencastParams":                     reflect.ValueOf((*page.StartScreencastParams)(nil)),
+		"StopLoadingParams":                         reflect.ValueOf((*page.StopLoadingParams)(nil)),
+		"StopScreencastParams":                      reflect.ValueOf((*page.StopScreencastParams)(nil)),
+		"TransitionType":                            reflect.ValueOf((*page.TransitionType)(nil)),
+		"Viewport":                                  reflect.ValueOf((*page.Viewport)(nil)),
+		"VisualViewport":                            reflect.ValueOf((*page.VisualViewport)(nil)),
+		"WaitForDebuggerParams":                     reflect.ValueOf((*page.WaitForDebuggerParams)(nil)),
+	}
+}
@@ -0,0 +1,36 @@
+// Code generated by 'yaegi extract github.com/chromedp/cdproto/performance'. DO NOT EDIT.
+
+package symbols
+
+import (
+	"github.com/chromedp/cdproto/performance"
+	"go/constant"
+	"go/token"
+	"reflect"
+)
+
+func init() {
+	Symbols["github.com/chromedp/cdproto/performance/performance"] = map[string]reflect.Value{
+		// function, constant and variable definitions
+		"CommandDisable":                     reflect.ValueOf(constant.MakeFromLiteral("\"Performance.disable\"", token.STRING, 0)),
+		"CommandEnable":                      reflect.ValueOf(constant.MakeFromLiteral("\"Performance.enable\"", token.STRING, 0)),
+		"CommandGetMetrics":                  reflect.ValueOf(constant.MakeFromLiteral("\"Performance.getMetrics\"", token.STRING, 0)),
+		"CommandSetTimeDomain":               reflect.ValueOf(constant.MakeFromLiteral("\"Performance.setTimeDomain\"", token.STRING, 0)),
+		"Disable":                            reflect.ValueOf(performance.Disable),
+		"Enable":                             reflect.ValueOf(performance.Enable),
+		"GetMetrics":                         reflect.ValueOf(performance.GetMetrics),
+		"SetTimeDomain":                      reflect.ValueOf(performance.SetTimeDomain),
+		"SetTimeDomainTimeDomainThreadTicks": reflect.ValueOf(performance.SetTimeDomainTimeDomainThreadTicks),
+		"SetTimeDomainTimeDomainTimeTicks":   reflect.ValueOf(performance.SetTimeDomainTimeDomainTimeTicks),
+
+		// type definitions
+		"DisableParams":           reflect.ValueOf((*performance.DisableParams)(nil)),
+		"EnableParams":            reflect.ValueOf((*performance.EnableParams)(nil)),
+		"EventMetrics":            reflect.ValueOf((*performance.EventMetrics)(nil)),
+		"GetMetricsParams":        reflect.ValueOf((*performance.GetMetricsParams)(nil)),
+		"GetMetricsReturns":       reflect.ValueOf((*performance.GetMetricsReturns)(nil)),
+		"Metric":                  reflect.ValueOf((*performance.Metric)(nil)),
+		"SetTimeDomainParams":     reflect.ValueOf((*performance.SetTimeDomainParams)(nil)),
+		"SetTimeDomainTimeDomain": reflect.ValueOf((*performance.SetTimeDomainTimeDomain)(nil)),
+	}
+}
@@ -0,0 +1,167 @@
+// Code generated by 'yaegi extract github.com/chromedp/cdproto/runtime'. DO NOT EDIT.
+
+package symbols
+
+import (
+	"github.com/chromedp/cdproto/runtime"
+	"go/constant"
+	"go/token"
+	"reflect"
+)
+
+func init() {
+	Symbols["github.com/chromedp/cdproto/runtime/runtime"] = map[string]reflect.Value{
+		// function, constant and variable definitions
+		"APITypeAssert":                          reflect.ValueOf(runtime.APITypeAssert),
+		"APITypeClear":                           reflect.ValueOf(runtime.APITypeClear),
+		"APITypeCount":                           reflect.ValueOf(runtime.APITypeCount),
+		"APITypeDebug":                           reflect.ValueOf(runtime.APITypeDebug),
+		"APITypeDir":                             reflect.ValueOf(runtime.APITypeDir),
+		"APITypeDirxml":                          reflect.ValueOf(runtime.APITypeDirxml),
+		"APITypeEndGroup":                        reflect.ValueOf(runtime.APITypeEndGroup),
+		"APITypeError":                           reflect.ValueOf(runtime.APITypeError),
+		"APITypeInfo":                            reflect.ValueOf(runtime.APITypeInfo),
+		"APITypeLog":                             reflect.ValueOf(runtime.APITypeLog),
+		"APITypeProfile":                         reflect.ValueOf(runtime.APITypeProfile),
+		"APITypeProfileEnd":                      reflect.ValueOf(runtime.APITypeProfileEnd),
+		"APITypeStartGroup":                      reflect.ValueOf(runtime.APITypeStartGroup),
+		"APITypeStartGroupCollapsed":             reflect.ValueOf(runtime.APITypeStartGroupCollapsed),
+		"APITypeTable":                           reflect.ValueOf(runtime.APITypeTable),
+		"APITypeTimeEnd":                         reflect.ValueOf(runtime.APITypeTimeEnd),
+		"APITypeTrace":                           reflect.ValueOf(runtime.APITypeTrace),
+		"APITypeWarning":                         reflect.ValueOf(runtime.APITypeWarning),
+		"AddBinding":                             reflect.ValueOf(runtime.AddBinding),
+		"AwaitPromise":                           reflect.ValueOf(runtime.AwaitPromise),
+		"CallFunctionOn":                         reflect.ValueOf(runtime.CallFunctionOn),
+		"CommandAddBinding":                      reflect.ValueOf(constant.MakeFromLiteral("\"Runtime.addBinding\"", token.STRING, 0)),
+		"CommandAwaitPromise":                    reflect.ValueOf(constant.MakeFromLiteral("\"Runtime.awaitPromise\"", token.STRING, 0)),
+		"CommandCallFunctionOn":                  reflect.ValueOf(constant.MakeFromLiteral("\"Runtime.callFunctionOn\"", token.STRING, 0)),
+		"CommandCompileScript":                   reflect.ValueOf(constant.MakeFromLiteral("\"Runtime.compileScript\"", token.STRING, 0)),
+		"CommandDisable":                         reflect.ValueOf(constant.MakeFromLiteral("\"Runtime.disable\"", token.STRING, 0)),
+		"CommandDiscardConsoleEntries":           reflect.ValueOf(constant.MakeFromLiteral("\"Runtime.discardConsoleEntries\"", token.STRING, 0)),
+		"CommandEnable":                          reflect.ValueOf(constant.MakeFromLiteral("\"Runtime.enable\"", token.STRING, 0)),
+		"CommandEvaluate":                        reflect.ValueOf(constant.MakeFromLiteral("\"Runtime.evaluate\"", token.STRING, 0)),
+		"CommandGetHeapUsage":                    reflect.ValueOf(constant.MakeFromLiteral("\"Runtime.getHeapUsage\"", token.STRING, 0)),
+		"CommandGetIsolateID":                    reflect.ValueOf(constant.MakeFromLiteral("\"Runtime.getIsolateId\"", token.STRING, 0)),
+		"CommandGetProperties":                   reflect.ValueOf(constant.MakeFromLiteral("\"Runtime.getProperties\"", token.STRING, 0)),
+		"CommandGlobalLexicalScopeNames":         reflect.ValueOf(constant.MakeFromLiteral("\"Runtime.globalLexicalScopeNames\"", token.STRING, 0)),
+		"CommandQueryObjects":                    reflect.ValueOf(constant.MakeFromLiteral("\"Runtime.queryObjects\"", token.STRING, 0)),
+		"CommandReleaseObject":                   reflect.ValueOf(constant.MakeFromLiteral("\"Runtime.releaseObject\"", token.STRING, 0)),
+		"CommandReleaseObjectGroup":              reflect.ValueOf(constant.MakeFromLiteral("\"Runtime.releaseObjectGroup\"", token.STRING, 0)),
+		"CommandRemoveBinding":                   reflect.ValueOf(constant.MakeFromLiteral("\"Runtime.removeBinding\"", token.STRING, 0)),
+		"CommandRunIfWaitingForDebugger":         reflect.ValueOf(constant.MakeFromLiteral("\"Runtime.runIfWaitingForDebugger\"", token.STRING, 0)),
+		"CommandRunScript":                       reflect.ValueOf(constant.MakeFromLiteral("\"Runtime.runScript\"", token.STRING, 0)),
+		"CommandSetCustomObjectFormatterEnabled": reflect.ValueOf(constant.MakeFromLiteral("\"Runtime.setCustomObjectFormatterEnabled\"", token.STRING, 0)),
+		"CommandSetMaxCallStackSizeToCapture":    reflect.ValueOf(constant.MakeFromLiteral("\"Runtime.setMaxCallStackSizeToCapture\"", token.STRING, 0)),
+		"CommandTerminateExecution":              reflect.ValueOf(constant.MakeFromLiteral("\"Runtime.terminateExecution\"", token.STRING, 0)),
+		"CompileScript":                          reflect.ValueOf(runtime.CompileScript),
+		"Disable":                                reflect.ValueOf(runtime.Disable),
+		"DiscardConsoleEntries":                  reflect.ValueOf(runtime.DiscardConsoleEntries),
+		"Enable":                                 reflect.ValueOf(runtime.Enable),
+		"Evaluate":                               reflect.ValueOf(runtime.Evaluate),
+		"GetHeapUsage":                           reflect.ValueOf(runtime.GetHeapUsage),
+		"GetIsolateID":                           reflect.ValueOf(runtime.GetIsolateID),
+		"GetProperties":                          reflect.ValueOf(runtime.GetProperties),
+		"GlobalLexicalScopeNames":                reflect.ValueOf(runtime.GlobalLexicalScopeNames),
+		"QueryObjects":                           reflect.ValueOf(runtime.QueryObjects),
+		"ReleaseObject":                          reflect.ValueOf(runtime.ReleaseObject),
+		"ReleaseObjectGroup":                     reflect.ValueOf(runtime.ReleaseObjectGroup),
+		"RemoveBinding":                          reflect.ValueOf(runtime.RemoveBinding),
+		"RunIfWaitingForDebugger":                reflect.ValueOf(runtime.RunIfWaitingForDebugger),
+		"RunScript":                              reflect.ValueOf(runtime.RunScript),
+		"SetCustomObjectFormatterEnabled":        reflect.ValueOf(runtime.SetCustomObjectFormatterEnabled),
+		"SetMaxCallStackSizeToCapture":           reflect.ValueOf(runtime.SetMaxCallStackSizeToCapture),
+		"SubtypeArray":                           reflect.ValueOf(runtime.SubtypeArray),
+		"SubtypeArraybuffer":                     reflect.ValueOf(runtime.SubtypeArraybuffer),
+		"SubtypeDataview":                        reflect.ValueOf(runtime.SubtypeDataview),
+		"SubtypeDate":                            reflect.ValueOf(runtime.SubtypeDate),
+		"SubtypeError":                           reflect.ValueOf(runtime.SubtypeError),
+		"SubtypeGenerator":                       reflect.ValueOf(runtime.SubtypeGenerator),
+		"SubtypeIterator":                        reflect.ValueOf(runtime.SubtypeIterator),
+		"SubtypeMap":                             reflect.ValueOf(runtime.SubtypeMap),
+		"SubtypeNode":                            reflect.ValueOf(runtime.SubtypeNode),
+		"SubtypeNull":                            reflect.ValueOf(runtime.SubtypeNull),
+		"SubtypePromise":                         reflect.ValueOf(runtime.SubtypePromise),
+		"SubtypeProxy":                           reflect.ValueOf(runtime.SubtypeProxy),
+		"SubtypeRegexp":                          reflect.ValueOf(runtime.SubtypeRegexp),
+		"SubtypeSet":                             reflect.ValueOf(runtime.SubtypeSet),
+		"SubtypeTypedarray":                      reflect.ValueOf(runtime.SubtypeTypedarray),
+		"SubtypeWeakmap":                         reflect.ValueOf(runtime.SubtypeWeakmap),
+		"SubtypeWeakset":                         reflect.ValueOf(runtime.SubtypeWeakset),
+		"TerminateExecution":                     reflect.ValueOf(runtime.TerminateExecution),
+		"TypeAccessor":                           reflect.ValueOf(runtime.TypeAccessor),
+		"TypeBigint":                             reflect.ValueOf(runtime.TypeBigint),
+		"TypeBoolean":                            reflect.ValueOf(runtime.TypeBoolean),
+		"TypeFunction":                           reflect.ValueOf(runtime.TypeFunction),
+		"TypeNumber":                             reflect.ValueOf(runtime.TypeNumber),
+		"TypeObject":                             reflect.ValueOf(runtime.TypeObject),
+		"TypeString":                             reflect.ValueOf(runtime.TypeString),
+		"TypeSymbol":                             reflect.ValueOf(runtime.TypeSymbol),
+		"TypeUndefined":                          reflect.ValueOf(runtime.TypeUndefined),
+
+		// type definitions
+		"APIType":                               reflect.ValueOf((*runtime.APIType)(nil)),
+		"AddBindingParams":                      reflect.ValueOf((*runtime.AddBindingParams)(nil)),
+		"AwaitPromiseParams":                    reflect.ValueOf((*runtime.AwaitPromiseParams)(nil)),
+		"AwaitPromiseReturns":                   reflect.ValueOf((*runtime.AwaitPromiseReturns)(nil)),
+		"CallArgument":                          reflect.ValueOf((*runtime.CallArgument)(nil)),
+		"CallFrame":                             reflect.ValueOf((*runtime.CallFrame)(nil)),
+		"CallFunctionOnParams":                  reflect.ValueOf((*runtime.CallFunctionOnParams)(nil)),
+		"CallFunctionOnReturns":                 reflect.ValueOf((*runtime.CallFunctionOnReturns)(nil)),
+		"CompileScriptParams":                   reflect.ValueOf((*runtime.CompileScriptParams)(nil)),
+		"CompileScriptReturns":                  reflect.ValueOf((*runtime.CompileScriptReturns)(nil)),
+		"CustomPreview":                         reflect.ValueOf((*runtime.CustomPreview)(nil)),
+		"DisableParams":                         reflect.ValueOf((*runtime.DisableParams)(nil)),
+		"DiscardConsoleEntriesParams":           reflect.ValueOf((*runtime.DiscardConsoleEntriesParams)(nil)),
+		"EnableParams":                          reflect.ValueOf((*runtime.EnableParams)(nil)),
+		"EntryPreview":                          reflect.ValueOf((*runtime.EntryPreview)(nil)),
+		"EvaluateParams":                        reflect.ValueOf((*runtime.EvaluateParams)(nil)),
+		"EvaluateReturns":                       reflect.ValueOf((*runtime.EvaluateReturns)(nil)),
+		"EventBindingCalled":                    reflect.ValueOf((*runtime.EventBindingCalled)(nil)),
+		"EventConsoleAPICalled":                 reflect.ValueOf((*runtime.EventConsoleAPICalled)(nil)),
+		"EventExceptionRevoked":                 reflect.ValueOf((*runtime.EventExceptionRevoked)(nil)),
+		"EventExceptionThrown":                  reflect.ValueOf((*runtime.EventExceptionThrown)(nil)),
+		"EventExecutionContextCreated":          reflect.ValueOf((*runtime.EventExecutionContextCreated)(nil)),
+		"EventExecutionContextDestroyed":        reflect.ValueOf((*runtime.EventExecutionContextDestroyed)(nil)),
+		"EventExecutionContextsCleared":         reflect.ValueOf((*runtime.EventExecutionContextsCleared)(nil)),
+		"EventInspectRequested":                 reflect.ValueOf((*runtime.EventInspectRequested)(nil)),
+		"ExceptionDetails":                      reflect.ValueOf((*runtime.ExceptionDetails)(nil)),
+		"ExecutionContextDescription":           reflect.ValueOf((*runtime.ExecutionContextDescription)(nil)),
+		"ExecutionContextID":                    reflect.ValueOf((*runtime.ExecutionContextID)(nil)),
+		"GetHeapUsageParams":                    reflect.ValueOf((*runtime.GetHeapUsageParams)(nil)),
+		"GetHeapUsageReturns":                   reflect.ValueOf((*runtime.GetHeapUsageReturns)(nil)),
+		"GetIsolateIDParams":                    reflect.ValueOf((*runtime.GetIsolateIDParams)(nil)),
+		"GetIsolateIDReturns":                   reflect.ValueOf((*runtime.GetIsolateIDReturns)(nil)),
+		"GetPropertiesParams":                   reflect.ValueOf((*runtime.GetPropertiesParams)(nil)),
+		"GetPropertiesReturns":                  reflect.ValueOf((*runtime.GetPropertiesReturns)(nil)),
+		"GlobalLexicalScopeNamesParams":         reflect.ValueOf((*runtime.GlobalLexicalScopeNamesParams)(nil)),
+		"GlobalLexicalScopeNamesReturns":        reflect.ValueOf((*runtime.GlobalLexicalScopeNamesReturns)(nil)),
+		"InternalPropertyDescriptor":            reflect.ValueOf((*runtime.InternalPropertyDescriptor)(nil)),
+		"ObjectPreview":                         reflect.ValueOf((*runtime.ObjectPreview)(nil)),
+		"PrivatePropertyDescriptor":             reflect.ValueOf((*runtime.PrivatePropertyDescriptor)(nil)),
+		"PropertyDescriptor":                    reflect.ValueOf((*runtime.PropertyDescriptor)(nil)),
+		"PropertyPreview":                       reflect.ValueOf((*runtime.PropertyPreview)(nil)),
+		"QueryObjectsParams":                    reflect.ValueOf((*runtime.QueryObjectsParams)(nil)),
+		"QueryObjectsReturns":                   reflect.ValueOf((*runtime.QueryObjectsReturns)(nil)),
+		"ReleaseObjectGroupParams":              reflect.ValueOf((*runtime.ReleaseObjectGroupParams)(nil)),
+		"ReleaseObjectParams":                   reflect.ValueOf((*runtime.ReleaseObjectParams)(nil)),
+		"RemoteObject":                          reflect.ValueOf((*runtime.RemoteObject)(nil)),
+		"RemoteObjectID":                        reflect.ValueOf((*runtime.RemoteObjectID)(nil)),
+		"RemoveBindingParams":                   reflect.ValueOf((*runtime.RemoveBindingParams)(nil)),
+		"RunIfWaitingForDebuggerParams":         reflect.ValueOf((*runtime.RunIfWaitingForDebuggerParams)(nil)),
+		"RunScriptParams":                       reflect.ValueOf((*runtime.RunScriptParams)(nil)),
+		"RunScriptReturns":                      reflect.ValueOf((*runtime.RunScriptReturns)(nil)),
+		"ScriptID":                              reflect.ValueOf((*runtime.ScriptID)(nil)),
+		"SetCustomObjectFormatterEnabledParams": reflect.ValueOf((*runtime.SetCustomObjectFormatterEnabledParams)(nil)),
+		"SetMaxCallStackSizeToCaptureParams":    reflect.ValueOf((*runtime.SetMaxCallStackSizeToCaptureParams)(nil)),
+		"StackTrace":                            reflect.ValueOf((*runtime.StackTrace)(nil)),
+		"StackTraceID":                          reflect.ValueOf((*runtime.StackTraceID)(nil)),
+		"Subtype":                               reflect.ValueOf((*runtime.Subtype)(nil)),
+		"TerminateExecutionParams":              reflect.ValueOf((*runtime.TerminateExecutionParams)(nil)),
+		"TimeDelta":                             reflect.ValueOf((*runtime.TimeDelta)(nil)),
+		"Timestamp":                             reflect.ValueOf((*runtime.Timestamp)(nil)),
+		"Type":                                  reflect.ValueOf((*runtime.Type)(nil)),
+		"UniqueDebuggerID":                      reflect.ValueOf((*runtime.UniqueDebuggerID)(nil)),
+		"UnserializableValue":                   reflect.ValueOf((*runtime.UnserializableValue)(nil)),
+	}
+}
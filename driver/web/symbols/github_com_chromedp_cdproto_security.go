@@ -0,0 +1,47 @@
+// Code generated by 'yaegi extract github.com/chromedp/cdproto/security'. DO NOT EDIT.
+
+package symbols
+
+import (
+	"github.com/chromedp/cdproto/security"
+	"go/constant"
+	"go/token"
+	"reflect"
+)
+
+func init() {
+	Symbols["github.com/chromedp/cdproto/security/security"] = map[string]reflect.Value{
+		// function, constant and variable definitions
+		"CertificateErrorActionCancel":        reflect.ValueOf(security.CertificateErrorActionCancel),
+		"CertificateErrorActionContinue":      reflect.ValueOf(security.CertificateErrorActionContinue),
+		"CommandDisable":                      reflect.ValueOf(constant.MakeFromLiteral("\"Security.disable\"", token.STRING, 0)),
+		"CommandEnable":                       reflect.ValueOf(constant.MakeFromLiteral("\"Security.enable\"", token.STRING, 0)),
+		"CommandSetIgnoreCertificateErrors":   reflect.ValueOf(constant.MakeFromLiteral("\"Security.setIgnoreCertificateErrors\"", token.STRING, 0)),
+		"Disable":                             reflect.ValueOf(security.Disable),
+		"Enable":                              reflect.ValueOf(security.Enable),
+		"MixedContentTypeBlockable":           reflect.ValueOf(security.MixedContentTypeBlockable),
+		"MixedContentTypeNone":                reflect.ValueOf(security.MixedContentTypeNone),
+		"MixedContentTypeOptionallyBlockable": reflect.ValueOf(security.MixedContentTypeOptionallyBlockable),
+		"SetIgnoreCertificateErrors":          reflect.ValueOf(security.SetIgnoreCertificateErrors),
+		"StateInfo":                           reflect.ValueOf(security.StateInfo),
+		"StateInsecure":                       reflect.ValueOf(security.StateInsecure),
+		"StateInsecureBroken":                 reflect.ValueOf(security.StateInsecureBroken),
+		"StateNeutral":                        reflect.ValueOf(security.StateNeutral),
+		"StateSecure":                         reflect.ValueOf(security.StateSecure),
+		"StateUnknown":                        reflect.ValueOf(security.StateUnknown),
+
+		// type definitions
+		"CertificateErrorAction":           reflect.ValueOf((*security.CertificateErrorAction)(nil)),
+		"CertificateID":                    reflect.ValueOf((*security.CertificateID)(nil)),
+		"CertificateSecurityState":         reflect.ValueOf((*security.CertificateSecurityState)(nil)),
+		"DisableParams":                    reflect.ValueOf((*security.DisableParams)(nil)),
+		"EnableParams":                     reflect.ValueOf((*security.EnableParams)(nil)),
+		"EventSecurityStateChanged":        reflect.ValueOf((*security.EventSecurityStateChanged)(nil)),
+		"EventVisibleSecurityStateChanged": reflect.ValueOf((*security.EventVisibleSecurityStateChanged)(nil)),
+		"MixedContentType":                 reflect.ValueOf((*security.MixedContentType)(nil)),
+		"SetIgnoreCertificateErrorsParams": reflect.ValueOf((*security.SetIgnoreCertificateErrorsParams)(nil)),
+		"State":                            reflect.ValueOf((*security.State)(nil)),
+		"StateExplanation":                 reflect.ValueOf((*security.StateExplanation)(nil)),
+		"VisibleSecurityState":             reflect.ValueOf((*security.VisibleSecurityState)(nil)),
+	}
+}
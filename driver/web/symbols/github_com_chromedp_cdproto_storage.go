@@ -0,0 +1,54 @@
+// Code generated by 'yaegi extract github.com/chromedp/cdproto/storage'. DO NOT EDIT.
+
+package symbols
+
+import (
+	"github.com/chromedp/cdproto/storage"
+	"go/constant"
+	"go/token"
+	"reflect"
+)
+
+func init() {
+	Symbols["github.com/chromedp/cdproto/storage/storage"] = map[string]reflect.Value{
+		// function, constant and variable definitions
+		"ClearDataForOrigin":                  reflect.ValueOf(storage.ClearDataForOrigin),
+		"CommandClearDataForOrigin":           reflect.ValueOf(constant.MakeFromLiteral("\"Storage.clearDataForOrigin\"", token.STRING, 0)),
+		"CommandGetUsageAndQuota":             reflect.ValueOf(constant.MakeFromLiteral("\"Storage.getUsageAndQuota\"", token.STRING, 0)),
+		"CommandTrackCacheStorageForOrigin":   reflect.ValueOf(constant.MakeFromLiteral("\"Storage.trackCacheStorageForOrigin\"", token.STRING, 0)),
+		"CommandTrackIndexedDBForOrigin":      reflect.ValueOf(constant.MakeFromLiteral("\"Storage.trackIndexedDBForOrigin\"", token.STRING, 0)),
+		"CommandUntrackCacheStorageForOrigin": reflect.ValueOf(constant.MakeFromLiteral("\"Storage.untrackCacheStorageForOrigin\"", token.STRING, 0)),
+		"CommandUntrackIndexedDBForOrigin":    reflect.ValueOf(constant.MakeFromLiteral("\"Storage.untrackIndexedDBForOrigin\"", token.STRING, 0)),
+		"GetUsageAndQuota":                    reflect.ValueOf(storage.GetUsageAndQuota),
+		"TrackCacheStorageForOrigin":          reflect.ValueOf(storage.TrackCacheStorageForOrigin),
+		"TrackIndexedDBForOrigin":             reflect.ValueOf(storage.TrackIndexedDBForOrigin),
+		"TypeAll":                             reflect.ValueOf(storage.TypeAll),
+		"TypeAppcache":                        reflect.ValueOf(storage.TypeAppcache),
+		"TypeCacheStorage":                    reflect.ValueOf(storage.TypeCacheStorage),
+		"TypeCookies":                         reflect.ValueOf(storage.TypeCookies),
+		"TypeFileSystems":                     reflect.ValueOf(storage.TypeFileSystems),
+		"TypeIndexeddb":                       reflect.ValueOf(storage.TypeIndexeddb),
+		"TypeLocalStorage":                    reflect.ValueOf(storage.TypeLocalStorage),
+		"TypeOther":                           reflect.ValueOf(storage.TypeOther),
+		"TypeServiceWorkers":                  reflect.ValueOf(storage.TypeServiceWorkers),
+		"TypeShaderCache":                     reflect.ValueOf(storage.TypeShaderCache),
+		"TypeWebsql":                          reflect.ValueOf(storage.TypeWebsql),
+		"UntrackCacheStorageForOrigin":        reflect.ValueOf(storage.UntrackCacheStorageForOrigin),
+		"UntrackIndexedDBForOrigin":           reflect.ValueOf(storage.UntrackIndexedDBForOrigin),
+
+		// type definitions
+		"ClearDataForOriginParams":           reflect.ValueOf((*storage.ClearDataForOriginParams)(nil)),
+		"EventCacheStorageContentUpdated":    reflect.ValueOf((*storage.EventCacheStorageContentUpdated)(nil)),
+		"EventCacheStorageListUpdated":       reflect.ValueOf((*storage.EventCacheStorageListUpdated)(nil)),
+		"EventIndexedDBContentUpdated":       reflect.ValueOf((*storage.EventIndexedDBContentUpdated)(nil)),
+		"EventIndexedDBListUpdated":          reflect.ValueOf((*storage.EventIndexedDBListUpdated)(nil)),
+		"GetUsageAndQuotaParams":             reflect.ValueOf((*storage.GetUsageAndQuotaParams)(nil)),
+		"GetUsageAndQuotaReturns":            reflect.ValueOf((*storage.GetUsageAndQuotaReturns)(nil)),
+		"TrackCacheStorageForOriginParams":   reflect.ValueOf((*storage.TrackCacheStorageForOriginParams)(nil)),
+		"TrackIndexedDBForOriginParams":      reflect.ValueOf((*storage.TrackIndexedDBForOriginParams)(nil)),
+		"Type":                               reflect.ValueOf((*storage.Type)(nil)),
+		"UntrackCacheStorageForOriginParams": reflect.ValueOf((*storage.UntrackCacheStorageForOriginParams)(nil)),
+		"UntrackIndexedDBForOriginParams":    reflect.ValueOf((*storage.UntrackIndexedDBForOriginParams)(nil)),
+		"UsageForType":                       reflect.ValueOf((*storage.UsageForType)(nil)),
+	}
+}
@@ -0,0 +1,83 @@
+// Code generated by 'yaegi extract github.com/chromedp/cdproto/target'. DO NOT EDIT.
+
+package symbols
+
+import (
+	"github.com/chromedp/cdproto/target"
+	"go/constant"
+	"go/token"
+	"reflect"
+)
+
+func init() {
+	Symbols["github.com/chromedp/cdproto/target/target"] = map[string]reflect.Value{
+		// function, constant and variable definitions
+		"ActivateTarget":                reflect.ValueOf(target.ActivateTarget),
+		"AttachToBrowserTarget":         reflect.ValueOf(target.AttachToBrowserTarget),
+		"AttachToTarget":                reflect.ValueOf(target.AttachToTarget),
+		"CloseTarget":                   reflect.ValueOf(target.CloseTarget),
+		"CommandActivateTarget":         reflect.ValueOf(constant.MakeFromLiteral("\"Target.activateTarget\"", token.STRING, 0)),
+		"CommandAttachToBrowserTarget":  reflect.ValueOf(constant.MakeFromLiteral("\"Target.attachToBrowserTarget\"", token.STRING, 0)),
+		"CommandAttachToTarget":         reflect.ValueOf(constant.MakeFromLiteral("\"Target.attachToTarget\"", token.STRING, 0)),
+		"CommandCloseTarget":            reflect.ValueOf(constant.MakeFromLiteral("\"Target.closeTarget\"", token.STRING, 0)),
+		"CommandCreateBrowserContext":   reflect.ValueOf(constant.MakeFromLiteral("\"Target.createBrowserContext\"", token.STRING, 0)),
+		"CommandCreateTarget":           reflect.ValueOf(constant.MakeFromLiteral("\"Target.createTarget\"", token.STRING, 0)),
+		"CommandDetachFromTarget":       reflect.ValueOf(constant.MakeFromLiteral("\"Target.detachFromTarget\"", token.STRING, 0)),
+		"CommandDisposeBrowserContext":  reflect.ValueOf(constant.MakeFromLiteral("\"Target.disposeBrowserContext\"", token.STRING, 0)),
+		"CommandExposeDevToolsProtocol": reflect.ValueOf(constant.MakeFromLiteral("\"Target.exposeDevToolsProtocol\"", token.STRING, 0)),
+		"CommandGetBrowserContexts":     reflect.ValueOf(constant.MakeFromLiteral("\"Target.getBrowserContexts\"", token.STRING, 0)),
+		"CommandGetTargetInfo":          reflect.ValueOf(constant.MakeFromLiteral("\"Target.getTargetInfo\"", token.STRING, 0)),
+		"CommandGetTargets":             reflect.ValueOf(constant.MakeFromLiteral("\"Target.getTargets\"", token.STRING, 0)),
+		"CommandSetAutoAttach":          reflect.ValueOf(constant.MakeFromLiteral("\"Target.setAutoAttach\"", token.STRING, 0)),
+		"CommandSetDiscoverTargets":     reflect.ValueOf(constant.MakeFromLiteral("\"Target.setDiscoverTargets\"", token.STRING, 0)),
+		"CommandSetRemoteLocations":     reflect.ValueOf(constant.MakeFromLiteral("\"Target.setRemoteLocations\"", token.STRING, 0)),
+		"CreateBrowserContext":          reflect.ValueOf(target.CreateBrowserContext),
+		"CreateTarget":                  reflect.ValueOf(target.CreateTarget),
+		"DetachFromTarget":              reflect.ValueOf(target.DetachFromTarget),
+		"DisposeBrowserContext":         reflect.ValueOf(target.DisposeBrowserContext),
+		"ExposeDevToolsProtocol":        reflect.ValueOf(target.ExposeDevToolsProtocol),
+		"GetBrowserContexts":            reflect.ValueOf(target.GetBrowserContexts),
+		"GetTargetInfo":                 reflect.ValueOf(target.GetTargetInfo),
+		"GetTargets":                    reflect.ValueOf(target.GetTargets),
+		"SetAutoAttach":                 reflect.ValueOf(target.SetAutoAttach),
+		"SetDiscoverTargets":            reflect.ValueOf(target.SetDiscoverTargets),
+		"SetRemoteLocations":            reflect.ValueOf(target.SetRemoteLocations),
+
+		// type definitions
+		"ActivateTargetParams":           reflect.ValueOf((*target.ActivateTargetParams)(nil)),
+		"AttachToBrowserTargetParams":    reflect.ValueOf((*target.AttachToBrowserTargetParams)(nil)),
+		"AttachToBrowserTargetReturns":   reflect.ValueOf((*target.AttachToBrowserTargetReturns)(nil)),
+		"AttachToTargetParams":           reflect.ValueOf((*target.AttachToTargetParams)(nil)),
+		"AttachToTargetReturns":          reflect.ValueOf((*target.AttachToTargetReturns)(nil)),
+		"BrowserContextID":               reflect.ValueOf((*target.BrowserContextID)(nil)),
+		"CloseTargetParams":              reflect.ValueOf((*target.CloseTargetParams)(nil)),
+		"CloseTargetReturns":             reflect.ValueOf((*target.CloseTargetReturns)(nil)),
+		"CreateBrowserContextParams":     reflect.ValueOf((*target.CreateBrowserContextParams)(nil)),
+		"CreateBrowserContextReturns":    reflect.ValueOf((*target.CreateBrowserContextReturns)(nil)),
+		"CreateTargetParams":             reflect.ValueOf((*target.CreateTargetParams)(nil)),
+		"CreateTargetReturns":            reflect.ValueOf((*target.CreateTargetReturns)(nil)),
+		"DetachFromTargetParams":         reflect.ValueOf((*target.DetachFromTargetParams)(nil)),
+		"DisposeBrowserContextParams":    reflect.ValueOf((*target.DisposeBrowserContextParams)(nil)),
+		"EventAttachedToTarget":          reflect.ValueOf((*target.EventAttachedToTarget)(nil)),
+		"EventDetachedFromTarget":        reflect.ValueOf((*target.EventDetachedFromTarget)(nil)),
+		"EventReceivedMessageFromTarget": reflect.ValueOf((*target.EventReceivedMessageFromTarget)(nil)),
+		"EventTargetCrashed":             reflect.ValueOf((*target.EventTargetCrashed)(nil)),
+		"EventTargetCreated":             reflect.ValueOf((*target.EventTargetCreated)(nil)),
+		"EventTargetDestroyed":           reflect.ValueOf((*target.EventTargetDestroyed)(nil)),
+		"EventTargetInfoChanged":         reflect.ValueOf((*target.EventTargetInfoChanged)(nil)),
+		"ExposeDevToolsProtocolParams":   reflect.ValueOf((*target.ExposeDevToolsProtocolParams)(nil)),
+		"GetBrowserContextsParams":       reflect.ValueOf((*target.GetBrowserContextsParams)(nil)),
+		"GetBrowserContextsReturns":      reflect.ValueOf((*target.GetBrowserContextsReturns)(nil)),
+		"GetTargetInfoParams":            reflect.ValueOf((*target.GetTargetInfoParams)(nil)),
+		"GetTargetInfoReturns":           reflect.ValueOf((*target.GetTargetInfoReturns)(nil)),
+		"GetTargetsParams":               reflect.ValueOf((*target.GetTargetsParams)(nil)),
+		"GetTargetsReturns":              reflect.ValueOf((*target.GetTargetsReturns)(nil)),
+		"ID":                             reflect.ValueOf((*target.ID)(nil)),
+		"Info":                           reflect.ValueOf((*target.Info)(nil)),
+		"RemoteLocation":                 reflect.ValueOf((*target.RemoteLocation)(nil)),
+		"SessionID":                      reflect.ValueOf((*target.SessionID)(nil)),
+		"SetAutoAttachParams":            reflect.ValueOf((*target.SetAutoAttachParams)(nil)),
+		"SetDiscoverTargetsParams":       reflect.ValueOf((*target.SetDiscoverTargetsParams)(nil)),
+		"SetRemoteLocationsParams":       reflect.ValueOf((*target.SetRemoteLocationsParams)(nil)),
+	}
+}
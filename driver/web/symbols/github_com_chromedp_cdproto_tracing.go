@@ -0,0 +1,54 @@
+// Code generated by 'yaegi extract github.com/chromedp/cdproto/tracing'. DO NOT EDIT.
+
+package symbols
+
+import (
+	"github.com/chromedp/cdproto/tracing"
+	"go/constant"
+	"go/token"
+	"reflect"
+)
+
+func init() {
+	Symbols["github.com/chromedp/cdproto/tracing/tracing"] = map[string]reflect.Value{
+		// function, constant and variable definitions
+		"CommandEnd":                       reflect.ValueOf(constant.MakeFromLiteral("\"Tracing.end\"", token.STRING, 0)),
+		"CommandGetCategories":             reflect.ValueOf(constant.MakeFromLiteral("\"Tracing.getCategories\"", token.STRING, 0)),
+		"CommandRecordClockSyncMarker":     reflect.ValueOf(constant.MakeFromLiteral("\"Tracing.recordClockSyncMarker\"", token.STRING, 0)),
+		"CommandRequestMemoryDump":         reflect.ValueOf(constant.MakeFromLiteral("\"Tracing.requestMemoryDump\"", token.STRING, 0)),
+		"CommandStart":                     reflect.ValueOf(constant.MakeFromLiteral("\"Tracing.start\"", token.STRING, 0)),
+		"End":                              reflect.ValueOf(tracing.End),
+		"GetCategories":                    reflect.ValueOf(tracing.GetCategories),
+		"RecordClockSyncMarker":            reflect.ValueOf(tracing.RecordClockSyncMarker),
+		"RecordModeEchoToConsole":          reflect.ValueOf(tracing.RecordModeEchoToConsole),
+		"RecordModeRecordAsMuchAsPossible": reflect.ValueOf(tracing.RecordModeRecordAsMuchAsPossible),
+		"RecordModeRecordContinuously":     reflect.ValueOf(tracing.RecordModeRecordContinuously),
+		"RecordModeRecordUntilFull":        reflect.ValueOf(tracing.RecordModeRecordUntilFull),
+		"RequestMemoryDump":                reflect.ValueOf(tracing.RequestMemoryDump),
+		"Start":                            reflect.ValueOf(tracing.Start),
+		"StreamCompressionGzip":            reflect.ValueOf(tracing.StreamCompressionGzip),
+		"StreamCompressionNone":            reflect.ValueOf(tracing.StreamCompressionNone),
+		"StreamFormatJSON":                 reflect.ValueOf(tracing.StreamFormatJSON),
+		"StreamFormatProto":                reflect.ValueOf(tracing.StreamFormatProto),
+		"TransferModeReportEvents":         reflect.ValueOf(tracing.TransferModeReportEvents),
+		"TransferModeReturnAsStream":       reflect.ValueOf(tracing.TransferModeReturnAsStream),
+
+		// type definitions
+		"EndParams":                   reflect.ValueOf((*tracing.EndParams)(nil)),
+		"EventBufferUsage":            reflect.ValueOf((*tracing.EventBufferUsage)(nil)),
+		"EventDataCollected":          reflect.ValueOf((*tracing.EventDataCollected)(nil)),
+		"EventTracingComplete":        reflect.ValueOf((*tracing.EventTracingComplete)(nil)),
+		"GetCategoriesParams":         reflect.ValueOf((*tracing.GetCategoriesParams)(nil)),
+		"GetCategoriesReturns":        reflect.ValueOf((*tracing.GetCategoriesReturns)(nil)),
+		"MemoryDumpConfig":            reflect.ValueOf((*tracing.MemoryDumpConfig)(nil)),
+		"RecordClockSyncMarkerParams": reflect.ValueOf((*tracing.RecordClockSyncMarkerParams)(nil)),
+		"RecordMode":                  reflect.ValueOf((*tracing.RecordMode)(nil)),
+		"RequestMemoryDumpParams":     reflect.ValueOf((*tracing.RequestMemoryDumpParams)(nil)),
+		"RequestMemoryDumpReturns":    reflect.ValueOf((*tracing.RequestMemoryDumpReturns)(nil)),
+		"StartParams":                 reflect.ValueOf((*tracing.StartParams)(nil)),
+		"StreamCompression":           reflect.ValueOf((*tracing.StreamCompression)(nil)),
+		"StreamFormat":                reflect.ValueOf((*tracing.StreamFormat)(nil)),
+		"TraceConfig":                 reflect.ValueOf((*tracing.TraceConfig)(nil)),
+		"TransferMode":                reflect.ValueOf((*tracing.TransferMode)(nil)),
+	}
+}
@@ -0,0 +1,89 @@
+// Code generated by 'yaegi extract github.com/chromedp/chromedp/device'. DO NOT EDIT.
+
+package symbols
+
+import (
+	"github.com/chromedp/chromedp/device"
+	"reflect"
+)
+
+func init() {
+	Symbols["github.com/chromedp/chromedp/device/device"] = map[string]reflect.Value{
+		// function, constant and variable definitions
+		"BlackBerryZ30":               reflect.ValueOf(device.BlackBerryZ30),
+		"BlackBerryZ30landscape":      reflect.ValueOf(device.BlackBerryZ30landscape),
+		"BlackberryPlayBook":          reflect.ValueOf(device.BlackberryPlayBook),
+		"BlackberryPlayBooklandscape": reflect.ValueOf(device.BlackberryPlayBooklandscape),
+		"GalaxyNote3":                 reflect.ValueOf(device.GalaxyNote3),
+		"GalaxyNote3landscape":        reflect.ValueOf(device.GalaxyNote3landscape),
+		"GalaxyNoteII":                reflect.ValueOf(device.GalaxyNoteII),
+		"GalaxyNoteIIlandscape":       reflect.ValueOf(device.GalaxyNoteIIlandscape),
+		"GalaxyS5":                    reflect.ValueOf(device.GalaxyS5),
+		"GalaxyS5landscape":           reflect.ValueOf(device.GalaxyS5landscape),
+		"GalaxySIII":                  reflect.ValueOf(device.GalaxySIII),
+		"GalaxySIIIlandscape":         reflect.ValueOf(device.GalaxySIIIlandscape),
+		"IPad":                        reflect.ValueOf(device.IPad),
+		"IPadMini":                    reflect.ValueOf(device.IPadMini),
+		"IPadMinilandscape":           reflect.ValueOf(device.IPadMinilandscape),
+		"IPadPro":                     reflect.ValueOf(device.IPadPro),
+		"IPadProlandscape":            reflect.ValueOf(device.IPadProlandscape),
+		"IPadlandscape":               reflect.ValueOf(device.IPadlandscape),
+		"IPhone4":                     reflect.ValueOf(device.IPhone4),
+		"IPhone4landscape":            reflect.ValueOf(device.IPhone4landscape),
+		"IPhone5":                     reflect.ValueOf(device.IPhone5),
+		"IPhone5landscape":            reflect.ValueOf(device.IPhone5landscape),
+		"IPhone6":                     reflect.ValueOf(device.IPhone6),
+		"IPhone6Plus":                 reflect.ValueOf(device.IPhone6Plus),
+		"IPhone6Pluslandscape":        reflect.ValueOf(device.IPhone6Pluslandscape),
+		"IPhone6landscape":            reflect.ValueOf(device.IPhone6landscape),
+		"IPhone7":                     reflect.ValueOf(device.IPhone7),
+		"IPhone7Plus":                 reflect.ValueOf(device.IPhone7Plus),
+		"IPhone7Pluslandscape":        reflect.ValueOf(device.IPhone7Pluslandscape),
+		"IPhone7landscape":            reflect.ValueOf(device.IPhone7landscape),
+		"IPhone8":                     reflect.ValueOf(device.IPhone8),
+		"IPhone8Plus":                 reflect.ValueOf(device.IPhone8Plus),
+		"IPhone8Pluslandscape":        reflect.ValueOf(device.IPhone8Pluslandscape),
+		"IPhone8landscape":            reflect.ValueOf(device.IPhone8landscape),
+		"IPhoneSE":                    reflect.ValueOf(device.IPhoneSE),
+		"IPhoneSElandscape":           reflect.ValueOf(device.IPhoneSElandscape),
+		"IPhoneX":                     reflect.ValueOf(device.IPhoneX),
+		"IPhoneXR":                    reflect.ValueOf(device.IPhoneXR),
+		"IPhoneXRlandscape":           reflect.ValueOf(device.IPhoneXRlandscape),
+		"IPhoneXlandscape":            reflect.ValueOf(device.IPhoneXlandscape),
+		"JioPhone2":                   reflect.ValueOf(device.JioPhone2),
+		"JioPhone2landscape":          reflect.ValueOf(device.JioPhone2landscape),
+		"KindleFireHDX":               reflect.ValueOf(device.KindleFireHDX),
+		"KindleFireHDXlandscape":      reflect.ValueOf(device.KindleFireHDXlandscape),
+		"LGOptimusL70":                reflect.ValueOf(device.LGOptimusL70),
+		"LGOptimusL70landscape":       reflect.ValueOf(device.LGOptimusL70landscape),
+		"MicrosoftLumia550":           reflect.ValueOf(device.MicrosoftLumia550),
+		"MicrosoftLumia950":           reflect.ValueOf(device.MicrosoftLumia950),
+		"MicrosoftLumia950landscape":  reflect.ValueOf(device.MicrosoftLumia950landscape),
+		"Nexus10":                     reflect.ValueOf(device.Nexus10),
+		"Nexus10landscape":            reflect.ValueOf(device.Nexus10landscape),
+		"Nexus4":                      reflect.ValueOf(device.Nexus4),
+		"Nexus4landscape":             reflect.ValueOf(device.Nexus4landscape),
+		"Nexus5":                      reflect.ValueOf(device.Nexus5),
+		"Nexus5X":                     reflect.ValueOf(device.Nexus5X),
+		"Nexus5Xlandscape":            reflect.ValueOf(device.Nexus5Xlandscape),
+		"Nexus5landscape":             reflect.ValueOf(device.Nexus5landscape),
+		"Nexus6":                      reflect.ValueOf(device.Nexus6),
+		"Nexus6P":                     reflect.ValueOf(device.Nexus6P),
+		"Nexus6Plandscape":            reflect.ValueOf(device.Nexus6Plandscape),
+		"Nexus6landscape":             reflect.ValueOf(device.Nexus6landscape),
+		"Nexus7":                      reflect.ValueOf(device.Nexus7),
+		"Nexus7landscape":             reflect.ValueOf(device.Nexus7landscape),
+		"NokiaLumia520":               reflect.ValueOf(device.NokiaLumia520),
+		"NokiaLumia520landscape":      reflect.ValueOf(device.NokiaLumia520landscape),
+		"NokiaN9":                     reflect.ValueOf(device.NokiaN9),
+		"NokiaN9landscape":            reflect.ValueOf(device.NokiaN9landscape),
+		"Pixel2":                      reflect.ValueOf(device.Pixel2),
+		"Pixel2XL":                    reflect.ValueOf(device.Pixel2XL),
+		"Pixel2XLlandscape":           reflect.ValueOf(device.Pixel2XLlandscape),
+		"Pixel2landscape":             reflect.ValueOf(device.Pixel2landscape),
+		"Reset":                       reflect.ValueOf(device.Reset),
+
+		// type definitions
+		"Info": reflect.ValueOf((*device.Info)(nil)),
+	}
+}
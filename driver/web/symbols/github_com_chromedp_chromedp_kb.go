@@ -0,0 +1,156 @@
+// Code generated by 'yaegi extract github.com/chromedp/chromedp/kb'. DO NOT EDIT.
+
+package symbols
+
+import (
+	"github.com/chromedp/chromedp/kb"
+	"go/constant"
+	"go/token"
+	"reflect"
+)
+
+func init() {
+	Symbols["github.com/chromedp/chromedp/kb/kb"] = map[string]reflect.Value{
+		// function, constant and variable definitions
+		"Again":                reflect.ValueOf(constant.MakeFromLiteral("\"Ԃ\"", token.STRING, 0)),
+		"Alt":                  reflect.ValueOf(constant.MakeFromLiteral("\"Ă\"", token.STRING, 0)),
+		"AppSwitch":            reflect.ValueOf(constant.MakeFromLiteral("\"ခ\"", token.STRING, 0)),
+		"ArrowDown":            reflect.ValueOf(constant.MakeFromLiteral("\"́\"", token.STRING, 0)),
+		"ArrowLeft":            reflect.ValueOf(constant.MakeFromLiteral("\"̂\"", token.STRING, 0)),
+		"ArrowRight":           reflect.ValueOf(constant.MakeFromLiteral("\"̃\"", token.STRING, 0)),
+		"ArrowUp":              reflect.ValueOf(constant.MakeFromLiteral("\"̄\"", token.STRING, 0)),
+		"AudioBassBoostToggle": reflect.ValueOf(constant.MakeFromLiteral("\"ข\"", token.STRING, 0)),
+		"AudioVolumeDown":      reflect.ValueOf(constant.MakeFromLiteral("\"ਏ\"", token.STRING, 0)),
+		"AudioVolumeMute":      reflect.ValueOf(constant.MakeFromLiteral("\"\\u0a11\"", token.STRING, 0)),
+		"AudioVolumeUp":        reflect.ValueOf(constant.MakeFromLiteral("\"ਐ\"", token.STRING, 0)),
+		"Backslash":            reflect.ValueOf(constant.MakeFromLiteral("\"\\\\\"", token.STRING, 0)),
+		"Backspace":            reflect.ValueOf(constant.MakeFromLiteral("\"\\b\"", token.STRING, 0)),
+		"BrightnessDown":       reflect.ValueOf(constant.MakeFromLiteral("\"\\u0601\"", token.STRING, 0)),
+		"BrightnessUp":         reflect.ValueOf(constant.MakeFromLiteral("\"\\u0602\"", token.STRING, 0)),
+		"BrowserBack":          reflect.ValueOf(constant.MakeFromLiteral("\"ఁ\"", token.STRING, 0)),
+		"BrowserFavorites":     reflect.ValueOf(constant.MakeFromLiteral("\"ం\"", token.STRING, 0)),
+		"BrowserForward":       reflect.ValueOf(constant.MakeFromLiteral("\"ః\"", token.STRING, 0)),
+		"BrowserHome":          reflect.ValueOf(constant.MakeFromLiteral("\"ఄ\"", token.STRING, 0)),
+		"BrowserRefresh":       reflect.ValueOf(constant.MakeFromLiteral("\"అ\"", token.STRING, 0)),
+		"BrowserSearch":        reflect.ValueOf(constant.MakeFromLiteral("\"ఆ\"", token.STRING, 0)),
+		"BrowserStop":          reflect.ValueOf(constant.MakeFromLiteral("\"ఇ\"", token.STRING, 0)),
+		"Cancel":               reflect.ValueOf(constant.MakeFromLiteral("\"Ԅ\"", token.STRING, 0)),
+		"CapsLock":             reflect.ValueOf(constant.MakeFromLiteral("\"Ą\"", token.STRING, 0)),
+		"ChannelDown":          reflect.ValueOf(constant.MakeFromLiteral("\"ഊ\"", token.STRING, 0)),
+		"ChannelUp":            reflect.ValueOf(constant.MakeFromLiteral("\"ഋ\"", token.STRING, 0)),
+		"Clear":                reflect.ValueOf(constant.MakeFromLiteral("\"Ё\"", token.STRING, 0)),
+		"Close":                reflect.ValueOf(constant.MakeFromLiteral("\"ਁ\"", token.STRING, 0)),
+		"ClosedCaptionToggle":  reflect.ValueOf(constant.MakeFromLiteral("\"ഒ\"", token.STRING, 0)),
+		"ContextMenu":          reflect.ValueOf(constant.MakeFromLiteral("\"ԅ\"", token.STRING, 0)),
+		"Control":              reflect.ValueOf(constant.MakeFromLiteral("\"ą\"", token.STRING, 0)),
+		"Convert":              reflect.ValueOf(constant.MakeFromLiteral("\"܅\"", token.STRING, 0)),
+		"Copy":                 reflect.ValueOf(constant.MakeFromLiteral("\"Ђ\"", token.STRING, 0)),
+		"Cut":                  reflect.ValueOf(constant.MakeFromLiteral("\"Є\"", token.STRING, 0)),
+		"Delete":               reflect.ValueOf(constant.MakeFromLiteral("\"\\x7f\"", token.STRING, 0)),
+		"Eject":                reflect.ValueOf(constant.MakeFromLiteral("\"\\u0604\"", token.STRING, 0)),
+		"Encode":               reflect.ValueOf(kb.Encode),
+		"EncodeUnidentified":   reflect.ValueOf(kb.EncodeUnidentified),
+		"End":                  reflect.ValueOf(constant.MakeFromLiteral("\"̅\"", token.STRING, 0)),
+		"Enter":                reflect.ValueOf(constant.MakeFromLiteral("\"\\r\"", token.STRING, 0)),
+		"Escape":               reflect.ValueOf(constant.MakeFromLiteral("\"\\x1b\"", token.STRING, 0)),
+		"Exit":                 reflect.ValueOf(constant.MakeFromLiteral("\"ക\"", token.STRING, 0)),
+		"F1":                   reflect.ValueOf(constant.MakeFromLiteral("\"ࠁ\"", token.STRING, 0)),
+		"F10":                  reflect.ValueOf(constant.MakeFromLiteral("\"ࠊ\"", token.STRING, 0)),
+		"F11":                  reflect.ValueOf(constant.MakeFromLiteral("\"ࠋ\"", token.STRING, 0)),
+		"F12":                  reflect.ValueOf(constant.MakeFromLiteral("\"ࠌ\"", token.STRING, 0)),
+		"F13":                  reflect.ValueOf(constant.MakeFromLiteral("\"ࠍ\"", token.STRING, 0)),
+		"F14":                  reflect.ValueOf(constant.MakeFromLiteral("\"ࠎ\"", token.STRING, 0)),
+		"F15":                  reflect.ValueOf(constant.MakeFromLiteral("\"ࠏ\"", token.STRING, 0)),
+		"F16":                  reflect.ValueOf(constant.MakeFromLiteral("\"ࠐ\"", token.STRING, 0)),
+		"F17":                  reflect.ValueOf(constant.MakeFromLiteral("\"ࠑ\"", token.STRING, 0)),
+		"F18":                  reflect.ValueOf(constant.MakeFromLiteral("\"ࠒ\"", token.STRING, 0)),
+		"F19":                  reflect.ValueOf(constant.MakeFromLiteral("\"ࠓ\"", token.STRING, 0)),
+		"F2":                   reflect.ValueOf(constant.MakeFromLiteral("\"ࠂ\"", token.STRING, 0)),
+		"F20":                  reflect.ValueOf(constant.MakeFromLiteral("\"ࠔ\"", token.STRING, 0)),
+		"F21":                  reflect.ValueOf(constant.MakeFromLiteral("\"ࠕ\"", token.STRING, 0)),
+		"F22":                  reflect.ValueOf(constant.MakeFromLiteral("\"ࠖ\"", token.STRING, 0)),
+		"F23":                  reflect.ValueOf(constant.MakeFromLiteral("\"ࠗ\"", token.STRING, 0)),
+		"F24":                  reflect.ValueOf(constant.MakeFromLiteral("\"࠘\"", token.STRING, 0)),
+		"F3":                   reflect.ValueOf(constant.MakeFromLiteral("\"ࠃ\"", token.STRING, 0)),
+		"F4":                   reflect.ValueOf(constant.MakeFromLiteral("\"ࠄ\"", token.STRING, 0)),
+		"F5":                   reflect.ValueOf(constant.MakeFromLiteral("\"ࠅ\"", token.STRING, 0)),
+		"F6":                   reflect.ValueOf(constant.MakeFromLiteral("\"ࠆ\"", token.STRING, 0)),
+		"F7":                   reflect.ValueOf(constant.MakeFromLiteral("\"ࠇ\"", token.STRING, 0)),
+		"F8":                   reflect.ValueOf(constant.MakeFromLiteral("\"ࠈ\"", token.STRING, 0)),
+		"F9":                   reflect.ValueOf(constant.MakeFromLiteral("\"ࠉ\"", token.STRING, 0)),
+		"Find":                 reflect.ValueOf(constant.MakeFromLiteral("\"ԇ\"", token.STRING, 0)),
+		"Fn":                   reflect.ValueOf(constant.MakeFromLiteral("\"Ć\"", token.STRING, 0)),
+		"FnLock":               reflect.ValueOf(constant.MakeFromLiteral("\"ć\"", token.STRING, 0)),
+		"Guide":                reflect.ValueOf(constant.MakeFromLiteral("\"ഢ\"", token.STRING, 0)),
+		"HangulMode":           reflect.ValueOf(constant.MakeFromLiteral("\"ܑ\"", token.STRING, 0)),
+		"HanjaMode":            reflect.ValueOf(constant.MakeFromLiteral("\"ܒ\"", token.STRING, 0)),
+		"Help":                 reflect.ValueOf(constant.MakeFromLiteral("\"Ԉ\"", token.STRING, 0)),
+		"Hiragana":             reflect.ValueOf(constant.MakeFromLiteral("\"ܖ\"", token.STRING, 0)),
+		"Home":                 reflect.ValueOf(constant.MakeFromLiteral("\"̆\"", token.STRING, 0)),
+		"Hyper":                reflect.ValueOf(constant.MakeFromLiteral("\"Ĉ\"", token.STRING, 0)),
+		"Info":                 reflect.ValueOf(constant.MakeFromLiteral("\"ഥ\"", token.STRING, 0)),
+		"Insert":               reflect.ValueOf(constant.MakeFromLiteral("\"Ї\"", token.STRING, 0)),
+		"KanaMode":             reflect.ValueOf(constant.MakeFromLiteral("\"ܘ\"", token.STRING, 0)),
+		"Katakana":             reflect.ValueOf(constant.MakeFromLiteral("\"ܚ\"", token.STRING, 0)),
+		"Keys":                 reflect.ValueOf(&kb.Keys).Elem(),
+		"LaunchApplication1":   reflect.ValueOf(constant.MakeFromLiteral("\"ଆ\"", token.STRING, 0)),
+		"LaunchApplication2":   reflect.ValueOf(constant.MakeFromLiteral("\"ଁ\"", token.STRING, 0)),
+		"LaunchAssistant":      reflect.ValueOf(constant.MakeFromLiteral("\"\\u0b0e\"", token.STRING, 0)),
+		"LaunchCalendar":       reflect.ValueOf(constant.MakeFromLiteral("\"ଂ\"", token.STRING, 0)),
+		"LaunchContacts":       reflect.ValueOf(constant.MakeFromLiteral("\"ଌ\"", token.STRING, 0)),
+		"LaunchMail":           reflect.ValueOf(constant.MakeFromLiteral("\"ଃ\"", token.STRING, 0)),
+		"LaunchMediaPlayer":    reflect.ValueOf(constant.MakeFromLiteral("\"\\u0b04\"", token.STRING, 0)),
+		"LaunchMusicPlayer":    reflect.ValueOf(constant.MakeFromLiteral("\"ଅ\"", token.STRING, 0)),
+		"LaunchPhone":          reflect.ValueOf(constant.MakeFromLiteral("\"\\u0b0d\"", token.STRING, 0)),
+		"LaunchScreenSaver":    reflect.ValueOf(constant.MakeFromLiteral("\"ଇ\"", token.STRING, 0)),
+		"LaunchSpreadsheet":    reflect.ValueOf(constant.MakeFromLiteral("\"ଈ\"", token.STRING, 0)),
+		"LaunchWebBrowser":     reflect.ValueOf(constant.MakeFromLiteral("\"ଉ\"", token.STRING, 0)),
+		"LogOff":               reflect.ValueOf(constant.MakeFromLiteral("\"\\u0605\"", token.STRING, 0)),
+		"MailForward":          reflect.ValueOf(constant.MakeFromLiteral("\"ਂ\"", token.STRING, 0)),
+		"MailReply":            reflect.ValueOf(constant.MakeFromLiteral("\"ਃ\"", token.STRING, 0)),
+		"MailSend":             reflect.ValueOf(constant.MakeFromLiteral("\"\\u0a04\"", token.STRING, 0)),
+		"MediaFastForward":     reflect.ValueOf(constant.MakeFromLiteral("\"ബ\"", token.STRING, 0)),
+		"MediaLast":            reflect.ValueOf(constant.MakeFromLiteral("\"ഭ\"", token.STRING, 0)),
+		"MediaPlay":            reflect.ValueOf(constant.MakeFromLiteral("\"യ\"", token.STRING, 0)),
+		"MediaPlayPause":       reflect.ValueOf(constant.MakeFromLiteral("\"ਅ\"", token.STRING, 0)),
+		"MediaRecord":          reflect.ValueOf(constant.MakeFromLiteral("\"ര\"", token.STRING, 0)),
+		"MediaRewind":          reflect.ValueOf(constant.MakeFromLiteral("\"റ\"", token.STRING, 0)),
+		"MediaStop":            reflect.ValueOf(constant.MakeFromLiteral("\"ਇ\"", token.STRING, 0)),
+		"MediaTrackNext":       reflect.ValueOf(constant.MakeFromLiteral("\"ਈ\"", token.STRING, 0)),
+		"MediaTrackPrevious":   reflect.ValueOf(constant.MakeFromLiteral("\"ਉ\"", token.STRING, 0)),
+		"Meta":                 reflect.ValueOf(constant.MakeFromLiteral("\"ĉ\"", token.STRING, 0)),
+		"ModeChange":           reflect.ValueOf(constant.MakeFromLiteral("\"܋\"", token.STRING, 0)),
+		"New":                  reflect.ValueOf(constant.MakeFromLiteral("\"ਊ\"", token.STRING, 0)),
+		"NonConvert":           reflect.ValueOf(constant.MakeFromLiteral("\"܍\"", token.STRING, 0)),
+		"NumLock":              reflect.ValueOf(constant.MakeFromLiteral("\"Ċ\"", token.STRING, 0)),
+		"Open":                 reflect.ValueOf(constant.MakeFromLiteral("\"\\u0a0b\"", token.STRING, 0)),
+		"PageDown":             reflect.ValueOf(constant.MakeFromLiteral("\"̇\"", token.STRING, 0)),
+		"PageUp":               reflect.ValueOf(constant.MakeFromLiteral("\"̈\"", token.STRING, 0)),
+		"Paste":                reflect.ValueOf(constant.MakeFromLiteral("\"Ј\"", token.STRING, 0)),
+		"Pause":                reflect.ValueOf(constant.MakeFromLiteral("\"ԉ\"", token.STRING, 0)),
+		"Power":                reflect.ValueOf(constant.MakeFromLiteral("\"؆\"", token.STRING, 0)),
+		"Print":                reflect.ValueOf(constant.MakeFromLiteral("\"\\u0a0c\"", token.STRING, 0)),
+		"PrintScreen":          reflect.ValueOf(constant.MakeFromLiteral("\"؈\"", token.STRING, 0)),
+		"Props":                reflect.ValueOf(constant.MakeFromLiteral("\"ԋ\"", token.STRING, 0)),
+		"Quote":                reflect.ValueOf(constant.MakeFromLiteral("\"'\"", token.STRING, 0)),
+		"Redo":                 reflect.ValueOf(constant.MakeFromLiteral("\"Љ\"", token.STRING, 0)),
+		"Save":                 reflect.ValueOf(constant.MakeFromLiteral("\"\\u0a0d\"", token.STRING, 0)),
+		"ScrollLock":           reflect.ValueOf(constant.MakeFromLiteral("\"Č\"", token.STRING, 0)),
+		"Select":               reflect.ValueOf(constant.MakeFromLiteral("\"Ԍ\"", token.STRING, 0)),
+		"Settings":             reflect.ValueOf(constant.MakeFromLiteral("\"ൃ\"", token.STRING, 0)),
+		"Shift":                reflect.ValueOf(constant.MakeFromLiteral("\"č\"", token.STRING, 0)),
+		"SpeechInputToggle":    reflect.ValueOf(constant.MakeFromLiteral("\"༂\"", token.STRING, 0)),
+		"SpellCheck":           reflect.ValueOf(constant.MakeFromLiteral("\"\\u0a0e\"", token.STRING, 0)),
+		"Super":                reflect.ValueOf(constant.MakeFromLiteral("\"Ď\"", token.STRING, 0)),
+		"Tab":                  reflect.ValueOf(constant.MakeFromLiteral("\"\\t\"", token.STRING, 0)),
+		"Undo":                 reflect.ValueOf(constant.MakeFromLiteral("\"Њ\"", token.STRING, 0)),
+		"WakeUp":               reflect.ValueOf(constant.MakeFromLiteral("\"؋\"", token.STRING, 0)),
+		"ZenkakuHankaku":       reflect.ValueOf(constant.MakeFromLiteral("\"ܝ\"", token.STRING, 0)),
+		"ZoomIn":               reflect.ValueOf(constant.MakeFromLiteral("\"ԍ\"", token.STRING, 0)),
+		"ZoomOut":              reflect.ValueOf(constant.MakeFromLiteral("\"Ԏ\"", token.STRING, 0)),
+		"ZoomToggle":           reflect.ValueOf(constant.MakeFromLiteral("\"ൎ\"", token.STRING, 0)),
+
+		// type definitions
+		"Key": reflect.ValueOf((*kb.Key)(nil)),
+	}
+}
@@ -0,0 +1,25 @@
+// Package symbols exports chromedp and the chrome devtools protocol packages
+// to the Go interpreter used by web driver scripts.
+package symbols
+
+/*
+   Copyright 2019 Bruno Moura <brunotm@gmail.com>
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+import "reflect"
+
+// Symbols are the chromedp and cdproto package symbols for the Go interpreter,
+// populated by the files generated with yaegi extract
+var Symbols = map[string]map[string]reflect.Value{}
@@ -30,9 +30,10 @@ import (
 
 // Transaction is a pre-compiled replicant transaction for web applications
 type Transaction struct {
-	driver  *Driver
-	program *runtime.Program
-	config  transaction.Config
+	driver    *Driver
+	program   *runtime.Program
+	config    transaction.Config
+	artifacts string
 }
 
 // Config returns the transaction config
@@ -42,25 +43,29 @@ func (t *Transaction) Config() (config transaction.Config) {
 
 // Run executes the web transaction
 func (t *Transaction) Run(ctx context.Context) (result transaction.Result) {
-	t.driver.m.RLock()
-	defer t.driver.m.RUnlock()
-
-	var err error
 	result.Name = t.config.Name
 	result.Driver = "web"
 	result.Metadata = t.config.Metadata
 
+	instance, err := t.driver.pool.acquire(ctx)
+	if err != nil {
+		result.Error = fmt.Errorf("driver/web: %w", err)
+		result.Failed = true
+		return result
+	}
+	defer func() { t.driver.pool.release(instance, result.Failed) }()
+
 	// handle browserless mode for testing
-	var drv *cdp.Driver
-	switch t.driver.config.testing {
-	case false:
-		drv = cdp.NewDriver(cdp.WithAddress(t.driver.config.ServerURL))
-	case true:
-		drv = cdp.NewDriver()
+	address := instance.address
+	if t.driver.config.testing {
+		address = cdp.DefaultAddress
 	}
-	defer drv.Close()
 
-	ctx = drivers.WithContext(ctx, drv, drivers.AsDefault())
+	// record the pages activity for capturing artifacts and metrics
+	rec := newRecorder(address)
+	defer rec.Close()
+
+	ctx = drivers.WithContext(ctx, rec, drivers.AsDefault())
 
 	// runtime.WithLog runtime.WithLogFields runtime.WithLogLevel
 	r, err := t.program.Run(ctx, runtime.WithLogLevel(logging.ErrorLevel))
@@ -78,5 +83,11 @@ func (t *Transaction) Run(ctx context.Context) (result transaction.Result) {
 		}
 	}
 
+	result.Metrics = addMetrics(result.Metrics, rec.metrics())
+
+	if t.artifacts == ArtifactsAlways || (t.artifacts == ArtifactsOnFailure && result.Failed) {
+		result.Artifacts = rec.artifacts(nil)
+	}
+
 	return result
 }
@@ -18,10 +18,13 @@ package elasticsearch
 */
 
 import (
+	"bytes"
 	"context"
 	"crypto/tls"
 	"fmt"
+	"math"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/Unbabel/replicant/transaction"
@@ -60,12 +63,47 @@ func (e *Emitter) Emit(result transaction.Result) {
 		OpType("create").
 		Index(e.index).
 		Id(result.UUID).
-		Doc(result)
+		Doc(newDocument(result))
 
 	e.bulkProcessor.Add(req)
 
 }
 
+// document is the indexed result. Metrics are always encoded as floating point numbers,
+// as dynamic mappings would otherwise map metrics first reported with whole values to integer fields.
+type document struct {
+	transaction.Result
+	Metrics map[string]metric `json:"metrics,omitempty"`
+}
+
+// metric is a result metric value
+type metric float64
+
+// MarshalJSON encodes the metric as a floating point number
+func (m metric) MarshalJSON() (data []byte, err error) {
+	data = strconv.AppendFloat(nil, float64(m), 'f', -1, 64)
+	if !bytes.ContainsRune(data, '.') {
+		data = append(data, ".0"...)
+	}
+	return data, nil
+}
+
+func newDocument(result transaction.Result) (d document) {
+	d.Result = result
+	for name, v := range result.Metrics {
+		// skip values that can't be represented in json
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			continue
+		}
+
+		if d.Metrics == nil {
+			d.Metrics = map[string]metric{}
+		}
+		d.Metrics[name] = metric(v)
+	}
+	return d
+}
+
 // New creates a new transaction.Result emmiter
 func New(config Config) (emitter *Emitter, err error) {
 	emitter = &Emitter{}
@@ -0,0 +1,39 @@
+package elasticsearch
+
+import (
+	"encoding/json"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/Unbabel/replicant/transaction"
+)
+
+func TestDocumentMetrics(t *testing.T) {
+	result := transaction.Result{Name: "web", Metrics: map[string]float64{
+		"web_cls": 0, "web_requests": 12, "web_lcp_seconds": 1.25, "web_invalid": math.NaN()}}
+
+	data, err := json.Marshal(newDocument(result))
+	if err != nil {
+		t.Fatalf("error serializing document: %s", err)
+	}
+
+	for _, expected := range []string{`"name":"web"`, `"web_cls":0.0`, `"web_requests":12.0`, `"web_lcp_seconds":1.25`} {
+		if !strings.Contains(string(data), expected) {
+			t.Fatalf("expected %s in document: %s", expected, data)
+		}
+	}
+
+	if strings.Contains(string(data), "web_invalid") {
+		t.Fatalf("unexpected invalid metric in document: %s", data)
+	}
+
+	var d map[string]interface{}
+	if err = json.Unmarshal(data, &d); err != nil {
+		t.Fatalf("error deserializing document: %s", err)
+	}
+
+	if len(d["metrics"].(map[string]interface{})) != 3 {
+		t.Fatalf("unexpected document metrics: %s", data)
+	}
+}
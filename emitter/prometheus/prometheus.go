@@ -20,9 +20,11 @@ package prometheus
 import (
 	"fmt"
 	"net/http"
-
+	"regexp"
 	"strings"
+	"sync"
 
+	"github.com/Unbabel/replicant/log"
 	"github.com/Unbabel/replicant/transaction"
 	"github.com/julienschmidt/httprouter"
 	"github.com/prometheus/client_golang/prometheus"
@@ -37,6 +39,8 @@ type Config struct {
 	Gauges            bool                `json:"gauges" yaml:"gauges"`
 	Summaries         bool                `json:"summaries" yaml:"summaries"`
 	SummaryObjectives map[float64]float64 `json:"summary_objectives" yaml:"summary_objectives"`
+	// Metrics exports the metrics reported in results by drivers as gauges named replicant_metric_<name>
+	Metrics bool `json:"metrics" yaml:"metrics"`
 }
 
 // DefaultConfig for prometheus config
@@ -44,6 +48,7 @@ var DefaultConfig = Config{
 	Path:              "/metrics",
 	Gauges:            true,
 	Summaries:         true,
+	Metrics:           true,
 	Labels:            []string{"transaction", "application", "environment", "component"},
 	SummaryObjectives: map[float64]float64{0.5: 0.05, 0.9: 0.01, 0.99: 0.001},
 }
@@ -57,8 +62,13 @@ type Emitter struct {
 	retriesGauge   *prometheus.GaugeVec
 	failuresGauge  *prometheus.GaugeVec
 	latencySummary *prometheus.SummaryVec
+	mtx            sync.Mutex
+	metrics        map[string]*prometheus.GaugeVec
 }
 
+// invalid characters for metric names
+var metricNameRx = regexp.MustCompile(`[^a-zA-Z0-9_]`)
+
 // Close emitter
 func (e *Emitter) Close() {}
 
@@ -82,6 +92,49 @@ func (e *Emitter) Emit(result transaction.Result) {
 	if e.config.Summaries {
 		e.latencySummary.With(result.Metadata).Observe(result.DurationSeconds)
 	}
+
+	if e.config.Metrics {
+		for name, value := range result.Metrics {
+			gauge, err := e.metric(name)
+			if err != nil {
+				log.Warn("error registering result metric").String("name", name).Error("error", err).Log()
+				continue
+			}
+			gauge.With(result.Metadata).Set(value)
+		}
+	}
+}
+
+// metric returns the gauge for the given result metric, registering it on first use
+func (e *Emitter) metric(name string) (gauge *prometheus.GaugeVec, err error) {
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+
+	name = metricNameRx.ReplaceAllString(name, "_")
+	if gauge, ok := e.metrics[name]; ok {
+		return gauge, nil
+	}
+
+	gauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "replicant",
+		Subsystem: "metric",
+		Name:      name,
+		Help:      "transaction result metric " + name},
+		e.config.Labels)
+
+	if err = prometheus.Register(gauge); err != nil {
+		are, ok := err.(prometheus.AlreadyRegisteredError)
+		if !ok {
+			return nil, err
+		}
+
+		if gauge, ok = are.ExistingCollector.(*prometheus.GaugeVec); !ok {
+			return nil, err
+		}
+	}
+
+	e.metrics[name] = gauge
+	return gauge, nil
 }
 
 // New creates a new transaction.Result emitter
@@ -93,6 +146,7 @@ func New(c Config, router *httprouter.Router) (emitter *Emitter, err error) {
 
 	emitter = &Emitter{}
 	emitter.config = c
+	emitter.metrics = make(map[string]*prometheus.GaugeVec)
 
 	emitter.runs = promauto.NewCounter(prometheus.CounterOpts{
 		Namespace: "replicant",
@@ -0,0 +1,44 @@
+package prometheus
+
+import (
+	"testing"
+
+	"github.com/Unbabel/replicant/transaction"
+	"github.com/julienschmidt/httprouter"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func TestEmitterMetrics(t *testing.T) {
+	e, err := New(DefaultConfig, httprouter.New())
+	if err != nil {
+		t.Fatalf("error creating emitter: %s", err)
+	}
+
+	metadata := map[string]string{
+		"transaction": "tls", "application": "replicant", "environment": "test", "component": "api"}
+
+	e.Emit(transaction.Result{Name: "tls", Metadata: metadata, Metrics: map[string]float64{"tls_expiry_days": 42}})
+	e.Emit(transaction.Result{Name: "tls", Metadata: metadata, Metrics: map[string]float64{"tls.expiry-days": 7}})
+
+	families, err := prometheus.DefaultGatherer.Gather()
+	if err != nil {
+		t.Fatalf("error gathering metrics: %s", err)
+	}
+
+	want := map[string]float64{"replicant_metric_tls_expiry_days": 7}
+	for _, f := range families {
+		value, ok := want[f.GetName()]
+		if !ok {
+			continue
+		}
+
+		if got := f.GetMetric()[0].GetGauge().GetValue(); got != value {
+			t.Errorf("%s = %f, want %f", f.GetName(), got, value)
+		}
+		delete(want, f.GetName())
+	}
+
+	if len(want) > 0 {
+		t.Errorf("metrics not found: %v", want)
+	}
+}
@@ -55,3 +55,6 @@ func (e *Emitter) Emit(result transaction.Result) {
 
 	fmt.Printf("%s\n", buf)
 }
+
+// Close is a no-op as results are written as they are emitted
+func (e *Emitter) Close() {}
@@ -0,0 +1,183 @@
+// Package stream implements a result emitter that fans out results to subscribers,
+// for streaming results to api clients.
+package stream
+
+/*
+   Copyright 2019 Bruno Moura <brunotm@gmail.com>
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+import (
+	"sync"
+
+	"github.com/Unbabel/replicant/transaction"
+)
+
+// Config options for the stream emitter
+type Config struct {
+	// History is the number of recent events kept for resuming subscriptions
+	History int `json:"history" yaml:"history"`
+
+	// Buffer is the number of pending events per subscriber. Subscribers that fall
+	// behind are closed and must resume from their last event id.
+	Buffer int `json:"buffer" yaml:"buffer"`
+}
+
+// DefaultConfig for the stream emitter
+var DefaultConfig = Config{History: 1024, Buffer: 64}
+
+// Event is a result with its stream sequence id
+type Event struct {
+	ID     uint64             `json:"id" yaml:"id"`
+	Result transaction.Result `json:"result" yaml:"result"`
+}
+
+// Filter for subscriptions. Empty fields are not used for filtering.
+type Filter struct {
+	// Name of the transaction
+	Name string `json:"name" yaml:"name"`
+
+	// Labels that must be present with the same values in the result metadata
+	Labels map[string]string `json:"labels" yaml:"labels"`
+}
+
+// Match checks if the given result matches this filter
+func (f Filter) Match(result transaction.Result) (ok bool) {
+	if f.Name != "" && f.Name != result.Name {
+		return false
+	}
+
+	for k, v := range f.Labels {
+		if lv, ok := result.Metadata[k]; !ok || lv != v {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Emitter fans out results to subscribers without blocking
+type Emitter struct {
+	mtx         sync.Mutex
+	config      Config
+	id          uint64
+	history     []Event
+	next        int
+	subscribers map[*Subscription]struct{}
+}
+
+// New creates a new stream emitter
+func New(c Config) (e *Emitter) {
+	if c.History <= 0 {
+		c.History = DefaultConfig.History
+	}
+
+	if c.Buffer <= 0 {
+		c.Buffer = DefaultConfig.Buffer
+	}
+
+	e = &Emitter{}
+	e.config = c
+	e.history = make([]Event, 0, c.History)
+	e.subscribers = make(map[*Subscription]struct{})
+	return e
+}
+
+// Emit results to all matching subscribers. Subscribers with full buffers are closed.
+func (e *Emitter) Emit(result transaction.Result) {
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+
+	e.id++
+	event := Event{ID: e.id, Result: result}
+
+	// keep a ring of the most recent events
+	if len(e.history) < e.config.History {
+		e.history = append(e.history, event)
+	} else {
+		e.history[e.next] = event
+		e.next = (e.next + 1) % e.config.History
+	}
+
+	for s := range e.subscribers {
+		if !s.filter.Match(result) {
+			continue
+		}
+
+		select {
+		case s.events <- event:
+		default:
+			e.remove(s)
+		}
+	}
+}
+
+// Subscribe to results matching the given filter. Recent matching events after
+// lastID are returned for resuming a previous subscription, a zero lastID returns none.
+func (e *Emitter) Subscribe(f Filter, lastID uint64) (s *Subscription, missed []Event) {
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+
+	// ids after a restart are lower than the last seen
+	if lastID > 0 && lastID < e.id {
+		for x := 0; x < len(e.history); x++ {
+			event := e.history[(e.next+x)%len(e.history)]
+			if event.ID > lastID && f.Match(event.Result) {
+				missed = append(missed, event)
+			}
+		}
+	}
+
+	s = &Subscription{emitter: e, filter: f, events: make(chan Event, e.config.Buffer)}
+	e.subscribers[s] = struct{}{}
+	return s, missed
+}
+
+// Close all subscriptions
+func (e *Emitter) Close() {
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+
+	for s := range e.subscribers {
+		e.remove(s)
+	}
+}
+
+// remove and close the subscription. Must be called with the lock held.
+func (e *Emitter) remove(s *Subscription) {
+	if _, ok := e.subscribers[s]; ok {
+		delete(e.subscribers, s)
+		close(s.events)
+	}
+}
+
+// Subscription to streamed results
+type Subscription struct {
+	emitter *Emitter
+	filter  Filter
+	events  chan Event
+}
+
+// Events returns the channel of events for this subscription. The channel is
+// closed when the subscription is closed or falls behind.
+func (s *Subscription) Events() (events <-chan Event) {
+	return s.events
+}
+
+// Close the subscription
+func (s *Subscription) Close() {
+	s.emitter.mtx.Lock()
+	defer s.emitter.mtx.Unlock()
+	s.emitter.remove(s)
+}
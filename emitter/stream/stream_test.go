@@ -0,0 +1,116 @@
+package stream
+
+import (
+	"testing"
+
+	"github.com/Unbabel/replicant/transaction"
+)
+
+func TestEmitterFilter(t *testing.T) {
+	e := New(Config{})
+	defer e.Close()
+
+	all, _ := e.Subscribe(Filter{}, 0)
+	byName, _ := e.Subscribe(Filter{Name: "a"}, 0)
+	byLabel, _ := e.Subscribe(Filter{Labels: map[string]string{"team": "x"}}, 0)
+
+	e.Emit(transaction.Result{Name: "a"})
+	e.Emit(transaction.Result{Name: "b", Metadata: map[string]string{"team": "x"}})
+	e.Emit(transaction.Result{Name: "c", Metadata: map[string]string{"team": "y"}})
+
+	tests := []struct {
+		name string
+		sub  *Subscription
+		want []string
+	}{
+		{name: "all", sub: all, want: []string{"a", "b", "c"}},
+		{name: "name", sub: byName, want: []string{"a"}},
+		{name: "label", sub: byLabel, want: []string{"b"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := names(tt.sub.Events(), len(tt.sub.events)); !equal(got, tt.want) {
+				t.Errorf("events = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEmitterResume(t *testing.T) {
+	e := New(Config{History: 3})
+	defer e.Close()
+
+	for _, name := range []string{"a", "b", "c", "d", "e"} {
+		e.Emit(transaction.Result{Name: name})
+	}
+
+	tests := []struct {
+		name   string
+		lastID uint64
+		want   []string
+	}{
+		{name: "new subscription", lastID: 0, want: nil},
+		{name: "within history", lastID: 3, want: []string{"d", "e"}},
+		{name: "beyond history", lastID: 1, want: []string{"c", "d", "e"}},
+		{name: "up to date", lastID: 5, want: nil},
+		{name: "after restart", lastID: 10, want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, missed := e.Subscribe(Filter{}, tt.lastID)
+			defer s.Close()
+
+			var got []string
+			for _, event := range missed {
+				got = append(got, event.Result.Name)
+			}
+
+			if !equal(got, tt.want) {
+				t.Errorf("missed = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEmitterSlowSubscriber(t *testing.T) {
+	e := New(Config{Buffer: 2})
+	defer e.Close()
+
+	slow, _ := e.Subscribe(Filter{}, 0)
+	for x := 0; x < 3; x++ {
+		e.Emit(transaction.Result{Name: "a"})
+	}
+
+	count := 0
+	for range slow.Events() {
+		count++
+	}
+
+	if count != 2 {
+		t.Errorf("received %d events before close, want 2", count)
+	}
+
+	// closing a removed subscription must not panic
+	slow.Close()
+}
+
+func names(events <-chan Event, n int) (s []string) {
+	for x := 0; x < n; x++ {
+		s = append(s, (<-events).Result.Name)
+	}
+	return s
+}
+
+func equal(a, b []string) (ok bool) {
+	if len(a) != len(b) {
+		return false
+	}
+	for x := range a {
+		if a[x] != b[x] {
+			return false
+		}
+	}
+	return true
+}
@@ -1,14 +1,20 @@
 module github.com/Unbabel/replicant
 
-go 1.13
+go 1.20
 
 require (
 	github.com/MontFerret/ferret v0.9.1-0.20191122143658-aa81df38d4a2
 	github.com/aws/aws-sdk-go v1.28.9
 	github.com/brunotm/log v0.3.2
-	github.com/containous/yaegi v0.8.14
+	github.com/chromedp/cdproto v0.0.0-20191114225735-6626966fbae4
+	github.com/chromedp/chromedp v0.5.2
+	github.com/dop251/goja v0.0.0-20230122112309-96b1610dd4f7
+	github.com/golang/protobuf v1.3.2
+	github.com/gorilla/websocket v1.4.1
+	github.com/jhump/protoreflect v1.6.0
 	github.com/julienschmidt/httprouter v1.3.0
-	github.com/kr/pretty v0.2.0 // indirect
+	github.com/mafredri/cdp v0.24.2
+	github.com/mailru/easyjson v0.7.1
 	github.com/olivere/elastic/v7 v7.0.8
 	github.com/prometheus/client_golang v1.2.1
 	github.com/robertkrimen/otto v0.0.0-20180617131154-15f95af6e78d
@@ -16,6 +22,40 @@ require (
 	github.com/segmentio/ksuid v1.0.2
 	github.com/spf13/cobra v0.0.6
 	github.com/syndtr/goleveldb v1.0.0
+	github.com/traefik/yaegi v0.15.1
+	golang.org/x/crypto v0.0.0-20200128174031-69ecbb4d6d5d
+	golang.org/x/net v0.0.0-20190827160401-ba9fcec4b297
+	google.golang.org/grpc v1.27.0
+	gopkg.in/yaml.v2 v2.4.0
+)
+
+require (
+	github.com/PuerkitoBio/goquery v1.5.0 // indirect
+	github.com/andybalholm/cascadia v1.0.0 // indirect
+	github.com/antlr/antlr4 v0.0.0-20191005235324-c81b4e69b6c3 // indirect
+	github.com/beorn7/perks v1.0.1 // indirect
+	github.com/cespare/xxhash/v2 v2.1.0 // indirect
+	github.com/corpix/uarand v0.1.1 // indirect
+	github.com/dlclark/regexp2 v1.7.0 // indirect
+	github.com/go-sourcemap/sourcemap v2.1.3+incompatible // indirect
+	github.com/gobwas/httphead v0.0.0-20180130184737-2c6c146eadee // indirect
+	github.com/gobwas/pool v0.2.0 // indirect
+	github.com/gobwas/ws v1.0.2 // indirect
+	github.com/golang/snappy v0.0.0-20180518054509-2e65f85255db // indirect
+	github.com/gorilla/css v1.0.0 // indirect
+	github.com/inconshreveable/mousetrap v1.0.0 // indirect
+	github.com/jmespath/go-jmespath v0.0.0-20180206201540-c2b33e8439af // indirect
+	github.com/knq/sysutil v0.0.0-20191005231841-15668db23d08 // indirect
+	github.com/matttproud/golang_protobuf_extensions v1.0.1 // indirect
+	github.com/pkg/errors v0.8.1 // indirect
+	github.com/prometheus/client_model v0.0.0-20190812154241-14fe0d1b01d4 // indirect
+	github.com/prometheus/common v0.7.0 // indirect
+	github.com/prometheus/procfs v0.0.5 // indirect
+	github.com/rs/zerolog v1.17.2 // indirect
+	github.com/spf13/pflag v1.0.3 // indirect
+	golang.org/x/sync v0.0.0-20190423024810-112230192c58 // indirect
+	golang.org/x/sys v0.0.0-20191113165036-4c7a9d0fe056 // indirect
+	golang.org/x/text v0.3.7 // indirect
+	google.golang.org/genproto v0.0.0-20190819201941-24fa4b261c55 // indirect
 	gopkg.in/sourcemap.v1 v1.0.5 // indirect
-	gopkg.in/yaml.v2 v2.2.4
 )
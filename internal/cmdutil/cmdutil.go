@@ -41,3 +41,25 @@ func GetFlagDuration(cmd *cobra.Command, name string) (v time.Duration) {
 
 	return v
 }
+
+// GetFlagInt fetches the named flag from cmd and fails on error
+func GetFlagInt(cmd *cobra.Command, name string) (v int) {
+	v, err := cmd.Flags().GetInt(name)
+	if err != nil {
+		fmt.Printf("error accessing flag %s for command %s: %s", name, cmd.Name(), err)
+		os.Exit(1)
+	}
+
+	return v
+}
+
+// GetFlagStringSlice fetches the named flag from cmd and fails on error
+func GetFlagStringSlice(cmd *cobra.Command, name string) (v []string) {
+	v, err := cmd.Flags().GetStringSlice(name)
+	if err != nil {
+		fmt.Printf("error accessing flag %s for command %s: %s", name, cmd.Name(), err)
+		os.Exit(1)
+	}
+
+	return v
+}
@@ -18,6 +18,7 @@ type callbackProxy struct {
 	client       *http.Client
 	serverURL    string
 	advertiseURL string
+	secret       string
 }
 
 func (c *callbackProxy) Listen(ctx context.Context) (h *callback.Handle, err error) {
@@ -39,6 +40,10 @@ func (c *callbackProxy) Listen(ctx context.Context) (h *callback.Handle, err err
 		return nil, err
 	}
 
+	if c.secret != "" {
+		req.Header.Set("Authorization", "Bearer "+c.secret)
+	}
+
 	go func() {
 		resp, err := c.client.Do(req)
 		if err != nil {
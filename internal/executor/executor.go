@@ -3,13 +3,22 @@ package executor
 
 import (
 	"context"
+	"crypto/subtle"
+	"crypto/tls"
 	"fmt"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/Unbabel/replicant/driver"
+	dnsdriver "github.com/Unbabel/replicant/driver/dns"
 	godriver "github.com/Unbabel/replicant/driver/go"
+	grpcdriver "github.com/Unbabel/replicant/driver/grpc"
+	httpdriver "github.com/Unbabel/replicant/driver/http"
 	"github.com/Unbabel/replicant/driver/javascript"
+	"github.com/Unbabel/replicant/driver/js2"
+	tcpdriver "github.com/Unbabel/replicant/driver/tcp"
+	tlsdriver "github.com/Unbabel/replicant/driver/tls"
 	"github.com/Unbabel/replicant/driver/web"
 	"github.com/Unbabel/replicant/internal/xz"
 	"github.com/Unbabel/replicant/transaction"
@@ -18,6 +27,7 @@ import (
 // Executor is the replicant execution service
 type Executor struct {
 	config  Config
+	client  *http.Client
 	drivers *xz.Map
 }
 
@@ -26,6 +36,19 @@ type Config struct {
 	Web          web.Config
 	ServerURL    string
 	AdvertiseURL string
+
+	// ExecutorURL is the url for reaching this executor, sent to the server on registration
+	ExecutorURL string
+
+	// Secret shared with the replicant server, used as a bearer token on both directions
+	Secret string
+
+	// ServerTLS config for reaching the replicant server over https
+	ServerTLS *tls.Config
+
+	// JSModulesDir is a directory with shared javascript modules for js2 transactions.
+	// If empty, modules are fetched from the replicant server.
+	JSModulesDir string
 }
 
 // New creates a new executor
@@ -33,30 +56,109 @@ func New(c Config) (e *Executor, err error) {
 	e = &Executor{}
 	e.config = c
 	e.drivers = xz.NewMap()
+	e.client = &http.Client{}
+	if c.ServerTLS != nil {
+		e.client.Transport = &http.Transport{TLSClientConfig: c.ServerTLS}
+	}
 
-	var drv driver.Driver
-
+	var wd driver.Driver
 	if c.Web.BinaryPath != "" || c.Web.ServerURL != "" {
-		drv, err = web.New(c.Web)
-		if err != nil {
+		if wd, err = web.New(c.Web); err != nil {
 			return nil, err
 		}
-		e.drivers.Store(drv.Type(), drv)
 	}
 
-	drv, err = javascript.New()
+	drivers, err := newDrivers(wd, e.modules())
 	if err != nil {
 		return nil, err
 	}
-	e.drivers.Store(drv.Type(), drv)
 
-	drv, err = godriver.New()
-	if err != nil {
-		return nil, err
+	for _, d := range drivers {
+		e.drivers.Store(d.Type(), d)
+	}
+
+	return e, nil
+}
+
+// Checkers returns the transaction drivers for checking transactions without running them.
+// No chrome instances are used and no js2 modules are loaded.
+func Checkers() (drivers []driver.Driver, err error) {
+	return newDrivers(web.NewChecker(), nil)
+}
+
+// newDrivers creates the transaction drivers, including the given web driver if not nil
+func newDrivers(wd driver.Driver, modules js2.ModuleLoader) (drivers []driver.Driver, err error) {
+	if wd != nil {
+		drivers = append(drivers, wd)
+	}
+
+	for _, fn := range []func() (driver.Driver, error){
+		func() (driver.Driver, error) { return javascript.New() },
+		func() (driver.Driver, error) { return js2.New(js2.Config{Modules: modules}) },
+		godriver.New,
+		grpcdriver.New,
+		httpdriver.New,
+		tlsdriver.New,
+		dnsdriver.New,
+		tcpdriver.New,
+	} {
+		d, err := fn()
+		if err != nil {
+			return nil, err
+		}
+		drivers = append(drivers, d)
+	}
+
+	return drivers, nil
+}
+
+// Executor health statuses
+const (
+	StatusOK       = "ok"
+	StatusDegraded = "degraded"
+)
+
+// Health is the state of the executor and its drivers
+type Health struct {
+	Status string      `json:"status"`
+	Web    *web.Health `json:"web,omitempty"`
+}
+
+// Health returns the state of this executor. The status is degraded
+// while the web driver has no chrome instances serving transactions.
+func (e *Executor) Health() (h Health) {
+	h.Status = StatusOK
+
+	if d, ok := e.drivers.Load("web"); ok {
+		wh := d.(*web.Driver).Health()
+		h.Web = &wh
+		if !wh.Healthy {
+			h.Status = StatusDegraded
+		}
+	}
+
+	return h
+}
+
+// Drivers returns the transaction drivers available in this executor
+func (e *Executor) Drivers() (drivers []driver.Driver) {
+	e.drivers.Range(func(_, d interface{}) bool {
+		drivers = append(drivers, d.(driver.Driver))
+		return true
+	})
+
+	return drivers
+}
+
+// Authorized checks if the request carries the shared secret as a bearer token.
+// All requests are authorized when no secret is configured.
+func (e *Executor) Authorized(r *http.Request) (ok bool) {
+	if e.config.Secret == "" {
+		return true
 	}
-	e.drivers.Store(drv.Type(), drv)
 
-	return e, err
+	token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	return subtle.ConstantTimeCompare([]byte(token), []byte(e.config.Secret)) == 1
 }
 
 // Run the given transaction
@@ -96,10 +198,11 @@ func (e *Executor) Run(uuid string, c transaction.Config) (r transaction.Result,
 	if c.CallBack != nil {
 		listener := &callbackProxy{}
 		listener.uuid = uuid
-		listener.client = &http.Client{}
+		listener.client = e.client
 		listener.config = c
 		listener.serverURL = e.config.ServerURL
 		listener.advertiseURL = e.config.AdvertiseURL
+		listener.secret = e.config.Secret
 		ctx = context.WithValue(ctx, c.CallBack.Type, listener)
 	}
 
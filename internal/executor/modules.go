@@ -0,0 +1,62 @@
+package executor
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/Unbabel/replicant/driver/js2"
+)
+
+// moduleTimeout for fetching modules from the replicant server
+const moduleTimeout = 30 * time.Second
+
+// modules returns the loader for shared javascript modules required by js2 transactions.
+// Modules are loaded from the local modules directory if configured, or from the replicant server.
+func (e *Executor) modules() (loader js2.ModuleLoader) {
+	switch {
+	case e.config.JSModulesDir != "":
+		return js2.DirLoader(e.config.JSModulesDir)
+	case e.config.ServerURL != "":
+		return e.serverModule
+	default:
+		return nil
+	}
+}
+
+// serverModule fetches the named module source from the replicant server
+func (e *Executor) serverModule(ctx context.Context, name string) (source string, err error) {
+	if name, err = js2.ModuleName(name); err != nil {
+		return "", err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, moduleTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, e.config.ServerURL+"/api/v1/module/"+name, nil)
+	if err != nil {
+		return "", fmt.Errorf("replicant-executor: error creating module request: %w", err)
+	}
+	if e.config.Secret != "" {
+		req.Header.Set("Authorization", "Bearer "+e.config.Secret)
+	}
+
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("replicant-executor: error fetching module %s: %w", name, err)
+	}
+	defer resp.Body.Close()
+
+	buf, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("replicant-executor: error reading module %s: %w", name, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("replicant-executor: error fetching module %s: %s: %s", name, resp.Status, buf)
+	}
+
+	return string(buf), nil
+}
@@ -0,0 +1,89 @@
+package executor
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/Unbabel/replicant/log"
+	"github.com/Unbabel/replicant/manager/registration"
+)
+
+// DriverTypes returns the types of the transaction drivers available in this executor
+func (e *Executor) DriverTypes() (types []string) {
+	for _, d := range e.Drivers() {
+		types = append(types, d.Type())
+	}
+
+	sort.Strings(types)
+	return types
+}
+
+// Register this executor with the replicant server and keep sending registrations
+// as heartbeats at every interval until the given context is done.
+func (e *Executor) Register(ctx context.Context, interval time.Duration) {
+	client := &http.Client{Timeout: interval, Transport: e.client.Transport}
+	reg := registration.Registration{URL: e.config.ExecutorURL, Drivers: e.DriverTypes()}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	registered := false
+	for {
+		err := e.register(ctx, client, reg)
+		switch {
+		case err != nil && registered:
+			log.Warn("error sending executor heartbeat").
+				String("server_url", e.config.ServerURL).Error("error", err).Log()
+		case err != nil:
+			log.Warn("error registering executor").
+				String("server_url", e.config.ServerURL).Error("error", err).Log()
+		case !registered:
+			log.Info("executor registered").String("server_url", e.config.ServerURL).
+				String("url", reg.URL).Log()
+		}
+		registered = err == nil
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// register sends a single registration to the replicant server
+func (e *Executor) register(ctx context.Context, client *http.Client, reg registration.Registration) (err error) {
+	buf, err := json.Marshal(&reg)
+	if err != nil {
+		return fmt.Errorf("replicant-executor: error serializing registration: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx,
+		http.MethodPost, e.config.ServerURL+"/api/v1/executors", bytes.NewReader(buf))
+	if err != nil {
+		return fmt.Errorf("replicant-executor: error creating registration request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if e.config.Secret != "" {
+		req.Header.Set("Authorization", "Bearer "+e.config.Secret)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("replicant-executor: error sending registration: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		buf, _ = ioutil.ReadAll(resp.Body)
+		return fmt.Errorf("replicant-executor: registration failed: %s: %s", resp.Status, buf)
+	}
+
+	return nil
+}
@@ -0,0 +1,105 @@
+// Package jsonpath implements a simple subset of JSONPath for selecting values
+// from decoded json documents, eg: $.items[0].name or items.0.name.
+package jsonpath
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Decode a json document for lookups, keeping numbers as json.Number
+func Decode(buf []byte) (data interface{}, err error) {
+	decoder := json.NewDecoder(bytes.NewReader(buf))
+	decoder.UseNumber()
+
+	if err = decoder.Decode(&data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// Lookup the value at the given path within the decoded json data. Paths are dot
+// separated keys optionally starting with $, with array indexes either as keys or in brackets.
+func Lookup(data interface{}, path string) (v interface{}, ok bool) {
+	keys, err := split(path)
+	if err != nil {
+		return nil, false
+	}
+
+	v = data
+	for _, key := range keys {
+		switch current := v.(type) {
+		case map[string]interface{}:
+			if v, ok = current[key]; !ok {
+				return nil, false
+			}
+		case []interface{}:
+			idx, err := strconv.Atoi(key)
+			if err != nil || idx < 0 || idx >= len(current) {
+				return nil, false
+			}
+			v = current[idx]
+		default:
+			return nil, false
+		}
+	}
+
+	return v, true
+}
+
+// Format a json value as a string for comparisons. Strings are not quoted,
+// numbers keep their original representation and objects and arrays are json encoded.
+func Format(value interface{}) (s string) {
+	switch v := value.(type) {
+	case nil:
+		return "null"
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	case map[string]interface{}, []interface{}:
+		buf, _ := json.Marshal(v)
+		return string(buf)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// split a path into its keys
+func split(path string) (keys []string, err error) {
+	path = strings.TrimPrefix(path, "$")
+	path = strings.TrimPrefix(path, ".")
+	if path == "" {
+		return nil, nil
+	}
+
+	for _, part := range strings.Split(path, ".") {
+		// handle bracketed indexes, eg: items[0][1]
+		for {
+			idx := strings.Index(part, "[")
+			if idx < 0 {
+				break
+			}
+
+			end := strings.Index(part, "]")
+			if end < idx {
+				return nil, fmt.Errorf("jsonpath: invalid path %s", path)
+			}
+
+			if idx > 0 {
+				keys = append(keys, part[:idx])
+			}
+			keys = append(keys, strings.Trim(part[idx+1:end], `'"`))
+			part = part[end+1:]
+		}
+
+		if part != "" {
+			keys = append(keys, part)
+		}
+	}
+
+	return keys, nil
+}
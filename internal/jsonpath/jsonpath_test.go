@@ -0,0 +1,39 @@
+package jsonpath
+
+import "testing"
+
+func TestLookup(t *testing.T) {
+	data, err := Decode([]byte(`{"a": {"b": [{"c": 1.50}, {"c": "x"}], "d": null}, "e": [[true]]}`))
+	if err != nil {
+		t.Fatalf("Decode() error = %s", err)
+	}
+
+	tests := []struct {
+		path  string
+		want  string
+		found bool
+	}{
+		{path: "$.a.b[0].c", want: "1.50", found: true},
+		{path: "a.b.1.c", want: "x", found: true},
+		{path: "$['a'].d", want: "null", found: true},
+		{path: "$.e[0][0]", want: "true", found: true},
+		{path: "$.a.b[0]", want: `{"c":1.50}`, found: true},
+		{path: "$.a.b[2]", found: false},
+		{path: "$.a.x", found: false},
+		{path: "$.a.b.c", found: false},
+		{path: "$.a[0", found: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			v, found := Lookup(data, tt.path)
+			if found != tt.found {
+				t.Fatalf("Lookup() found = %t, want %t", found, tt.found)
+			}
+
+			if got := Format(v); found && got != tt.want {
+				t.Fatalf("Lookup() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
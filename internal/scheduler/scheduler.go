@@ -51,9 +51,10 @@ type Entry struct {
 
 // Scheduler for transactions
 type Scheduler struct {
-	mtx   sync.Mutex
-	cron  *cron.Cron
-	tasks map[string]Entry
+	mtx     sync.Mutex
+	cron    *cron.Cron
+	tasks   map[string]Entry
+	running map[string]chan struct{}
 }
 
 // New creates a new scheduler
@@ -61,6 +62,7 @@ func New() (scheduler *Scheduler) {
 
 	scheduler = &Scheduler{}
 	scheduler.tasks = make(map[string]Entry)
+	scheduler.running = make(map[string]chan struct{})
 
 	scheduler.cron = cron.New(
 		cron.WithLogger(cron.DefaultLogger),
@@ -72,6 +74,14 @@ func New() (scheduler *Scheduler) {
 	return scheduler
 }
 
+// ParseSchedule checks if the given schedule spec is valid
+func ParseSchedule(schedule string) (err error) {
+	if _, err = cron.ParseStandard(schedule); err != nil {
+		return fmt.Errorf("scheduler: invalid schedule: %w", err)
+	}
+	return nil
+}
+
 // Start the scheduler
 func (s *Scheduler) Start() {
 	s.cron.Start()
@@ -95,7 +105,8 @@ func (s *Scheduler) AddTask(name, schedule string, task Task) (err error) {
 	// workaround the bug in robfig/cron which led to skipping
 	// all-1 tasks added at the same time.
 	// https://github.com/robfig/cron/pull/263
-	task = skipIfStillRunning(name, task)
+	running := newRunning()
+	task = skipIfStillRunning(name, running, task)
 
 	var id cron.EntryID
 	if id, err = s.cron.AddJob(schedule, task); err != nil {
@@ -103,6 +114,7 @@ func (s *Scheduler) AddTask(name, schedule string, task Task) (err error) {
 	}
 
 	s.tasks[name] = Entry{Name: name, ID: int(id), Schedule: schedule}
+	s.running[name] = running
 
 	return nil
 }
@@ -112,6 +124,40 @@ func (s *Scheduler) AddTaskFunc(name, schedule string, task func()) (err error)
 	return s.AddTask(name, schedule, TaskFunc(task))
 }
 
+// UpdateTask atomically replaces an existing task in the scheduler with the given
+// schedule and task, or adds it if it does not exist. The existing task is kept
+// if the new one cannot be scheduled. The new task is skipped while a run of the
+// existing one is still in progress.
+func (s *Scheduler) UpdateTask(name, schedule string, task Task) (err error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	running, ok := s.running[name]
+	if !ok {
+		running = newRunning()
+	}
+	task = skipIfStillRunning(name, running, task)
+
+	var id cron.EntryID
+	if id, err = s.cron.AddJob(schedule, task); err != nil {
+		return fmt.Errorf("scheduler: error adding job: %w", err)
+	}
+
+	if entry, ok := s.tasks[name]; ok {
+		s.cron.Remove(cron.EntryID(entry.ID))
+	}
+
+	s.tasks[name] = Entry{Name: name, ID: int(id), Schedule: schedule}
+	s.running[name] = running
+
+	return nil
+}
+
+// UpdateTaskFunc is like UpdateTask but accepts a function task
+func (s *Scheduler) UpdateTaskFunc(name, schedule string, task func()) (err error) {
+	return s.UpdateTask(name, schedule, TaskFunc(task))
+}
+
 // RemoveTask from the scheduler
 func (s *Scheduler) RemoveTask(name string) (err error) {
 	s.mtx.Lock()
@@ -125,6 +171,7 @@ func (s *Scheduler) RemoveTask(name string) (err error) {
 
 	s.cron.Remove(cron.EntryID(entry.ID))
 	delete(s.tasks, name)
+	delete(s.running, name)
 
 	return nil
 }
@@ -142,12 +189,16 @@ func (s *Scheduler) Entries() (entries []Entry) {
 	return entries
 }
 
-// skipIfStillRunning skips an invocation of the Job if a previous invocation is
-// still running. It logs skips to the given logger at Info level.
-func skipIfStillRunning(name string, t Task) Task {
-	var ch = make(chan struct{}, 1)
+// newRunning creates the guard shared by the invocations of a named task
+func newRunning() (ch chan struct{}) {
+	ch = make(chan struct{}, 1)
 	ch <- struct{}{}
+	return ch
+}
 
+// skipIfStillRunning skips an invocation of the Job if a previous invocation is
+// still running, as tracked by the given guard. It logs skips at Warn level.
+func skipIfStillRunning(name string, ch chan struct{}, t Task) Task {
 	return TaskFunc(func() {
 		select {
 		case v := <-ch:
@@ -0,0 +1,66 @@
+package scheduler
+
+import (
+	"testing"
+
+	"github.com/robfig/cron/v3"
+)
+
+func TestUpdateTask(t *testing.T) {
+	s := New()
+
+	if err := s.UpdateTaskFunc("task", "invalid", func() {}); err == nil {
+		t.Fatal("UpdateTask() expected error for invalid schedule")
+	}
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	if err := s.AddTaskFunc("task", "* * * * *", func() {
+		close(started)
+		<-release
+	}); err != nil {
+		t.Fatalf("AddTask() error = %s", err)
+	}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		s.job("task").Run()
+	}()
+	<-started
+
+	runs := 0
+	if err := s.UpdateTaskFunc("task", "*/5 * * * *", func() { runs++ }); err != nil {
+		t.Fatalf("UpdateTask() error = %s", err)
+	}
+
+	if err := s.UpdateTaskFunc("task", "invalid", func() {}); err == nil {
+		t.Fatal("UpdateTask() expected error for invalid schedule")
+	}
+
+	entries := s.Entries()
+	if len(entries) != 1 || entries[0].Schedule != "*/5 * * * *" {
+		t.Fatalf("UpdateTask() unexpected entries: %#v", entries)
+	}
+
+	// the updated task must not overlap with the running one
+	s.job("task").Run()
+	if runs != 0 {
+		t.Fatal("UpdateTask() updated task ran while the previous task was running")
+	}
+
+	close(release)
+	<-done
+
+	s.job("task").Run()
+	if runs != 1 {
+		t.Fatalf("UpdateTask() expected updated task to run once, ran %d", runs)
+	}
+}
+
+// job returns the scheduled job for the named task
+func (s *Scheduler) job(name string) (job cron.Job) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return s.cron.Entry(cron.EntryID(s.tasks[name].ID)).Job
+}
@@ -0,0 +1,78 @@
+// Package tlsutil implements helpers for creating tls configurations
+// for replicant servers, executors and clients.
+package tlsutil
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+)
+
+// ServerConfig creates a tls config for serving with the given certificate and key.
+// If clientCAFile is specified, client certificates signed by its CAs are required.
+func ServerConfig(certFile, keyFile, clientCAFile string) (config *tls.Config, err error) {
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("tlsutil: error loading certificate: %w", err)
+	}
+
+	config = &tls.Config{}
+	config.MinVersion = tls.VersionTLS12
+	config.Certificates = []tls.Certificate{cert}
+
+	if clientCAFile != "" {
+		if config.ClientCAs, err = loadPool(clientCAFile, false); err != nil {
+			return nil, err
+		}
+		config.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+
+	return config, nil
+}
+
+// ClientConfig creates a tls config for clients. If caFile is specified its CAs are
+// trusted in addition to the system roots. If certFile and keyFile are specified,
+// the certificate is presented to servers requiring client certificates.
+func ClientConfig(caFile, certFile, keyFile string) (config *tls.Config, err error) {
+	config = &tls.Config{}
+	config.MinVersion = tls.VersionTLS12
+
+	if caFile != "" {
+		if config.RootCAs, err = loadPool(caFile, true); err != nil {
+			return nil, err
+		}
+	}
+
+	if certFile != "" || keyFile != "" {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, fmt.Errorf("tlsutil: error loading client certificate: %w", err)
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+
+	return config, nil
+}
+
+// loadPool loads the PEM encoded certificates in the given file into a
+// certificate pool, optionally including the system roots
+func loadPool(file string, system bool) (pool *x509.CertPool, err error) {
+	buf, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("tlsutil: error reading CA file: %w", err)
+	}
+
+	pool = x509.NewCertPool()
+	if system {
+		if pool, err = x509.SystemCertPool(); err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+	}
+
+	if !pool.AppendCertsFromPEM(buf) {
+		return nil, fmt.Errorf("tlsutil: no certificates found in CA file %s", file)
+	}
+
+	return pool, nil
+}
@@ -3,26 +3,148 @@ package tmpl
 
 import (
 	"bytes"
+	"fmt"
+	"strings"
 	"text/template"
+	"text/template/parse"
 
+	"github.com/Unbabel/replicant/secret"
 	"github.com/Unbabel/replicant/transaction"
 )
 
 // Parse the script within the transaction with the defined transaction inputs
 func Parse(config transaction.Config) (c transaction.Config, err error) {
+	c, _, err = ParseWithSecrets(config, nil)
+	return c, err
+}
+
+// Templated checks if the transaction config needs rendering before running,
+// either for having inputs or for referencing secrets in its script.
+// Scripts without inputs are otherwise run as is, as their own syntax
+// might resemble template actions, eg: []T{{...}}
+func Templated(config transaction.Config) (ok bool) {
+	if config.Inputs != nil {
+		return true
+	}
 
-	tpl, err := template.New(config.Name).Parse(config.Script)
+	if !strings.Contains(config.Script, "{{") {
+		return false
+	}
+
+	tpl, err := template.New(config.Name).
+		Funcs(template.FuncMap{"secret": func(string) string { return "" }}).Parse(config.Script)
 	if err != nil {
-		return config, err
+		return false
 	}
 
-	var buf bytes.Buffer
-	err = tpl.Execute(&buf, config.Inputs)
+	for _, t := range tpl.Templates() {
+		if t.Tree != nil && references(t.Tree.Root, "secret") {
+			return true
+		}
+	}
+	return false
+}
+
+// references checks if the template node calls the given function
+func references(node parse.Node, name string) (ok bool) {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return false
+		}
+		for _, c := range n.Nodes {
+			if references(c, name) {
+				return true
+			}
+		}
+	case *parse.ActionNode:
+		return references(n.Pipe, name)
+	case *parse.IfNode:
+		return references(&n.BranchNode, name)
+	case *parse.RangeNode:
+		return references(&n.BranchNode, name)
+	case *parse.WithNode:
+		return references(&n.BranchNode, name)
+	case *parse.BranchNode:
+		return references(n.Pipe, name) || references(n.List, name) || references(n.ElseList, name)
+	case *parse.TemplateNode:
+		return references(n.Pipe, name)
+	case *parse.PipeNode:
+		if n == nil {
+			return false
+		}
+		for _, c := range n.Cmds {
+			if references(c, name) {
+				return true
+			}
+		}
+	case *parse.CommandNode:
+		for _, a := range n.Args {
+			if references(a, name) {
+				return true
+			}
+		}
+	case *parse.IdentifierNode:
+		return n.Ident == name
+	}
+	return false
+}
+
+// ParseWithSecrets renders the string inputs and then the script within the transaction
+// with the rendered inputs. Secrets referenced with the secret template function,
+// eg: {{ secret "payments/api_key" }}, are resolved from the given provider and their
+// values are returned for redaction.
+func ParseWithSecrets(config transaction.Config, p secret.Provider) (c transaction.Config, values []string, err error) {
+	funcs := template.FuncMap{
+		"secret": func(key string) (value string, err error) {
+			if p == nil {
+				return "", fmt.Errorf("no secret provider configured for resolving %s", key)
+			}
+
+			if value, err = p.Get(key); err != nil {
+				return "", err
+			}
+
+			values = append(values, value)
+			return value, nil
+		},
+	}
+
+	var inputs map[string]interface{}
+	if config.Inputs != nil {
+		inputs = make(map[string]interface{}, len(config.Inputs))
+		for k, v := range config.Inputs {
+			s, ok := v.(string)
+			if !ok {
+				inputs[k] = v
+				continue
+			}
+
+			if inputs[k], err = execute(config.Name+"/"+k, s, funcs, nil); err != nil {
+				return config, nil, err
+			}
+		}
+	}
+
+	script, err := execute(config.Name, config.Script, funcs, inputs)
 	if err != nil {
-		return config, err
+		return config, nil, err
 	}
 
-	config.Script = buf.String()
-	return config, nil
+	config.Script = script
+	return config, values, nil
+}
+
+func execute(name, text string, funcs template.FuncMap, data interface{}) (s string, err error) {
+	tpl, err := template.New(name).Funcs(funcs).Parse(text)
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	if err = tpl.Execute(&buf, data); err != nil {
+		return "", err
+	}
 
+	return buf.String(), nil
 }
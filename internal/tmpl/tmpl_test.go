@@ -0,0 +1,39 @@
+package tmpl
+
+import (
+	"testing"
+
+	"github.com/Unbabel/replicant/transaction"
+)
+
+func TestTemplated(t *testing.T) {
+	tests := []struct {
+		name   string
+		config transaction.Config
+		want   bool
+	}{
+		{name: "plain script", want: false, config: transaction.Config{
+			Script: `function Run(ctx) { return "{}" }`}},
+		{name: "go composite literal", want: false, config: transaction.Config{
+			Script: "package transaction\n\nvar matrix = [][]int{{1, 2}, {3, 4}}\n"}},
+		{name: "javascript object literal", want: false, config: transaction.Config{
+			Script: `function Run(ctx) { return JSON.stringify({data: {"a": 1}}) }`}},
+		{name: "action without secrets", want: false, config: transaction.Config{
+			Script: `rows := [][]string{{"x"}}; n := {{ 1 }}`}},
+		{name: "inputs", want: true, config: transaction.Config{
+			Inputs: map[string]interface{}{"url": "http://localhost"},
+			Script: `req.URL = "{{ index . "url" }}"`}},
+		{name: "secret", want: true, config: transaction.Config{
+			Script: `req.Header["Authorization"] = "Bearer {{ secret "payments/token" }}"`}},
+		{name: "secret in pipeline", want: true, config: transaction.Config{
+			Script: `{{ if true }}token = {{ "payments/token" | secret | printf "%q" }}{{ end }}`}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Templated(tt.config); got != tt.want {
+				t.Fatalf("Templated() = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,353 @@
+package manager
+
+/*
+   Copyright 2019 Bruno Moura <brunotm@gmail.com>
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Unbabel/replicant/log"
+	"github.com/Unbabel/replicant/manager/registration"
+)
+
+const (
+	// DefaultHealthCheckInterval for executors if not specified
+	DefaultHealthCheckInterval = time.Second * 10
+
+	// DefaultExecutorTTL for registered executors if not specified
+	DefaultExecutorTTL = time.Second * 30
+
+	// executor api paths
+	executorRunPath    = "/api/v1/run/"
+	executorHealthPath = "/api/v1/health"
+
+	// status reported by executors with unavailable drivers
+	executorDegraded = "degraded"
+
+	// maximum size of executor health responses
+	maxHealthSize = 1 << 20
+)
+
+// ErrNoExecutors no healthy executors available for running transactions
+var ErrNoExecutors = fmt.Errorf("no healthy executors available")
+
+// Registration is sent by executors for registering themselves with the manager.
+// Executors must keep sending registrations as heartbeats.
+type Registration = registration.Registration
+
+// ExecutorStatus is the state of an executor in the manager executor pool
+type ExecutorStatus struct {
+	URL        string    `json:"url" yaml:"url"`
+	Drivers    []string  `json:"drivers,omitempty" yaml:"drivers,omitempty"`
+	Registered bool      `json:"registered" yaml:"registered"`
+	Healthy    bool      `json:"healthy" yaml:"healthy"`
+	Withdrawn  []string  `json:"withdrawn,omitempty" yaml:"withdrawn,omitempty"`
+	InFlight   int       `json:"in_flight" yaml:"in_flight"`
+	LastCheck  time.Time `json:"last_check" yaml:"last_check"`
+	LastSeen   time.Time `json:"last_seen,omitempty" yaml:"last_seen,omitempty"`
+	Error      string    `json:"error,omitempty" yaml:"error,omitempty"`
+}
+
+// executor tracks the state of a single executor.
+// Statically configured executors have no driver list and are assumed
+// to support all drivers until they register. They are never expired.
+// Drivers reported as unavailable by health checks are withdrawn.
+type executor struct {
+	url        string
+	drivers    []string
+	withdrawn  []string
+	static     bool
+	registered bool
+	healthy    bool
+	inFlight   int
+	lastCheck  time.Time
+	lastSeen   time.Time
+	err        error
+}
+
+// supports checks if the executor supports the given driver
+func (e *executor) supports(driver string) (ok bool) {
+	for _, d := range e.withdrawn {
+		if d == driver {
+			return false
+		}
+	}
+
+	if !e.registered {
+		return true
+	}
+
+	for _, d := range e.drivers {
+		if d == driver {
+			return true
+		}
+	}
+	return false
+}
+
+// pool of executors for running transactions.
+// Executors are health checked at every interval and runs are routed
+// to the healthy executor supporting the transaction driver with the
+// least runs in flight. Registered executors are removed from the pool
+// when no registration heartbeat is received within the ttl.
+type pool struct {
+	mtx       sync.Mutex
+	client    *http.Client
+	interval  time.Duration
+	ttl       time.Duration
+	secret    string
+	next      int
+	executors []*executor
+	close     chan struct{}
+}
+
+// newPool creates a new executor pool. Executors are considered healthy
+// until proven otherwise by health checks or failed runs.
+func newPool(client *http.Client, urls []string, interval, ttl time.Duration) (p *pool) {
+	p = &pool{}
+	p.client = client
+	p.interval = interval
+	p.ttl = ttl
+	p.close = make(chan struct{})
+
+	for _, u := range urls {
+		if u == "" {
+			continue
+		}
+		p.executors = append(p.executors, &executor{url: u, static: true, healthy: true})
+	}
+
+	return p
+}
+
+// start health checking executors
+func (p *pool) start() {
+	go func() {
+		p.check()
+
+		ticker := time.NewTicker(p.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				p.expire()
+				p.check()
+			case <-p.close:
+				return
+			}
+		}
+	}()
+}
+
+// stop health checking executors
+func (p *pool) stop() {
+	close(p.close)
+}
+
+// register or refresh an executor registration
+func (p *pool) register(r Registration) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	for _, e := range p.executors {
+		if e.url == r.URL {
+			e.drivers = r.Drivers
+			e.registered = true
+			e.lastSeen = time.Now()
+			return
+		}
+	}
+
+	log.Info("executor registered").String("url", r.URL).
+		String("drivers", strings.Join(r.Drivers, ",")).Log()
+
+	p.executors = append(p.executors, &executor{
+		url: r.URL, drivers: r.Drivers, registered: true, healthy: true, lastSeen: time.Now()})
+}
+
+// expire registered executors which stopped sending heartbeats.
+// Statically configured executors are kept.
+func (p *pool) expire() {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	executors := p.executors[:0]
+	for _, e := range p.executors {
+		if e.registered && !e.static && time.Since(e.lastSeen) > p.ttl {
+			log.Warn("executor registration expired").String("url", e.url).
+				String("last_seen", e.lastSeen.Format(time.RFC3339)).Log()
+			continue
+		}
+		executors = append(executors, e)
+	}
+
+	// clear the references left behind
+	for x := len(executors); x < len(p.executors); x++ {
+		p.executors[x] = nil
+	}
+	p.executors = executors
+}
+
+// check the health of all executors concurrently
+func (p *pool) check() {
+	p.mtx.Lock()
+	executors := make([]*executor, len(p.executors))
+	copy(executors, p.executors)
+	p.mtx.Unlock()
+
+	var wg sync.WaitGroup
+	for _, e := range executors {
+		wg.Add(1)
+		go func(e *executor) {
+			defer wg.Done()
+			withdrawn, err := p.probe(e.url)
+
+			p.mtx.Lock()
+			defer p.mtx.Unlock()
+
+			if e.healthy != (err == nil) {
+				log.Info("executor health changed").String("url", e.url).
+					Bool("healthy", err == nil).Error("error", err).Log()
+			}
+
+			if strings.Join(e.withdrawn, ",") != strings.Join(withdrawn, ",") {
+				log.Info("executor drivers availability changed").String("url", e.url).
+					String("withdrawn", strings.Join(withdrawn, ",")).Log()
+			}
+
+			e.healthy = err == nil
+			e.withdrawn = withdrawn
+			e.err = err
+			e.lastCheck = time.Now()
+		}(e)
+	}
+
+	wg.Wait()
+}
+
+// executorHealth is the response of the executor health endpoint
+type executorHealth struct {
+	Status string `json:"status"`
+	Web    *struct {
+		Healthy bool `json:"healthy"`
+	} `json:"web"`
+}
+
+// probe the executor health endpoint, returning the drivers unavailable
+// on degraded executors, which keep serving the remaining drivers
+func (p *pool) probe(url string) (withdrawn []string, err error) {
+	ctx, cancel := context.WithTimeout(context.Background(), p.interval)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url+executorHealthPath, nil)
+	if err != nil {
+		return nil, err
+	}
+	setBearer(req, p.secret)
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	// executors without drivers health reporting respond with no body
+	var h executorHealth
+	json.NewDecoder(io.LimitReader(resp.Body, maxHealthSize)).Decode(&h)
+
+	if h.Status == executorDegraded && h.Web != nil && !h.Web.Healthy {
+		return []string{"web"}, nil
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unhealthy executor status: %s", resp.Status)
+	}
+
+	return nil, nil
+}
+
+// acquire the healthy executor supporting the given driver with the least runs
+// in flight, ignoring the excluded executors. Ties are broken in a round robin fashion.
+func (p *pool) acquire(driver string, exclude map[string]bool) (e *executor, err error) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	for x := 0; x < len(p.executors); x++ {
+		c := p.executors[(p.next+x)%len(p.executors)]
+		if !c.healthy || exclude[c.url] || !c.supports(driver) {
+			continue
+		}
+
+		if e == nil || c.inFlight < e.inFlight {
+			e = c
+		}
+	}
+
+	if e == nil {
+		return nil, ErrNoExecutors
+	}
+
+	p.next++
+	e.inFlight++
+	return e, nil
+}
+
+// release a previously acquired executor. Unreachable executors are marked
+// as unhealthy with the given error until the next successful health check.
+func (p *pool) release(e *executor, unreachable bool, err error) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	e.inFlight--
+	if unreachable {
+		e.healthy = false
+		e.err = err
+	}
+}
+
+// status of all executors in the pool
+func (p *pool) status() (status []ExecutorStatus) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	for _, e := range p.executors {
+		s := ExecutorStatus{URL: e.url, Drivers: e.drivers, Registered: e.registered,
+			Healthy: e.healthy, Withdrawn: e.withdrawn, InFlight: e.inFlight, LastCheck: e.lastCheck, LastSeen: e.lastSeen}
+		if e.err != nil {
+			s.Error = e.err.Error()
+		}
+		status = append(status, s)
+	}
+
+	sort.Slice(status, func(i, j int) bool { return status[i].URL < status[j].URL })
+	return status
+}
+
+// setBearer sets the executor shared secret as the request bearer token if specified
+func setBearer(req *http.Request, secret string) {
+	if secret != "" {
+		req.Header.Set("Authorization", "Bearer "+secret)
+	}
+}
@@ -0,0 +1,246 @@
+package manager
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Unbabel/replicant/store/memory"
+	"github.com/Unbabel/replicant/transaction"
+)
+
+func newTestExecutor(t *testing.T) (s *httptest.Server) {
+	mux := http.NewServeMux()
+	mux.HandleFunc(executorHealthPath, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	mux.HandleFunc(executorRunPath, func(w http.ResponseWriter, r *http.Request) {
+		var config transaction.Config
+		if err := json.NewDecoder(r.Body).Decode(&config); err != nil {
+			t.Errorf("error decoding transaction config: %s", err)
+		}
+
+		result := transaction.Result{Name: config.Name, Driver: config.Driver, Message: "ok"}
+		json.NewEncoder(w).Encode(&result)
+	})
+
+	return httptest.NewServer(mux)
+}
+
+func newTestManager(t *testing.T, urls ...string) (m *Manager) {
+	s, _ := memory.New("")
+	rs, _ := memory.NewResultStore("")
+	return New(Config{ExecutorURLs: urls, HealthCheckInterval: time.Hour}, s, rs)
+}
+
+func TestPoolAcquireLeastLoaded(t *testing.T) {
+	p := newPool(http.DefaultClient, []string{"a", "b", "c"}, time.Hour, time.Hour)
+
+	a, _ := p.acquire("javascript", nil)
+	b, _ := p.acquire("javascript", nil)
+	c, _ := p.acquire("javascript", nil)
+	if a == b || b == c || a == c {
+		t.Fatalf("expected runs to be spread across executors, got %s, %s, %s", a.url, b.url, c.url)
+	}
+
+	p.release(b, false, nil)
+	e, _ := p.acquire("javascript", nil)
+	if e != b {
+		t.Fatalf("expected least loaded executor %s, got %s", b.url, e.url)
+	}
+
+	if _, err := p.acquire("javascript", map[string]bool{"a": true, "b": true, "c": true}); err != ErrNoExecutors {
+		t.Fatalf("expected ErrNoExecutors, got %v", err)
+	}
+}
+
+func TestPoolRegisteredDrivers(t *testing.T) {
+	p := newPool(http.DefaultClient, nil, time.Hour, time.Minute)
+	p.register(Registration{URL: "a", Drivers: []string{"go", "javascript"}})
+	p.register(Registration{URL: "b", Drivers: []string{"javascript", "web"}})
+
+	for x := 0; x < 3; x++ {
+		e, err := p.acquire("web", nil)
+		if err != nil || e.url != "b" {
+			t.Fatalf("expected web executor b, got %v, %v", e, err)
+		}
+		p.release(e, false, nil)
+	}
+
+	if _, err := p.acquire("grpc", nil); err != ErrNoExecutors {
+		t.Fatalf("expected ErrNoExecutors for unsupported driver, got %v", err)
+	}
+
+	// expire b by making its last heartbeat older than the ttl
+	p.executors[1].lastSeen = time.Now().Add(-time.Hour)
+	p.register(Registration{URL: "a", Drivers: []string{"go", "javascript"}})
+	p.expire()
+
+	if status := p.status(); len(status) != 1 || status[0].URL != "a" {
+		t.Fatalf("expected only executor a after expiry, got %#v", status)
+	}
+
+	if _, err := p.acquire("web", nil); err != ErrNoExecutors {
+		t.Fatalf("expected ErrNoExecutors after expiry, got %v", err)
+	}
+}
+
+func TestPoolStaticNotExpired(t *testing.T) {
+	p := newPool(http.DefaultClient, []string{"a"}, time.Hour, time.Minute)
+	p.register(Registration{URL: "a", Drivers: []string{"javascript"}})
+	p.register(Registration{URL: "b", Drivers: []string{"javascript"}})
+
+	for _, e := range p.executors {
+		e.lastSeen = time.Now().Add(-time.Hour)
+	}
+	p.expire()
+
+	if status := p.status(); len(status) != 1 || status[0].URL != "a" {
+		t.Fatalf("expected only static executor a after expiry, got %#v", status)
+	}
+}
+
+func TestPoolDegradedExecutor(t *testing.T) {
+	degraded := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		w.Write([]byte(`{"status": "degraded", "web": {"healthy": false}}`))
+	}))
+	defer degraded.Close()
+
+	unavailable := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer unavailable.Close()
+
+	p := newPool(http.DefaultClient, []string{degraded.URL, unavailable.URL}, time.Second, time.Hour)
+	p.check()
+
+	if _, err := p.acquire("web", nil); err != ErrNoExecutors {
+		t.Fatalf("expected ErrNoExecutors for withdrawn driver, got %v", err)
+	}
+
+	e, err := p.acquire("javascript", nil)
+	if err != nil || e.url != degraded.URL {
+		t.Fatalf("expected degraded executor to keep serving other drivers, got %v, %v", e, err)
+	}
+
+	for _, s := range p.status() {
+		if s.Healthy != (s.URL == degraded.URL) {
+			t.Fatalf("unexpected executor health: %#v", s)
+		}
+
+		if s.URL == degraded.URL && (len(s.Withdrawn) != 1 || s.Withdrawn[0] != "web") {
+			t.Fatalf("expected withdrawn web driver: %#v", s)
+		}
+	}
+}
+
+func TestManagerRunRetriesOnConnectionError(t *testing.T) {
+	healthy := newTestExecutor(t)
+	defer healthy.Close()
+
+	failed := newTestExecutor(t)
+	failed.Close()
+
+	m := newTestManager(t, failed.URL, healthy.URL)
+	defer m.Close()
+
+	for x := 0; x < 3; x++ {
+		result := m.Run(transaction.Config{Name: "test-transaction", Driver: "javascript"})
+		if result.Error != nil || result.Message != "ok" {
+			t.Fatalf("transaction failed: %#v", result)
+		}
+	}
+
+	for _, s := range m.Executors() {
+		if s.Healthy != (s.URL == healthy.URL) {
+			t.Errorf("unexpected executor state: %#v", s)
+		}
+	}
+}
+
+func TestManagerRunNoRetryAfterSent(t *testing.T) {
+	// executors dropping the connection after receiving the transaction
+	var runs int32
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.URL.Path, executorRunPath) {
+			return
+		}
+
+		atomic.AddInt32(&runs, 1)
+		conn, _, err := w.(http.Hijacker).Hijack()
+		if err != nil {
+			t.Errorf("error hijacking connection: %s", err)
+			return
+		}
+		conn.Close()
+	})
+
+	a := httptest.NewServer(handler)
+	defer a.Close()
+	b := httptest.NewServer(handler)
+	defer b.Close()
+
+	m := newTestManager(t, a.URL, b.URL)
+	defer m.Close()
+
+	result := m.Run(transaction.Config{Name: "test-transaction", Driver: "javascript"})
+	if !result.Failed || result.Error == nil {
+		t.Fatalf("expected transaction to fail, got: %#v", result)
+	}
+
+	if n := atomic.LoadInt32(&runs); n != 1 {
+		t.Fatalf("expected transaction to be sent once, sent %d times", n)
+	}
+}
+
+func TestManagerRunTimeoutKeepsExecutorHealthy(t *testing.T) {
+	defer func(p time.Duration) { timeoutGracePeriod = p }(timeoutGracePeriod)
+	timeoutGracePeriod = 0
+
+	// executor never answering runs
+	done := make(chan struct{})
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.URL.Path, executorRunPath) {
+			select {
+			case <-r.Context().Done():
+			case <-done:
+			}
+		}
+	})
+
+	s := httptest.NewServer(handler)
+	defer s.Close()
+	defer close(done)
+
+	m := newTestManager(t, s.URL)
+	defer m.Close()
+
+	result := m.Run(transaction.Config{Name: "test-transaction", Driver: "javascript", Timeout: "100ms"})
+	if !result.Failed || result.Error == nil {
+		t.Fatalf("expected transaction to fail, got: %#v", result)
+	}
+
+	for _, s := range m.Executors() {
+		if !s.Healthy {
+			t.Fatalf("expected executor to be healthy after a timeout: %#v", s)
+		}
+	}
+}
+
+func TestManagerRunNoExecutors(t *testing.T) {
+	failed := newTestExecutor(t)
+	failed.Close()
+
+	m := newTestManager(t, failed.URL)
+	defer m.Close()
+
+	result := m.Run(transaction.Config{Name: "test-transaction", Driver: "javascript"})
+	if !result.Failed || result.Error == nil {
+		t.Fatalf("expected transaction to fail, got: %#v", result)
+	}
+}
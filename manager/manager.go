@@ -21,19 +21,27 @@ package manager
 import (
 	"bytes"
 	"context"
+	"crypto/tls"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
+	"mime"
 	"net/http"
+	"net/http/httptrace"
+	"strings"
 	"sync"
 	"time"
 
+	"github.com/Unbabel/replicant/emitter/stream"
 	"github.com/Unbabel/replicant/internal/scheduler"
 	"github.com/Unbabel/replicant/internal/tmpl"
-	"github.com/Unbabel/replicant/internal/xz"
 	"github.com/Unbabel/replicant/log"
+	"github.com/Unbabel/replicant/secret"
 	"github.com/Unbabel/replicant/store"
 	"github.com/Unbabel/replicant/transaction"
+	"github.com/Unbabel/replicant/transaction/validate"
 	"github.com/segmentio/ksuid"
 )
 
@@ -41,13 +49,27 @@ const (
 	// DefaultTransactionTimeout if not specified
 	DefaultTransactionTimeout = "1m"
 
-	// default timeout grace period
-	defaultTimeoutGracePeriod = time.Second * 20
+	// DefaultArtifactMaxSize for result artifacts if not specified
+	DefaultArtifactMaxSize = 10 << 20
+
+	// maximum size of executor responses, which carry base64 encoded result artifacts
+	maxResponseSize = 2 * transaction.MaxArtifactsSize
+
+	// maximum interval between removals of expired results and artifacts
+	pruneInterval = time.Hour
+
+	// artifactPath is the api path for retrieving stored result artifacts
+	artifactPath = "/api/v1/result/%s/artifacts/%s"
 )
 
-// Emitter is the interface for result emitters to external systems
+// timeoutGracePeriod is added to transaction timeouts for executor calls
+var timeoutGracePeriod = time.Second * 20
+
+// Emitter is the interface for result emitters to external systems.
+// Emitters are closed when the manager is closed.
 type Emitter interface {
 	Emit(result transaction.Result)
+	Close()
 }
 
 // EmitterFunc is a function type that implements Emitter
@@ -56,27 +78,99 @@ type EmitterFunc func(result transaction.Result)
 // Emit results
 func (e EmitterFunc) Emit(result transaction.Result) { e(result) }
 
+// Close is a no-op for function emitters
+func (e EmitterFunc) Close() {}
+
+// Config for the manager
+type Config struct {
+	// ExecutorURLs of the executors for running transactions
+	ExecutorURLs []string `json:"executor_urls" yaml:"executor_urls"`
+
+	// HealthCheckInterval for executor health checks
+	HealthCheckInterval time.Duration `json:"health_check_interval" yaml:"health_check_interval"`
+
+	// ExecutorTTL for registered executors without heartbeats
+	ExecutorTTL time.Duration `json:"executor_ttl" yaml:"executor_ttl"`
+
+	// ExecutorSecret sent to executors as a bearer token
+	ExecutorSecret string `json:"-" yaml:"-"`
+
+	// ExecutorTLS config for reaching executors over https
+	ExecutorTLS *tls.Config `json:"-" yaml:"-"`
+
+	// Secrets provider for resolving secrets referenced in transaction templates
+	Secrets secret.Provider `json:"-" yaml:"-"`
+
+	// ArtifactMaxSize in bytes for result artifacts, larger artifacts are discarded
+	ArtifactMaxSize int64 `json:"artifact_max_size" yaml:"artifact_max_size"`
+
+	// ArtifactRetention for stored result artifacts, which are kept indefinitely if not specified
+	ArtifactRetention time.Duration `json:"artifact_retention" yaml:"artifact_retention"`
+
+	// ResultRetention for stored results, which are kept indefinitely if not specified
+	ResultRetention time.Duration `json:"result_retention" yaml:"result_retention"`
+}
+
 // Manager is a manager for replicant transactions.
 // It tracks execution, scheduling and result data.
 type Manager struct {
 	mtx          sync.Mutex
 	client       *http.Client
-	executorURL  string
+	secret       string
+	secrets      secret.Provider
+	executors    *pool
 	emitters     []Emitter
+	stream       *stream.Emitter
+	validator    *validate.Validator
 	scheduler    *scheduler.Scheduler
 	transactions store.Store
-	results      *xz.Map
+	results      store.ResultStore
+	artifacts    store.ArtifactStore
+	maxArtifact  int64
+	artifactTTL  time.Duration
+	resultTTL    time.Duration
+	pruning      sync.WaitGroup
+	close        chan struct{}
 }
 
 // New creates a new manager
-func New(s store.Store, executorURL string) (manager *Manager) {
+func New(c Config, s store.Store, rs store.ResultStore) (manager *Manager) {
+	if c.HealthCheckInterval == 0 {
+		c.HealthCheckInterval = DefaultHealthCheckInterval
+	}
+
+	if c.ExecutorTTL == 0 {
+		c.ExecutorTTL = DefaultExecutorTTL
+	}
+
+	if c.ArtifactMaxSize == 0 {
+		c.ArtifactMaxSize = DefaultArtifactMaxSize
+	}
+
 	manager = &Manager{}
 	manager.client = &http.Client{}
-	manager.executorURL = executorURL + "/api/v1/run/"
+	if c.ExecutorTLS != nil {
+		manager.client.Transport = &http.Transport{TLSClientConfig: c.ExecutorTLS}
+	}
+	manager.secret = c.ExecutorSecret
+	manager.secrets = c.Secrets
+	manager.executors = newPool(manager.client, c.ExecutorURLs, c.HealthCheckInterval, c.ExecutorTTL)
+	manager.executors.secret = c.ExecutorSecret
+	manager.executors.start()
 	manager.transactions = s
-	manager.results = xz.NewMap()
+	manager.results = rs
+	manager.stream = stream.New(stream.DefaultConfig)
+	manager.emitters = append(manager.emitters, manager.stream)
 	manager.scheduler = scheduler.New()
 	manager.scheduler.Start()
+	manager.maxArtifact = c.ArtifactMaxSize
+	manager.artifactTTL = c.ArtifactRetention
+	manager.resultTTL = c.ResultRetention
+	manager.close = make(chan struct{})
+	if manager.artifactTTL > 0 || manager.resultTTL > 0 {
+		manager.pruning.Add(1)
+		go manager.prune()
+	}
 
 	// Reconfigure previously stored transactions
 	s.Iter(func(name string, config transaction.Config) (proceed bool) {
@@ -103,24 +197,49 @@ func New(s store.Store, executorURL string) (manager *Manager) {
 	return manager
 }
 
-// Close the manager
+// Close the manager. Running scheduled transactions are waited for,
+// so that their results are emitted before closing the emitters.
 func (m *Manager) Close() (err error) {
-	m.scheduler.Stop()
+	<-m.scheduler.Stop().Done()
+	m.executors.stop()
+	close(m.close)
+	m.pruning.Wait()
+
+	m.mtx.Lock()
+	for _, e := range m.emitters {
+		e.Close()
+	}
+	m.mtx.Unlock()
+
+	if err = m.results.Close(); err != nil {
+		return fmt.Errorf("manager: %w", err)
+	}
+	if m.artifacts != nil {
+		if err = m.artifacts.Close(); err != nil {
+			return fmt.Errorf("manager: %w", err)
+		}
+	}
 	return m.transactions.Close()
 }
 
 // Run the given transaction
 func (m *Manager) Run(c transaction.Config) (r transaction.Result) {
+	return m.run(ksuid.New().String(), c)
+}
+
+// run the given transaction with the given result uuid
+func (m *Manager) run(uuid string, c transaction.Config) (r transaction.Result) {
 	var err error
+	var secrets []string
 
-	uuid := ksuid.New().String()
 	start := time.Now()
 
-	if c.Inputs != nil {
-		if c, err = tmpl.Parse(c); err != nil {
+	if tmpl.Templated(c) {
+		if c, secrets, err = tmpl.ParseWithSecrets(c, m.secrets); err != nil {
 			return wrapErrorResult(
 				uuid, c, start, fmt.Errorf("manager: error parsing transaction template: %w", err))
 		}
+		defer func() { r = redact(r, secrets) }()
 	}
 
 	buf, err := json.Marshal(&c)
@@ -129,12 +248,6 @@ func (m *Manager) Run(c transaction.Config) (r transaction.Result) {
 			uuid, c, start, fmt.Errorf("manager: error marshaling config: %w", err))
 	}
 
-	req, err := http.NewRequest(http.MethodPost, m.executorURL+uuid, bytes.NewReader(buf))
-	if err != nil {
-		return wrapErrorResult(
-			uuid, c, start, fmt.Errorf("manager: error creating executor request: %w", err))
-	}
-
 	// Use the default timeout when unspecified
 	if c.Timeout == "" {
 		c.Timeout = DefaultTransactionTimeout
@@ -148,60 +261,253 @@ func (m *Manager) Run(c transaction.Config) (r transaction.Result) {
 			uuid, c, start, fmt.Errorf("manager: error parsing timeout from template: %w", err))
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), timeout+defaultTimeoutGracePeriod)
+	ctx, cancel := context.WithTimeout(context.Background(), timeout+timeoutGracePeriod)
 	defer cancel()
 
-	res, err := m.client.Do(req.WithContext(ctx))
-	if err != nil {
-		return wrapErrorResult(
-			uuid, c, start, fmt.Errorf("manager: error sending executor request: %w", err))
+	// Retry on other executors only when the connection to the executor could not be
+	// established, as transactions might have already run on errors after the request was sent
+	tried := make(map[string]bool)
+	for {
+		e, err := m.executors.acquire(c.Driver, tried)
+		if err != nil {
+			return wrapErrorResult(uuid, c, start, fmt.Errorf("manager: %w", err))
+		}
+
+		resp, connected, err := m.runOn(ctx, e.url, uuid, buf)
+
+		// only failures to connect tell about the executor health
+		unreachable := err != nil && !connected && ctx.Err() == nil
+		m.executors.release(e, unreachable, err)
+
+		if err != nil {
+			if !unreachable {
+				return wrapErrorResult(
+					uuid, c, start, fmt.Errorf("manager: error sending executor request: %w", err))
+			}
+
+			log.Warn("error sending executor request").String("name", c.Name).
+				String("uuid", uuid).String("executor", e.url).Error("error", err).Log()
+			tried[e.url] = true
+			continue
+		}
+
+		err = json.Unmarshal(resp, &r)
+		if err != nil {
+			return wrapErrorResult(
+				uuid, c, start, fmt.Errorf("manager: error reading executor response: %w", err))
+		}
+
+		r.Artifacts = m.storeArtifacts(uuid, r.Artifacts, secrets)
+		return r
 	}
-	defer res.Body.Close()
+}
+
+// runOn runs the given serialized transaction config on the executor with the given url.
+// Returned errors are transport level errors, connected reports if a connection to
+// the executor was established for sending the request.
+func (m *Manager) runOn(ctx context.Context, url, uuid string, config []byte) (buf []byte, connected bool, err error) {
+	trace := &httptrace.ClientTrace{GotConn: func(httptrace.GotConnInfo) { connected = true }}
+	ctx = httptrace.WithClientTrace(ctx, trace)
 
-	buf, err = ioutil.ReadAll(res.Body)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url+executorRunPath+uuid, bytes.NewReader(config))
 	if err != nil {
-		return wrapErrorResult(
-			uuid, c, start, fmt.Errorf("manager: error reading executor response: %w", err))
+		return nil, false, err
 	}
+	setBearer(req, m.secret)
 
-	err = json.Unmarshal(buf, &r)
+	res, err := m.client.Do(req)
 	if err != nil {
-		return wrapErrorResult(
-			uuid, c, start, fmt.Errorf("manager: error reading executor response: %w", err))
+		return nil, connected, err
 	}
+	defer res.Body.Close()
 
-	return r
+	// read one byte past the limit to detect larger responses
+	buf, err = ioutil.ReadAll(io.LimitReader(res.Body, maxResponseSize+1))
+	if err == nil && len(buf) > maxResponseSize {
+		err = fmt.Errorf("executor response larger than %d bytes", maxResponseSize)
+	}
+	return buf, true, err
 }
 
 func (m *Manager) schedule(config transaction.Config) (err error) {
+	return m.scheduler.AddTaskFunc(config.Name, config.Schedule, m.task(config))
+}
+
+func (m *Manager) reschedule(config transaction.Config) (err error) {
+	if config.Schedule == "" {
+		m.scheduler.RemoveTask(config.Name)
+		return nil
+	}
+
+	return m.scheduler.UpdateTaskFunc(config.Name, config.Schedule, m.task(config))
+}
+
+// task creates the scheduler task for running the given transaction
+func (m *Manager) task(config transaction.Config) (task func()) {
+	return func() {
+		var result transaction.Result
+
+		// retries share the result uuid, so that the artifacts of failed attempts are not orphaned
+		uuid := ksuid.New().String()
+		for x := 0; x <= config.RetryCount; x++ {
+			result = m.run(uuid, config)
+			result.RetryCount = x
+			if !result.Failed && result.Error == nil {
+				break
+			}
+
+			log.Debug("transaction failed").String("name", result.Name).
+				Error("error", result.Error).String("data", result.Data).
+				String("message", result.Message).String("uuid", result.UUID).
+				Int("retry", int64(result.RetryCount)).Log()
+		}
 
-	return m.scheduler.AddTaskFunc(config.Name, config.Schedule,
-		func() {
-			var result transaction.Result
+		if err := m.results.Append(result); err != nil {
+			log.Error("error storing transaction result").String("name", result.Name).
+				String("uuid", result.UUID).Error("error", err).Log()
+		}
+
+		for x := 0; x < len(m.emitters); x++ {
+			m.emitters[x].Emit(result)
+		}
+	}
+}
+
+// SetValidator sets the validator for transaction configs being added or run.
+// Transaction configs are not validated if no validator is set.
+func (m *Manager) SetValidator(validator *validate.Validator) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	m.validator = validator
+}
+
+// SetArtifactStore sets the store for result artifacts sent by executors.
+// Result artifacts are discarded if no artifact store is set.
+func (m *Manager) SetArtifactStore(artifacts store.ArtifactStore) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	m.artifacts = artifacts
+}
+
+// storeArtifacts stores the artifacts sent with a result, replacing their data with
+// the url for retrieving them. Resolved secret values are redacted from text artifacts.
+func (m *Manager) storeArtifacts(uuid string, artifacts []transaction.Artifact, secrets []string) (stored []transaction.Artifact) {
+	if len(artifacts) == 0 {
+		return nil
+	}
+
+	m.mtx.Lock()
+	as := m.artifacts
+	m.mtx.Unlock()
 
-			for x := 0; x <= config.RetryCount; x++ {
-				result = m.Run(config)
-				result.RetryCount = x
-				if !result.Failed && result.Error == nil {
-					break
+	if as == nil {
+		log.Debug("no artifact store, discarding result artifacts").String("uuid", uuid).
+			Int("artifacts", int64(len(artifacts))).Log()
+		return nil
+	}
+
+	for _, artifact := range artifacts {
+		if len(secrets) > 0 && textArtifact(artifact.ContentType) {
+			artifact.Data = []byte(secret.Redact(string(artifact.Data), secrets))
+		}
+
+		artifact.Size = int64(len(artifact.Data))
+		if artifact.Size > m.maxArtifact {
+			log.Warn("discarding result artifact larger than the maximum size").String("uuid", uuid).
+				String("artifact", artifact.Name).Int("size", artifact.Size).Int("max_size", m.maxArtifact).Log()
+			continue
+		}
+
+		if _, err := as.Put(uuid, artifact); err != nil {
+			log.Error("error storing result artifact").String("uuid", uuid).
+				String("artifact", artifact.Name).Error("error", err).Log()
+			continue
+		}
+
+		// storage references are internal to the server and not exposed in results
+		stored = append(stored, transaction.Artifact{Name: artifact.Name, ContentType: artifact.ContentType,
+			Size: artifact.Size, URL: fmt.Sprintf(artifactPath, uuid, artifact.Name)})
+	}
+
+	return stored
+}
+
+// prune periodically removes the results and artifacts stored longer than their retention period
+func (m *Manager) prune() {
+	defer m.pruning.Done()
+
+	interval := pruneInterval
+	for _, ttl := range []time.Duration{m.artifactTTL, m.resultTTL} {
+		if ttl > 0 && ttl < interval {
+			interval = ttl
+		}
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if m.resultTTL > 0 {
+				if err := m.results.Prune(time.Now().Add(-m.resultTTL)); err != nil {
+					log.Error("error removing expired results").Error("error", err).Log()
 				}
+			}
+
+			m.mtx.Lock()
+			as := m.artifacts
+			m.mtx.Unlock()
 
-				log.Debug("transaction failed").String("name", result.Name).
-					Error("error", result.Error).String("data", result.Data).
-					String("message", result.Message).String("uuid", result.UUID).
-					Int("retry", int64(result.RetryCount)).Log()
+			if as == nil || m.artifactTTL <= 0 {
+				continue
 			}
 
-			m.results.Store(config.Name, result)
-			for x := 0; x < len(m.emitters); x++ {
-				m.emitters[x].Emit(result)
+			if err := as.Prune(time.Now().Add(-m.artifactTTL)); err != nil {
+				log.Error("error removing expired result artifacts").Error("error", err).Log()
 			}
-		})
+		case <-m.close:
+			return
+		}
+	}
+}
+
+// GetArtifact fetches the named artifact with its data for the result with the given uuid
+func (m *Manager) GetArtifact(uuid, name string) (artifact transaction.Artifact, err error) {
+	m.mtx.Lock()
+	as := m.artifacts
+	m.mtx.Unlock()
+
+	if as == nil {
+		return artifact, fmt.Errorf("manager: %w: no artifact store configured", store.ErrArtifactNotFound)
+	}
+
+	if artifact, err = as.Get(uuid, name); err != nil {
+		return artifact, fmt.Errorf("manager: %w", err)
+	}
+
+	return artifact, nil
+}
+
+// Validate the given transaction config with the manager validator.
+// The returned error is of type validate.Errors when the config is invalid.
+func (m *Manager) Validate(config transaction.Config) (err error) {
+	if m.validator == nil {
+		return nil
+	}
+	return m.validator.Validate(config)
 }
 
 // Add adds a replicant transaction to the manager and scheduler if the scheduling
 // spec is provided
 func (m *Manager) Add(config transaction.Config) (err error) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	if err = m.Validate(config); err != nil {
+		return fmt.Errorf("manager: %w", err)
+	}
+
 	ok, err := m.transactions.Has(config.Name)
 	if err != nil {
 		return fmt.Errorf("manager: %w", err)
@@ -220,15 +526,66 @@ func (m *Manager) Add(config transaction.Config) (err error) {
 	return m.transactions.Set(config.Name, config)
 }
 
+// Update replaces an existing replicant transaction in the manager and atomically
+// reschedules it with the new scheduling spec. Existing results are kept.
+func (m *Manager) Update(config transaction.Config) (err error) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	previous, err := m.transactions.Get(config.Name)
+	if err != nil {
+		return fmt.Errorf("manager: %w", err)
+	}
+
+	if err = m.Validate(config); err != nil {
+		return fmt.Errorf("manager: %w", err)
+	}
+
+	if err = m.reschedule(config); err != nil {
+		return fmt.Errorf("manager: %w", err)
+	}
+
+	if err = m.transactions.Set(config.Name, config); err != nil {
+		// restore the previous transaction schedule
+		if rerr := m.reschedule(previous); rerr != nil {
+			log.Error("error restoring transaction schedule").
+				String("name", previous.Name).Error("error", rerr).Log()
+		}
+		return fmt.Errorf("manager: %w", err)
+	}
+
+	return nil
+}
+
 // Delete a transaction from the manager by name
 func (m *Manager) Delete(name string) (err error) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
 
 	if err = m.transactions.Delete(name); err != nil {
 		return fmt.Errorf("manager: %w", err)
 	}
 
 	m.scheduler.RemoveTask(name)
-	m.results.Delete(name)
+	return nil
+}
+
+// Executors returns the state of the manager executor pool
+func (m *Manager) Executors() (status []ExecutorStatus) {
+	return m.executors.status()
+}
+
+// RegisterExecutor adds or refreshes an executor in the manager executor pool
+func (m *Manager) RegisterExecutor(r Registration) (err error) {
+	if r.URL == "" {
+		return fmt.Errorf("manager: executor registration without url")
+	}
+
+	if len(r.Drivers) == 0 {
+		return fmt.Errorf("manager: executor registration without drivers")
+	}
+
+	m.executors.register(r)
 	return nil
 }
 
@@ -239,6 +596,11 @@ func (m *Manager) AddEmitter(emitter Emitter) {
 	m.emitters = append(m.emitters, emitter)
 }
 
+// Stream returns the emitter streaming results produced by this manager
+func (m *Manager) Stream() (s *stream.Emitter) {
+	return m.stream
+}
+
 // AddEmitterFunc is like SetEmitter, but it takes a EmitterFunc as input
 func (m *Manager) AddEmitterFunc(emitter func(result transaction.Result)) {
 	m.AddEmitter(EmitterFunc(emitter))
@@ -272,24 +634,69 @@ func (m *Manager) RunByName(name string) (result transaction.Result, err error)
 
 // GetResult fetches the latest result from a managed transaction
 func (m *Manager) GetResult(name string) (result transaction.Result, err error) {
-	v, ok := m.results.Load(name)
-	if !ok {
+	results, err := m.results.Query(store.ResultQuery{Name: name, Limit: 1})
+	if err != nil {
+		return result, fmt.Errorf("manager: %w", err)
+	}
+
+	if len(results) == 0 {
 		return result, fmt.Errorf("manager: no results found")
 	}
 
-	return v.(transaction.Result), nil
+	return results[0], nil
 }
 
-// GetResults fetches all latest results
+// GetResults fetches the latest results for all managed transactions
 func (m *Manager) GetResults() (results []transaction.Result) {
-	m.results.Range(func(_ interface{}, v interface{}) (proceed bool) {
-		results = append(results, v.(transaction.Result))
+	m.transactions.Iter(func(name string, _ transaction.Config) (proceed bool) {
+		result, err := m.GetResult(name)
+		if err == nil {
+			results = append(results, result)
+		}
 		return true
 	})
 
 	return results
 }
 
+// QueryResults fetches the stored results matching the given query
+func (m *Manager) QueryResults(query store.ResultQuery) (results []transaction.Result, err error) {
+	results, err = m.results.Query(query)
+	if err != nil {
+		return nil, fmt.Errorf("manager: %w", err)
+	}
+
+	return results, nil
+}
+
+// redact resolved secret values from the result
+func redact(r transaction.Result, secrets []string) (result transaction.Result) {
+	if len(secrets) == 0 {
+		return r
+	}
+
+	r.Message = secret.Redact(r.Message, secrets)
+	r.Data = secret.Redact(r.Data, secrets)
+	if r.Error != nil {
+		if msg := secret.Redact(r.Error.Error(), secrets); msg != r.Error.Error() {
+			r.Error = errors.New(msg)
+		}
+	}
+
+	return r
+}
+
+// textArtifact checks if the artifact content type is text based, eg: html, json or HAR
+func textArtifact(contentType string) (ok bool) {
+	mt, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+
+	return strings.HasPrefix(mt, "text/") || strings.HasSuffix(mt, "json") ||
+		strings.HasSuffix(mt, "xml") || strings.HasSuffix(mt, "javascript")
+}
+
 func wrapErrorResult(uuid string, c transaction.Config, start time.Time, err error) (r transaction.Result) {
 	r.Name = c.Name
 	r.Driver = c.Driver
@@ -0,0 +1,408 @@
+package manager
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Unbabel/replicant/secret"
+	"github.com/Unbabel/replicant/store"
+	"github.com/Unbabel/replicant/store/fs"
+	"github.com/Unbabel/replicant/store/memory"
+	"github.com/Unbabel/replicant/transaction"
+)
+
+func TestManagerRunRedactsSecrets(t *testing.T) {
+	// executor echoing the rendered script in the result
+	executor := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var config transaction.Config
+		json.NewDecoder(r.Body).Decode(&config)
+		json.NewEncoder(w).Encode(&transaction.Result{
+			Name: config.Name, Message: "using " + config.Script, Data: config.Script})
+	}))
+	defer executor.Close()
+
+	secrets := secret.ProviderFunc(func(key string) (value string, err error) {
+		if key == "payments/api_key" {
+			return "s3cr3t", nil
+		}
+		return "", secret.ErrSecretNotFound
+	})
+
+	s, _ := memory.New("")
+	rs, _ := memory.NewResultStore("")
+	m := New(Config{ExecutorURLs: []string{executor.URL}, HealthCheckInterval: time.Hour, Secrets: secrets}, s, rs)
+	defer m.Close()
+
+	result := m.Run(transaction.Config{
+		Name:   "test-transaction",
+		Driver: "javascript",
+		Inputs: map[string]interface{}{"api_key": `{{ secret "payments/api_key" }}`},
+		Script: `{{ index . "api_key" }}`,
+	})
+
+	if result.Error != nil {
+		t.Fatalf("Manager.Run() error = %s", result.Error)
+	}
+
+	if strings.Contains(result.Message, "s3cr3t") || result.Data != secret.Redacted {
+		t.Fatalf("Manager.Run() secret not redacted: %#v", result)
+	}
+
+	// secrets are rendered without inputs
+	result = m.Run(transaction.Config{
+		Name:   "test-transaction",
+		Driver: "javascript",
+		Script: `{{ secret "payments/api_key" }}`,
+	})
+
+	if result.Error != nil || result.Data != secret.Redacted {
+		t.Fatalf("Manager.Run() secret not rendered or redacted without inputs: %#v", result)
+	}
+
+	// scripts without inputs or secrets are not rendered
+	script := "package transaction\n\nvar matrix = [][]int{{1}}\n"
+	result = m.Run(transaction.Config{Name: "test-transaction", Driver: "go", Script: script})
+
+	if result.Error != nil || result.Data != script {
+		t.Fatalf("Manager.Run() script rendered without inputs or secrets: %#v", result)
+	}
+
+	result = m.Run(transaction.Config{
+		Name:   "test-transaction",
+		Driver: "javascript",
+		Inputs: map[string]interface{}{"api_key": `{{ secret "payments/missing" }}`},
+	})
+
+	if !result.Failed || result.Error == nil {
+		t.Fatalf("Manager.Run() expected error for missing secret, got %#v", result)
+	}
+}
+
+func TestManagerRunStoresArtifacts(t *testing.T) {
+	executor := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		uuid := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+		json.NewEncoder(w).Encode(&transaction.Result{UUID: uuid, Name: "test-transaction", Failed: true,
+			Artifacts: []transaction.Artifact{{Name: "dom.html", ContentType: "text/html", Data: []byte("<html></html>")}}})
+	}))
+	defer executor.Close()
+
+	dir, err := ioutil.TempDir("", "replicant-artifacts")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	as, err := fs.NewArtifactStore("fs:" + dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s, _ := memory.New("")
+	rs, _ := memory.NewResultStore("")
+	m := New(Config{ExecutorURLs: []string{executor.URL}, HealthCheckInterval: time.Hour}, s, rs)
+	defer m.Close()
+
+	// discarded without an artifact store
+	result := m.Run(transaction.Config{Name: "test-transaction", Driver: "web"})
+	if len(result.Artifacts) != 0 {
+		t.Fatalf("Manager.Run() expected no artifacts, got %#v", result.Artifacts)
+	}
+
+	m.SetArtifactStore(as)
+	result = m.Run(transaction.Config{Name: "test-transaction", Driver: "web"})
+	if len(result.Artifacts) != 1 {
+		t.Fatalf("Manager.Run() expected 1 artifact, got %#v", result.Artifacts)
+	}
+
+	artifact := result.Artifacts[0]
+	if artifact.Data != nil || artifact.Ref != "" || artifact.Size != 13 ||
+		artifact.URL != "/api/v1/result/"+result.UUID+"/artifacts/dom.html" {
+		t.Fatalf("Manager.Run() unexpected artifact: %#v", artifact)
+	}
+
+	artifact, err = m.GetArtifact(result.UUID, "dom.html")
+	if err != nil {
+		t.Fatalf("Manager.GetArtifact() error = %s", err)
+	}
+
+	if string(artifact.Data) != "<html></html>" || artifact.ContentType != "text/html" {
+		t.Fatalf("Manager.GetArtifact() unexpected artifact: %#v", artifact)
+	}
+}
+
+func TestManagerRunRedactsArtifacts(t *testing.T) {
+	// executor echoing the rendered script in text and binary artifacts
+	executor := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		uuid := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+		var config transaction.Config
+		json.NewDecoder(r.Body).Decode(&config)
+		json.NewEncoder(w).Encode(&transaction.Result{UUID: uuid, Name: config.Name, Failed: true,
+			Artifacts: []transaction.Artifact{
+				{Name: "network.har", ContentType: "application/json", Data: []byte(config.Script)},
+				{Name: "dom.html", ContentType: "text/html; charset=utf-8", Data: []byte(config.Script)},
+				{Name: "pixel.bin", ContentType: "application/octet-stream", Data: []byte(config.Script)}}})
+	}))
+	defer executor.Close()
+
+	secrets := secret.ProviderFunc(func(key string) (value string, err error) {
+		return "s3cr3t", nil
+	})
+
+	dir, err := ioutil.TempDir("", "replicant-artifacts")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	as, err := fs.NewArtifactStore("fs:" + dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s, _ := memory.New("")
+	rs, _ := memory.NewResultStore("")
+	m := New(Config{ExecutorURLs: []string{executor.URL}, HealthCheckInterval: time.Hour, Secrets: secrets}, s, rs)
+	defer m.Close()
+	m.SetArtifactStore(as)
+
+	result := m.Run(transaction.Config{Name: "test-transaction", Driver: "web",
+		Script: `Authorization: {{ secret "payments/api_key" }}`})
+	if len(result.Artifacts) != 3 {
+		t.Fatalf("Manager.Run() expected 3 artifacts, got %#v", result.Artifacts)
+	}
+
+	for _, name := range []string{"network.har", "dom.html"} {
+		artifact, err := m.GetArtifact(result.UUID, name)
+		if err != nil {
+			t.Fatalf("Manager.GetArtifact() error = %s", err)
+		}
+
+		if string(artifact.Data) != "Authorization: "+secret.Redacted {
+			t.Fatalf("Manager.GetArtifact() secret not redacted from %s: %s", name, artifact.Data)
+		}
+	}
+
+	// binary artifacts are stored as sent
+	artifact, err := m.GetArtifact(result.UUID, "pixel.bin")
+	if err != nil || string(artifact.Data) != "Authorization: s3cr3t" {
+		t.Fatalf("Manager.GetArtifact() unexpected binary artifact: %v, %s", err, artifact.Data)
+	}
+}
+
+func TestManagerTaskRetriesShareUUID(t *testing.T) {
+	var mtx sync.Mutex
+	var uuids []string
+	executor := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.URL.Path, executorRunPath) {
+			return
+		}
+
+		uuid := strings.TrimPrefix(r.URL.Path, executorRunPath)
+		mtx.Lock()
+		uuids = append(uuids, uuid)
+		mtx.Unlock()
+		json.NewEncoder(w).Encode(&transaction.Result{UUID: uuid, Name: "test-transaction", Failed: true})
+	}))
+	defer executor.Close()
+
+	s, _ := memory.New("")
+	rs, _ := memory.NewResultStore("")
+	m := New(Config{ExecutorURLs: []string{executor.URL}, HealthCheckInterval: time.Hour}, s, rs)
+	defer m.Close()
+
+	m.task(transaction.Config{Name: "test-transaction", Driver: "web", RetryCount: 2})()
+
+	mtx.Lock()
+	defer mtx.Unlock()
+	if len(uuids) != 3 || uuids[0] != uuids[1] || uuids[1] != uuids[2] {
+		t.Fatalf("Manager.task() expected retries with the same uuid, got %v", uuids)
+	}
+}
+
+func TestManagerRunResponseLimit(t *testing.T) {
+	executor := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"name": "`))
+		w.Write(make([]byte, maxResponseSize))
+	}))
+	defer executor.Close()
+
+	s, _ := memory.New("")
+	rs, _ := memory.NewResultStore("")
+	m := New(Config{ExecutorURLs: []string{executor.URL}, HealthCheckInterval: time.Hour}, s, rs)
+	defer m.Close()
+
+	result := m.Run(transaction.Config{Name: "test-transaction", Driver: "web"})
+	if result.Error == nil || !strings.Contains(result.Error.Error(), "larger than") {
+		t.Fatalf("Manager.Run() expected response size error, got %v", result.Error)
+	}
+}
+
+func TestManagerArtifactLimits(t *testing.T) {
+	executor := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		uuid := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+		json.NewEncoder(w).Encode(&transaction.Result{UUID: uuid, Name: "test-transaction", Failed: true,
+			Artifacts: []transaction.Artifact{
+				{Name: "dom.html", ContentType: "text/html", Data: []byte("<html></html>")},
+				{Name: "console.json", ContentType: "application/json", Data: []byte("[]")}}})
+	}))
+	defer executor.Close()
+
+	dir, err := ioutil.TempDir("", "replicant-artifacts")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	as, err := fs.NewArtifactStore("fs:" + dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s, _ := memory.New("")
+	rs, _ := memory.NewResultStore("")
+	m := New(Config{ExecutorURLs: []string{executor.URL}, HealthCheckInterval: time.Hour,
+		ArtifactMaxSize: 8, ArtifactRetention: 100 * time.Millisecond}, s, rs)
+	defer m.Close()
+	m.SetArtifactStore(as)
+
+	result := m.Run(transaction.Config{Name: "test-transaction", Driver: "web"})
+	if len(result.Artifacts) != 1 || result.Artifacts[0].Name != "console.json" {
+		t.Fatalf("Manager.Run() expected only the artifact within the size limit, got %#v", result.Artifacts)
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		_, err = m.GetArtifact(result.UUID, "console.json")
+		if errors.Is(err, store.ErrArtifactNotFound) {
+			break
+		}
+
+		if time.Now().After(deadline) {
+			t.Fatalf("Manager.GetArtifact() expected expired artifact to be removed, got error %v", err)
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+}
+
+func TestManagerUpdate(t *testing.T) {
+	s, _ := memory.New("")
+	rs, _ := memory.NewResultStore("")
+	m := New(Config{HealthCheckInterval: time.Hour}, s, rs)
+	defer m.Close()
+
+	config := transaction.Config{Name: "test-transaction", Driver: "web", Schedule: "* * * * *"}
+	if err := m.Update(config); err == nil {
+		t.Fatal("Manager.Update() expected error for unmanaged transaction")
+	}
+
+	if err := m.Add(config); err != nil {
+		t.Fatalf("Manager.Add() error = %s", err)
+	}
+
+	config.Schedule = "*/5 * * * *"
+	config.Script = "updated"
+	if err := m.Update(config); err != nil {
+		t.Fatalf("Manager.Update() error = %s", err)
+	}
+
+	invalid := config
+	invalid.Schedule = "invalid"
+	invalid.Script = "invalid"
+	if err := m.Update(invalid); err == nil {
+		t.Fatal("Manager.Update() expected error for invalid schedule")
+	}
+
+	got, err := m.Get(config.Name)
+	if err != nil {
+		t.Fatalf("Manager.Get() error = %s", err)
+	}
+
+	if got.Script != "updated" || got.Schedule != config.Schedule {
+		t.Fatalf("Manager.Update() unexpected transaction: %#v", got)
+	}
+
+	entries := m.scheduler.Entries()
+	if len(entries) != 1 || entries[0].Schedule != config.Schedule {
+		t.Fatalf("Manager.Update() unexpected schedule: %#v", entries)
+	}
+
+	// unscheduled transactions are removed from the scheduler
+	config.Schedule = ""
+	if err = m.Update(config); err != nil {
+		t.Fatalf("Manager.Update() error = %s", err)
+	}
+
+	if entries = m.scheduler.Entries(); len(entries) != 0 {
+		t.Fatalf("Manager.Update() unexpected schedule: %#v", entries)
+	}
+}
+
+func TestManagerResultRetention(t *testing.T) {
+	s, _ := memory.New("")
+	rs, _ := memory.NewResultStore("")
+	m := New(Config{HealthCheckInterval: time.Hour, ResultRetention: 50 * time.Millisecond}, s, rs)
+	defer m.Close()
+
+	config := transaction.Config{Name: "test-transaction", Driver: "web", Schedule: "@every 1h"}
+	if err := m.Add(config); err != nil {
+		t.Fatalf("Manager.Add() error = %s", err)
+	}
+
+	rs.Append(transaction.Result{UUID: "1", Name: config.Name, Time: time.Now().Add(-time.Hour)})
+	rs.Append(transaction.Result{UUID: "2", Name: config.Name, Time: time.Now().Add(time.Hour)})
+
+	// the history of removed transactions is kept
+	if err := m.Delete(config.Name); err != nil {
+		t.Fatalf("Manager.Delete() error = %s", err)
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		results, err := rs.Query(store.ResultQuery{Name: config.Name})
+		if err != nil {
+			t.Fatalf("ResultStore.Query() error = %s", err)
+		}
+
+		if len(results) == 1 && results[0].UUID == "2" {
+			return
+		}
+
+		if time.Now().After(deadline) {
+			t.Fatalf("expected only the results within retention, got %#v", results)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// closeEmitter records if it was closed
+type closeEmitter struct {
+	closed bool
+}
+
+func (e *closeEmitter) Emit(result transaction.Result) {}
+func (e *closeEmitter) Close()                         { e.closed = true }
+
+func TestManagerCloseEmitters(t *testing.T) {
+	s, _ := memory.New("")
+	rs, _ := memory.NewResultStore("")
+	m := New(Config{HealthCheckInterval: time.Hour}, s, rs)
+
+	e := &closeEmitter{}
+	m.AddEmitter(e)
+
+	if err := m.Close(); err != nil {
+		t.Fatalf("Manager.Close() error = %s", err)
+	}
+
+	if !e.closed {
+		t.Fatal("Manager.Close() emitter not closed")
+	}
+}
@@ -0,0 +1,26 @@
+// Package registration defines the executor registrations sent to replicant servers.
+// It is shared by the manager and executors without either depending on the other.
+package registration
+
+/*
+   Copyright 2019 Bruno Moura <brunotm@gmail.com>
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Registration is sent by executors for registering themselves with the manager.
+// Executors must keep sending registrations as heartbeats.
+type Registration struct {
+	URL     string   `json:"url" yaml:"url"`
+	Drivers []string `json:"drivers" yaml:"drivers"`
+}
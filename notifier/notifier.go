@@ -0,0 +1,309 @@
+// Package notifier implements result change notifications. It tracks the state
+// of each transaction and notifies webhook targets on state transitions.
+package notifier
+
+/*
+   Copyright 2019 Bruno Moura <brunotm@gmail.com>
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/Unbabel/replicant/log"
+	"github.com/Unbabel/replicant/transaction"
+	"gopkg.in/yaml.v2"
+)
+
+// State of a transaction
+type State string
+
+const (
+	// StatePassing transaction is passing
+	StatePassing State = "passing"
+
+	// StateFailing transaction failed at least the threshold of consecutive times
+	StateFailing State = "failing"
+
+	// StateFlapping transaction is changing between passing and failing
+	StateFlapping State = "flapping"
+)
+
+const (
+	// DefaultThreshold of consecutive failures for a transaction to be failing
+	DefaultThreshold = 1
+
+	// DefaultQueueSize of pending notifications
+	DefaultQueueSize = 1024
+
+	// DefaultTimeout for sending notifications
+	DefaultTimeout = time.Second * 10
+)
+
+// Config for the notifier
+type Config struct {
+	// Threshold of consecutive failures for a transaction to be failing
+	Threshold int `json:"threshold" yaml:"threshold"`
+
+	// Flapping detection, disabled if unspecified
+	Flapping FlappingConfig `json:"flapping" yaml:"flapping"`
+
+	// Timeout for sending notifications
+	Timeout time.Duration `json:"timeout" yaml:"timeout"`
+
+	// QueueSize of pending notifications. Notifications are dropped when full.
+	QueueSize int `json:"queue_size" yaml:"queue_size"`
+
+	// Targets for notifications
+	Targets []Target `json:"targets" yaml:"targets"`
+}
+
+// FlappingConfig for flapping detection. A transaction is flapping when its result
+// changed between passing and failing at least Transitions times within the last Window results.
+type FlappingConfig struct {
+	Window      int `json:"window" yaml:"window"`
+	Transitions int `json:"transitions" yaml:"transitions"`
+}
+
+// Notification for a transaction state transition. Repeat notifications are
+// created for every result while a transaction is not passing, and are only
+// sent to alertmanager targets for keeping their alerts active.
+type Notification struct {
+	Name     string             `json:"name" yaml:"name"`
+	State    State              `json:"state" yaml:"state"`
+	Previous State              `json:"previous" yaml:"previous"`
+	Failures int                `json:"failures" yaml:"failures"`
+	Repeat   bool               `json:"repeat" yaml:"repeat"`
+	Since    time.Time          `json:"since" yaml:"since"`
+	Time     time.Time          `json:"time" yaml:"time"`
+	Result   transaction.Result `json:"result" yaml:"result"`
+}
+
+// Recovered checks if this notification is a recovery from failing or flapping
+func (n Notification) Recovered() (ok bool) {
+	return n.State == StatePassing
+}
+
+// Error returns the result error message, if any
+func (n Notification) Error() (s string) {
+	if n.Result.Error != nil {
+		return n.Result.Error.Error()
+	}
+	return ""
+}
+
+// state of a tracked transaction
+type state struct {
+	current  State
+	since    time.Time
+	failures int
+	history  []bool
+}
+
+// Notifier tracks transaction states from results and notifies targets on
+// state transitions. It implements the manager.Emitter interface.
+type Notifier struct {
+	mtx     sync.Mutex
+	config  Config
+	client  *http.Client
+	states  map[string]*state
+	targets []*target
+	queue   chan Notification
+	wg      sync.WaitGroup
+}
+
+// LoadConfig reads the notifier config from a yaml or json file
+func LoadConfig(path string) (c Config, err error) {
+	buf, err := ioutil.ReadFile(path)
+	if err != nil {
+		return c, fmt.Errorf("notifier: error reading config: %w", err)
+	}
+
+	if err = yaml.Unmarshal(buf, &c); err != nil {
+		return c, fmt.Errorf("notifier: error reading config: %w", err)
+	}
+
+	return c, nil
+}
+
+// New creates a new notifier
+func New(c Config) (n *Notifier, err error) {
+	if c.Threshold <= 0 {
+		c.Threshold = DefaultThreshold
+	}
+
+	if c.Timeout == 0 {
+		c.Timeout = DefaultTimeout
+	}
+
+	if c.QueueSize <= 0 {
+		c.QueueSize = DefaultQueueSize
+	}
+
+	n = &Notifier{}
+	n.config = c
+	n.client = &http.Client{Timeout: c.Timeout}
+	n.states = make(map[string]*state)
+	n.queue = make(chan Notification, c.QueueSize)
+
+	for _, tc := range c.Targets {
+		t, err := newTarget(tc)
+		if err != nil {
+			return nil, err
+		}
+		n.targets = append(n.targets, t)
+	}
+
+	n.wg.Add(1)
+	go n.run()
+
+	return n, nil
+}
+
+// Emit updates the transaction state with the given result and queues
+// a notification if the state changed or the transaction is not passing
+func (n *Notifier) Emit(result transaction.Result) {
+	notification, ok := n.update(result)
+	if !ok {
+		return
+	}
+
+	select {
+	case n.queue <- notification:
+	default:
+		log.Warn("notification queue full, dropping notification").
+			String("name", notification.Name).String("state", string(notification.State)).Log()
+	}
+}
+
+// Close the notifier after sending the pending notifications
+func (n *Notifier) Close() {
+	close(n.queue)
+	n.wg.Wait()
+}
+
+// State returns the current state for the named transaction
+func (n *Notifier) State(name string) (s State, ok bool) {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+
+	st, ok := n.states[name]
+	if !ok {
+		return "", false
+	}
+	return st.current, true
+}
+
+// update the transaction state with the given result and return a notification on transitions,
+// or a repeat notification when not passing. Transactions are assumed to be passing until proven otherwise.
+func (n *Notifier) update(result transaction.Result) (notification Notification, ok bool) {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+
+	st, exists := n.states[result.Name]
+	if !exists {
+		st = &state{current: StatePassing}
+		n.states[result.Name] = st
+	}
+
+	failed := result.Failed || result.Error != nil
+	if failed {
+		st.failures++
+	} else {
+		st.failures = 0
+	}
+
+	if window := n.config.Flapping.Window; window > 0 {
+		st.history = append(st.history, failed)
+		if len(st.history) > window {
+			st.history = st.history[len(st.history)-window:]
+		}
+	}
+
+	// failures under the threshold keep the current state, so transactions
+	// no longer flapping stay flapping until passing or reaching the threshold
+	next := st.current
+	switch {
+	case n.flapping(st):
+		next = StateFlapping
+	case !failed:
+		next = StatePassing
+	case st.failures >= n.config.Threshold:
+		next = StateFailing
+	}
+
+	if next == st.current && next == StatePassing {
+		return notification, false
+	}
+
+	notification = Notification{
+		Name:     result.Name,
+		State:    next,
+		Previous: st.current,
+		Failures: st.failures,
+		Repeat:   next == st.current,
+		Time:     time.Now(),
+		Result:   result,
+	}
+
+	if !notification.Repeat {
+		st.current = next
+		st.since = notification.Time
+	}
+	notification.Since = st.since
+
+	return notification, true
+}
+
+// flapping checks if the transaction history has enough transitions to be flapping
+func (n *Notifier) flapping(st *state) (ok bool) {
+	if n.config.Flapping.Transitions <= 0 {
+		return false
+	}
+
+	transitions := 0
+	for x := 1; x < len(st.history); x++ {
+		if st.history[x] != st.history[x-1] {
+			transitions++
+		}
+	}
+
+	return transitions >= n.config.Flapping.Transitions
+}
+
+// run sends queued notifications to the matching targets
+func (n *Notifier) run() {
+	defer n.wg.Done()
+
+	for notification := range n.queue {
+		for _, t := range n.targets {
+			if notification.Repeat && t.config.Format != FormatAlertmanager {
+				continue
+			}
+
+			if !t.match(notification.Result.Metadata) {
+				continue
+			}
+
+			if err := t.send(n.client, notification); err != nil {
+				log.Error("error sending notification").String("target", t.config.Name).
+					String("name", notification.Name).Error("error", err).Log()
+			}
+		}
+	}
+}
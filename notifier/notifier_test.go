@@ -0,0 +1,184 @@
+package notifier
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/Unbabel/replicant/transaction"
+)
+
+func TestNotifierTransitions(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  Config
+		results []bool // failed
+		want    []State
+	}{
+		{name: "no transition", results: []bool{false, false, false}, want: nil},
+		{name: "failing and recovery", results: []bool{false, true, true, false},
+			want: []State{StateFailing, StatePassing}},
+		{name: "threshold", config: Config{Threshold: 3}, results: []bool{true, true, false, true, true, true, true},
+			want: []State{StateFailing}},
+		{name: "flapping", config: Config{Threshold: 2, Flapping: FlappingConfig{Window: 6, Transitions: 3}},
+			results: []bool{true, false, true, false, false, false, false, false, false},
+			want:    []State{StateFlapping, StatePassing}},
+		{name: "flapping to failing", config: Config{Threshold: 2, Flapping: FlappingConfig{Window: 4, Transitions: 3}},
+			results: []bool{true, false, true, false, true, true, true, true},
+			want:    []State{StateFlapping, StateFailing}},
+		{name: "flapping failing under threshold", config: Config{Threshold: 4, Flapping: FlappingConfig{Window: 5, Transitions: 3}},
+			results: []bool{true, false, true, false, true, true, true, true},
+			want:    []State{StateFlapping, StateFailing}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n, err := New(tt.config)
+			if err != nil {
+				t.Fatalf("New() error = %s", err)
+			}
+			defer n.Close()
+
+			var got []State
+			for _, failed := range tt.results {
+				if notification, ok := n.update(transaction.Result{Name: "test", Failed: failed}); ok && !notification.Repeat {
+					got = append(got, notification.State)
+				}
+			}
+
+			if strings.Join(states(got), ",") != strings.Join(states(tt.want), ",") {
+				t.Fatalf("transitions = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func states(s []State) (r []string) {
+	for _, st := range s {
+		r = append(r, string(st))
+	}
+	return r
+}
+
+func TestNotifierTargets(t *testing.T) {
+	var mtx sync.Mutex
+	received := map[string][]string{}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		buf, _ := ioutil.ReadAll(r.Body)
+		mtx.Lock()
+		received[r.URL.Path] = append(received[r.URL.Path], string(buf))
+		mtx.Unlock()
+	}))
+	defer srv.Close()
+
+	n, err := New(Config{Targets: []Target{
+		{Name: "slack", URL: srv.URL + "/slack", Format: FormatSlack, Match: map[string]string{"environment": "production"}},
+		{Name: "alertmanager", URL: srv.URL + "/alertmanager", Format: FormatAlertmanager,
+			Match: map[string]string{"environment": "production", "component": "payments"}},
+		{Name: "custom", URL: srv.URL + "/custom", Template: `{"name":{{ json .Name }},"state":"{{ .State }}"}`},
+	}})
+	if err != nil {
+		t.Fatalf("New() error = %s", err)
+	}
+
+	production := map[string]string{"environment": "production", "component": "search"}
+	n.Emit(transaction.Result{Name: "search", Failed: true, Error: errors.New("timeout"), Metadata: production})
+	n.Emit(transaction.Result{Name: "search", Failed: true, Metadata: production})
+	n.Emit(transaction.Result{Name: "search", Metadata: production})
+	n.Emit(transaction.Result{Name: "staging", Failed: true, Metadata: map[string]string{"environment": "staging"}})
+	n.Close()
+
+	if len(received["/slack"]) != 2 {
+		t.Fatalf("expected failing and recovery slack messages, got %v", received["/slack"])
+	}
+
+	var msg struct{ Text string }
+	json.Unmarshal([]byte(received["/slack"][0]), &msg)
+	if !strings.Contains(msg.Text, "[FAILING]") || !strings.Contains(msg.Text, "timeout") {
+		t.Errorf("unexpected slack message: %s", received["/slack"][0])
+	}
+
+	if len(received["/alertmanager"]) != 0 {
+		t.Errorf("unexpected alertmanager alerts: %v", received["/alertmanager"])
+	}
+
+	want := []string{
+		`{"name":"search","state":"failing"}`,
+		`{"name":"search","state":"passing"}`,
+		`{"name":"staging","state":"failing"}`}
+	if strings.Join(received["/custom"], "\n") != strings.Join(want, "\n") {
+		t.Errorf("custom target received %v, want %v", received["/custom"], want)
+	}
+}
+
+func TestNotifierRepeatsAlerts(t *testing.T) {
+	var mtx sync.Mutex
+	received := map[string][][]alert{}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var alerts []alert
+		json.NewDecoder(r.Body).Decode(&alerts)
+		mtx.Lock()
+		received[r.URL.Path] = append(received[r.URL.Path], alerts)
+		mtx.Unlock()
+	}))
+	defer srv.Close()
+
+	n, err := New(Config{Targets: []Target{
+		{Name: "slack", URL: srv.URL + "/slack", Format: FormatSlack},
+		{Name: "alertmanager", URL: srv.URL + "/alertmanager", Format: FormatAlertmanager},
+	}})
+	if err != nil {
+		t.Fatalf("New() error = %s", err)
+	}
+
+	for x := 0; x < 3; x++ {
+		n.Emit(transaction.Result{Name: "search", Failed: true})
+	}
+	n.Emit(transaction.Result{Name: "search"})
+	n.Emit(transaction.Result{Name: "search"})
+	n.Close()
+
+	if len(received["/slack"]) != 2 {
+		t.Fatalf("expected only failing and recovery slack messages, got %d", len(received["/slack"]))
+	}
+
+	// the firing alert is sent for every failing result with the same start time
+	got := received["/alertmanager"]
+	if len(got) != 4 {
+		t.Fatalf("expected 3 firing and 1 resolved alertmanager requests, got %d", len(got))
+	}
+
+	for x, alerts := range got[:3] {
+		if len(alerts) != 1 || alerts[0].EndsAt != nil || alerts[0].StartsAt == nil ||
+			!alerts[0].StartsAt.Equal(*got[0][0].StartsAt) {
+			t.Fatalf("unexpected alerts in request %d: %#v", x, alerts)
+		}
+	}
+
+	if alerts := got[3]; len(alerts) != 1 || alerts[0].EndsAt == nil {
+		t.Fatalf("expected a resolved alert, got %#v", alerts)
+	}
+}
+
+func TestAlertmanagerAlerts(t *testing.T) {
+	n := Notification{Name: "payments", State: StatePassing, Previous: StateFailing,
+		Result: transaction.Result{Driver: "web", Metadata: map[string]string{"environment": "production"}}}
+
+	alerts := alertmanagerAlerts(n)
+	if len(alerts) != 1 || alerts[0].EndsAt == nil || alerts[0].StartsAt != nil {
+		t.Fatalf("expected a single resolved alert, got %#v", alerts)
+	}
+
+	labels := alerts[0].Labels
+	if labels["alertname"] != "ReplicantTransactionFailing" || labels["transaction"] != "payments" ||
+		labels["environment"] != "production" {
+		t.Errorf("unexpected alert labels: %v", labels)
+	}
+}
@@ -0,0 +1,252 @@
+package notifier
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"text/template"
+	"time"
+)
+
+// Target formats
+const (
+	// FormatTemplate renders the notification with a text/template
+	FormatTemplate = "template"
+
+	// FormatSlack sends slack compatible incoming webhook messages
+	FormatSlack = "slack"
+
+	// FormatAlertmanager sends alertmanager compatible alerts
+	FormatAlertmanager = "alertmanager"
+)
+
+// Target is an outgoing webhook for notifications
+type Target struct {
+	// Name of the target
+	Name string `json:"name" yaml:"name"`
+
+	// URL of the webhook
+	URL string `json:"url" yaml:"url"`
+
+	// Format of the payload: template, slack or alertmanager
+	Format string `json:"format" yaml:"format"`
+
+	// Template for the payload when using the template format. Rendered with a Notification,
+	// with the json and summary functions available.
+	Template string `json:"template" yaml:"template"`
+
+	// ContentType for the template format, application/json if unspecified
+	ContentType string `json:"content_type" yaml:"content_type"`
+
+	// Headers for the webhook requests
+	Headers map[string]string `json:"headers" yaml:"headers"`
+
+	// Match labels that must be present with the same values in the transaction metadata.
+	// An empty match routes all notifications to this target.
+	Match map[string]string `json:"match" yaml:"match"`
+}
+
+// funcs available in target templates
+var funcs = template.FuncMap{
+	// json encodes the value for embedding in json payloads
+	"json": func(v interface{}) (s string, err error) {
+		buf, err := json.Marshal(v)
+		return string(buf), err
+	},
+	"summary": summary,
+}
+
+type target struct {
+	config   Target
+	template *template.Template
+}
+
+func newTarget(c Target) (t *target, err error) {
+	if c.URL == "" {
+		return nil, fmt.Errorf("notifier: no url for target %s", c.Name)
+	}
+
+	t = &target{config: c}
+	switch c.Format {
+	case FormatSlack, FormatAlertmanager:
+	case FormatTemplate, "":
+		t.config.Format = FormatTemplate
+		if c.Template == "" {
+			return nil, fmt.Errorf("notifier: no template for target %s", c.Name)
+		}
+
+		if t.template, err = template.New(c.Name).Funcs(funcs).Parse(c.Template); err != nil {
+			return nil, fmt.Errorf("notifier: error parsing template for target %s: %w", c.Name, err)
+		}
+	default:
+		return nil, fmt.Errorf("notifier: unknown format %s for target %s", c.Format, c.Name)
+	}
+
+	if t.config.ContentType == "" {
+		t.config.ContentType = "application/json"
+	}
+
+	return t, nil
+}
+
+// match checks if the transaction metadata matches this target labels
+func (t *target) match(metadata map[string]string) (ok bool) {
+	for k, v := range t.config.Match {
+		if mv, ok := metadata[k]; !ok || mv != v {
+			return false
+		}
+	}
+	return true
+}
+
+// send the notification to this target
+func (t *target) send(client *http.Client, n Notification) (err error) {
+	var buf []byte
+
+	switch t.config.Format {
+	case FormatSlack:
+		buf, err = json.Marshal(slackMessage(n))
+	case FormatAlertmanager:
+		buf, err = json.Marshal(alertmanagerAlerts(n))
+	default:
+		var b bytes.Buffer
+		err = t.template.Execute(&b, n)
+		buf = b.Bytes()
+	}
+
+	if err != nil {
+		return fmt.Errorf("notifier: error creating payload: %w", err)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, t.config.URL, bytes.NewReader(buf))
+	if err != nil {
+		return fmt.Errorf("notifier: error creating request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", t.config.ContentType)
+	for k, v := range t.config.Headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("notifier: error sending request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		body, _ := ioutil.ReadAll(resp.Body)
+		return fmt.Errorf("notifier: unexpected response status: %s: %s", resp.Status, body)
+	}
+
+	return nil
+}
+
+// summary of the notification for humans
+func summary(n Notification) (s string) {
+	switch n.State {
+	case StatePassing:
+		return fmt.Sprintf("[RECOVERED] transaction %s is passing again after being %s", n.Name, n.Previous)
+	case StateFlapping:
+		return fmt.Sprintf("[FLAPPING] transaction %s is flapping between passing and failing", n.Name)
+	default:
+		s = fmt.Sprintf("[FAILING] transaction %s failed %d consecutive times", n.Name, n.Failures)
+		if err := n.Error(); err != "" {
+			s += ": " + err
+		}
+		return s
+	}
+}
+
+func slackMessage(n Notification) (m map[string]interface{}) {
+	color := "danger"
+	switch n.State {
+	case StatePassing:
+		color = "good"
+	case StateFlapping:
+		color = "warning"
+	}
+
+	var fields []map[string]interface{}
+	fields = append(fields, map[string]interface{}{"title": "state", "value": n.State, "short": true})
+	fields = append(fields, map[string]interface{}{"title": "driver", "value": n.Result.Driver, "short": true})
+	for k, v := range n.Result.Metadata {
+		fields = append(fields, map[string]interface{}{"title": k, "value": v, "short": true})
+	}
+
+	attachment := map[string]interface{}{
+		"color":  color,
+		"text":   n.Result.Message,
+		"fields": fields,
+		"ts":     n.Time.Unix(),
+	}
+
+	return map[string]interface{}{
+		"text":        summary(n),
+		"attachments": []interface{}{attachment},
+	}
+}
+
+// alertmanager alert, as accepted by the alertmanager /api/v1/alerts endpoint
+type alert struct {
+	Labels      map[string]string `json:"labels"`
+	Annotations map[string]string `json:"annotations"`
+	StartsAt    *time.Time        `json:"startsAt,omitempty"`
+	EndsAt      *time.Time        `json:"endsAt,omitempty"`
+}
+
+// alertmanagerAlerts creates firing alerts for failing and flapping transactions, and
+// resolves previous alerts on recovery by setting their end time. Firing alerts are
+// sent again on repeat notifications, as alertmanager resolves alerts not sent within
+// its resolve timeout.
+func alertmanagerAlerts(n Notification) (alerts []alert) {
+	labels := map[string]string{}
+	for k, v := range n.Result.Metadata {
+		labels[k] = v
+	}
+	labels["transaction"] = n.Name
+	labels["driver"] = n.Result.Driver
+
+	annotations := map[string]string{"summary": summary(n)}
+	if n.Result.Message != "" {
+		annotations["message"] = n.Result.Message
+	}
+	if err := n.Error(); err != "" {
+		annotations["error"] = err
+	}
+
+	switch n.State {
+	case StatePassing:
+		alerts = append(alerts, alert{
+			Labels: withName(labels, alertName(n.Previous)), Annotations: annotations, EndsAt: &n.Time})
+	default:
+		alerts = append(alerts, alert{
+			Labels: withName(labels, alertName(n.State)), Annotations: annotations, StartsAt: &n.Since})
+
+		// resolve the alert for the previous non passing state
+		if n.Previous != StatePassing && !n.Repeat {
+			alerts = append(alerts, alert{
+				Labels: withName(labels, alertName(n.Previous)), Annotations: annotations, EndsAt: &n.Time})
+		}
+	}
+
+	return alerts
+}
+
+func alertName(s State) (name string) {
+	if s == StateFlapping {
+		return "ReplicantTransactionFlapping"
+	}
+	return "ReplicantTransactionFailing"
+}
+
+func withName(labels map[string]string, name string) (l map[string]string) {
+	l = make(map[string]string, len(labels)+1)
+	for k, v := range labels {
+		l[k] = v
+	}
+	l["alertname"] = name
+	return l
+}
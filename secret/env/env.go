@@ -0,0 +1,68 @@
+// Package env implements a secret provider backed by environment variables.
+package env
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/Unbabel/replicant/secret"
+)
+
+// DefaultPrefix for secret environment variables
+const DefaultPrefix = "REPLICANT_SECRET_"
+
+var _ secret.Provider = (*Provider)(nil)
+
+func init() {
+	secret.Register("env",
+		func(uri string) (p secret.Provider, err error) {
+			return New(uri)
+		})
+}
+
+// Provider resolves secrets from environment variables. Keys are mapped to
+// upper case variable names with non alphanumeric characters replaced by
+// underscores, eg: payments/api_key -> REPLICANT_SECRET_PAYMENTS_API_KEY.
+type Provider struct {
+	prefix string
+}
+
+// New creates a new environment secret provider.
+// URI spec: env:[prefix], with DefaultPrefix when unspecified.
+func New(uri string) (p *Provider, err error) {
+	prefix := strings.TrimPrefix(uri, "env:")
+	if prefix == "" || prefix == uri {
+		prefix = DefaultPrefix
+	}
+
+	return &Provider{prefix: prefix}, nil
+}
+
+// Get the secret value for the given key
+func (p *Provider) Get(key string) (value string, err error) {
+	if !secret.ValidKey(key) {
+		return "", fmt.Errorf("secret/env: invalid key %s", key)
+	}
+
+	value, ok := os.LookupEnv(p.Name(key))
+	if !ok {
+		return "", fmt.Errorf("secret/env: %s: %w", key, secret.ErrSecretNotFound)
+	}
+
+	return value, nil
+}
+
+// Name returns the environment variable name for the given key
+func (p *Provider) Name(key string) (name string) {
+	return p.prefix + strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z':
+			return r - 'a' + 'A'
+		case r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+			return r
+		default:
+			return '_'
+		}
+	}, key)
+}
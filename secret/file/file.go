@@ -0,0 +1,65 @@
+// Package file implements a secret provider backed by a directory of files,
+// as mounted by kubernetes or docker secrets.
+package file
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/Unbabel/replicant/secret"
+)
+
+var _ secret.Provider = (*Provider)(nil)
+
+func init() {
+	secret.Register("file",
+		func(uri string) (p secret.Provider, err error) {
+			return New(uri)
+		})
+}
+
+// Provider resolves secrets from files in a directory. The key is the file path
+// relative to the directory and the file content is the value, without trailing newlines.
+type Provider struct {
+	dir string
+}
+
+// New creates a new file secret provider.
+// URI spec: file:/<directory>
+func New(uri string) (p *Provider, err error) {
+	dir := strings.TrimPrefix(uri, "file:")
+	if dir == "" || dir == uri {
+		return nil, fmt.Errorf("secret/file: no directory specified in uri %s", uri)
+	}
+
+	info, err := os.Stat(dir)
+	if err != nil {
+		return nil, fmt.Errorf("secret/file: %w", err)
+	}
+
+	if !info.IsDir() {
+		return nil, fmt.Errorf("secret/file: %s is not a directory", dir)
+	}
+
+	return &Provider{dir: dir}, nil
+}
+
+// Get the secret value for the given key
+func (p *Provider) Get(key string) (value string, err error) {
+	if !secret.ValidKey(key) {
+		return "", fmt.Errorf("secret/file: invalid key %s", key)
+	}
+
+	buf, err := ioutil.ReadFile(filepath.Join(p.dir, filepath.FromSlash(key)))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", fmt.Errorf("secret/file: %s: %w", key, secret.ErrSecretNotFound)
+		}
+		return "", fmt.Errorf("secret/file: %w", err)
+	}
+
+	return strings.TrimRight(string(buf), "\r\n"), nil
+}
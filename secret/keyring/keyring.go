@@ -0,0 +1,250 @@
+// Package keyring implements a secret provider backed by a local encrypted keyring file.
+// Secrets are encrypted with AES-256-GCM using a key derived from a passphrase with scrypt.
+package keyring
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/Unbabel/replicant/secret"
+	"golang.org/x/crypto/scrypt"
+)
+
+// DefaultPassphraseEnv is the environment variable holding the keyring passphrase
+const DefaultPassphraseEnv = "REPLICANT_KEYRING_PASSPHRASE"
+
+const (
+	version  = 1
+	saltSize = 16
+	keySize  = 32
+	scryptN  = 1 << 15
+	scryptR  = 8
+	scryptP  = 1
+)
+
+// ErrInvalidPassphrase the keyring could not be decrypted with the given passphrase
+var ErrInvalidPassphrase = errors.New("invalid passphrase or corrupted keyring")
+
+var _ secret.Provider = (*Keyring)(nil)
+
+func init() {
+	secret.Register("keyring",
+		func(uri string) (p secret.Provider, err error) {
+			return New(uri)
+		})
+}
+
+// file is the on disk keyring format
+type file struct {
+	Version int    `json:"version"`
+	Salt    []byte `json:"salt"`
+	Nonce   []byte `json:"nonce"`
+	Data    []byte `json:"data"`
+}
+
+// Keyring is an encrypted file of secrets
+type Keyring struct {
+	mtx        sync.RWMutex
+	path       string
+	passphrase string
+	secrets    map[string]string
+}
+
+// New opens the keyring with the passphrase from the environment.
+// URI spec: keyring:/<path>[?passphrase-env=<variable>], with DefaultPassphraseEnv
+// when the variable is unspecified.
+func New(uri string) (k *Keyring, err error) {
+	path := strings.TrimPrefix(uri, "keyring:")
+	if path == "" || path == uri {
+		return nil, fmt.Errorf("secret/keyring: no path specified in uri %s", uri)
+	}
+
+	env := DefaultPassphraseEnv
+	if idx := strings.Index(path, "?"); idx >= 0 {
+		query, err := url.ParseQuery(path[idx+1:])
+		if err != nil {
+			return nil, fmt.Errorf("secret/keyring: invalid uri %s: %w", uri, err)
+		}
+
+		if e := query.Get("passphrase-env"); e != "" {
+			env = e
+		}
+		path = path[:idx]
+	}
+
+	passphrase := os.Getenv(env)
+	if passphrase == "" {
+		return nil, fmt.Errorf("secret/keyring: no passphrase found in %s", env)
+	}
+
+	return Open(path, passphrase)
+}
+
+// Create a new empty keyring for the given path. The keyring is only written on Save.
+func Create(path, passphrase string) (k *Keyring) {
+	return &Keyring{path: path, passphrase: passphrase, secrets: make(map[string]string)}
+}
+
+// Open and decrypt the keyring at the given path
+func Open(path, passphrase string) (k *Keyring, err error) {
+	buf, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("secret/keyring: %w", err)
+	}
+
+	var f file
+	if err = json.Unmarshal(buf, &f); err != nil {
+		return nil, fmt.Errorf("secret/keyring: error reading keyring: %w", err)
+	}
+
+	if f.Version != version {
+		return nil, fmt.Errorf("secret/keyring: unsupported keyring version %d", f.Version)
+	}
+
+	aead, err := newAEAD(passphrase, f.Salt)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := aead.Open(nil, f.Nonce, f.Data, nil)
+	if err != nil {
+		return nil, fmt.Errorf("secret/keyring: %w", ErrInvalidPassphrase)
+	}
+
+	k = Create(path, passphrase)
+	if err = json.Unmarshal(data, &k.secrets); err != nil {
+		return nil, fmt.Errorf("secret/keyring: error reading secrets: %w", err)
+	}
+
+	return k, nil
+}
+
+// Get the secret value for the given key
+func (k *Keyring) Get(key string) (value string, err error) {
+	k.mtx.RLock()
+	defer k.mtx.RUnlock()
+
+	value, ok := k.secrets[key]
+	if !ok {
+		return "", fmt.Errorf("secret/keyring: %s: %w", key, secret.ErrSecretNotFound)
+	}
+
+	return value, nil
+}
+
+// Set the secret value for the given key
+func (k *Keyring) Set(key, value string) (err error) {
+	if !secret.ValidKey(key) {
+		return fmt.Errorf("secret/keyring: invalid key %s", key)
+	}
+
+	k.mtx.Lock()
+	defer k.mtx.Unlock()
+	k.secrets[key] = value
+	return nil
+}
+
+// Delete the secret for the given key
+func (k *Keyring) Delete(key string) (err error) {
+	k.mtx.Lock()
+	defer k.mtx.Unlock()
+
+	if _, ok := k.secrets[key]; !ok {
+		return fmt.Errorf("secret/keyring: %s: %w", key, secret.ErrSecretNotFound)
+	}
+
+	delete(k.secrets, key)
+	return nil
+}
+
+// Keys returns the sorted secret keys in this keyring
+func (k *Keyring) Keys() (keys []string) {
+	k.mtx.RLock()
+	defer k.mtx.RUnlock()
+
+	for key := range k.secrets {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+	return keys
+}
+
+// Save encrypts and writes the keyring to its path with a new salt and nonce
+func (k *Keyring) Save() (err error) {
+	k.mtx.RLock()
+	data, err := json.Marshal(k.secrets)
+	k.mtx.RUnlock()
+	if err != nil {
+		return fmt.Errorf("secret/keyring: error serializing secrets: %w", err)
+	}
+
+	f := file{Version: version, Salt: make([]byte, saltSize)}
+	if _, err = rand.Read(f.Salt); err != nil {
+		return fmt.Errorf("secret/keyring: error generating salt: %w", err)
+	}
+
+	aead, err := newAEAD(k.passphrase, f.Salt)
+	if err != nil {
+		return err
+	}
+
+	f.Nonce = make([]byte, aead.NonceSize())
+	if _, err = rand.Read(f.Nonce); err != nil {
+		return fmt.Errorf("secret/keyring: error generating nonce: %w", err)
+	}
+	f.Data = aead.Seal(nil, f.Nonce, data, nil)
+
+	buf, err := json.Marshal(&f)
+	if err != nil {
+		return fmt.Errorf("secret/keyring: error serializing keyring: %w", err)
+	}
+
+	// write to a temporary file first to avoid corrupting the keyring
+	tmp, err := ioutil.TempFile(filepath.Dir(k.path), ".keyring")
+	if err != nil {
+		return fmt.Errorf("secret/keyring: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err = tmp.Write(buf); err != nil {
+		tmp.Close()
+		return fmt.Errorf("secret/keyring: %w", err)
+	}
+
+	if err = tmp.Close(); err != nil {
+		return fmt.Errorf("secret/keyring: %w", err)
+	}
+
+	if err = os.Rename(tmp.Name(), k.path); err != nil {
+		return fmt.Errorf("secret/keyring: %w", err)
+	}
+
+	return nil
+}
+
+// newAEAD derives the encryption key from the passphrase and salt
+func newAEAD(passphrase string, salt []byte) (aead cipher.AEAD, err error) {
+	key, err := scrypt.Key([]byte(passphrase), salt, scryptN, scryptR, scryptP, keySize)
+	if err != nil {
+		return nil, fmt.Errorf("secret/keyring: error deriving key: %w", err)
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("secret/keyring: %w", err)
+	}
+
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,56 @@
+package keyring
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Unbabel/replicant/secret"
+)
+
+func TestKeyring(t *testing.T) {
+	dir, err := ioutil.TempDir("", "replicant-keyring")
+	if err != nil {
+		t.Fatalf("error creating temp dir: %s", err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "replicant.keyring")
+	k := Create(path, "passphrase")
+	k.Set("payments/api_key", "s3cr3t")
+	k.Set("token", "t0k3n")
+	if err = k.Save(); err != nil {
+		t.Fatalf("Keyring.Save() error = %s", err)
+	}
+
+	if _, err = Open(path, "wrong"); !errors.Is(err, ErrInvalidPassphrase) {
+		t.Fatalf("Open() with wrong passphrase error = %v, want ErrInvalidPassphrase", err)
+	}
+
+	os.Setenv("REPLICANT_TEST_KEYRING_PASSPHRASE", "passphrase")
+	defer os.Unsetenv("REPLICANT_TEST_KEYRING_PASSPHRASE")
+
+	p, err := secret.New("keyring:" + path + "?passphrase-env=REPLICANT_TEST_KEYRING_PASSPHRASE")
+	if err != nil {
+		t.Fatalf("secret.New() error = %s", err)
+	}
+
+	if value, err := p.Get("payments/api_key"); err != nil || value != "s3cr3t" {
+		t.Fatalf("Keyring.Get() = %s, %v", value, err)
+	}
+
+	k = p.(*Keyring)
+	if err = k.Delete("token"); err != nil {
+		t.Fatalf("Keyring.Delete() error = %s", err)
+	}
+
+	if _, err = k.Get("token"); !errors.Is(err, secret.ErrSecretNotFound) {
+		t.Fatalf("Keyring.Get() error = %v, want ErrSecretNotFound", err)
+	}
+
+	if keys := k.Keys(); len(keys) != 1 || keys[0] != "payments/api_key" {
+		t.Fatalf("Keyring.Keys() = %v", keys)
+	}
+}
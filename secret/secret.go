@@ -0,0 +1,167 @@
+// Package secret implements providers for resolving secrets referenced
+// from transaction inputs and scripts.
+package secret
+
+/*
+   Copyright 2019 Bruno Moura <brunotm@gmail.com>
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/Unbabel/replicant/internal/xz"
+)
+
+var (
+	registry = xz.NewMap()
+
+	// ErrSecretNotFound secret not found
+	ErrSecretNotFound = errors.New("secret not found")
+)
+
+// Redacted replaces resolved secret values in results
+const Redacted = "[REDACTED]"
+
+// Provider resolves secrets by key. Keys are slash separated paths,
+// eg: payments/api_key.
+type Provider interface {
+	// Get the secret value for the given key. Missing secrets must return ErrSecretNotFound.
+	Get(key string) (value string, err error)
+}
+
+// ProviderFunc is a function type that implements Provider
+type ProviderFunc func(key string) (value string, err error)
+
+// Get the secret value for the given key
+func (f ProviderFunc) Get(key string) (value string, err error) { return f(key) }
+
+// Chain of providers, queried in order until the secret is found
+type Chain []Provider
+
+// Get the secret value for the given key from the first provider that has it
+func (c Chain) Get(key string) (value string, err error) {
+	for _, p := range c {
+		value, err = p.Get(key)
+		if err == nil {
+			return value, nil
+		}
+
+		if !errors.Is(err, ErrSecretNotFound) {
+			return "", err
+		}
+	}
+
+	return "", fmt.Errorf("secret: %s: %w", key, ErrSecretNotFound)
+}
+
+// Supplier for secret providers
+type Supplier func(uri string) (p Provider, err error)
+
+// Register registers secret provider suppliers
+func Register(name string, s Supplier) (err error) {
+	if _, ok := registry.Load(name); ok {
+		return fmt.Errorf("secret: %s already registered", name)
+	}
+	registry.Store(name, s)
+	return nil
+}
+
+// New creates a new secret provider with the registered suppliers from the given URI.
+// URI spec: <provider>:<arguments>
+func New(uri string) (p Provider, err error) {
+	params := strings.SplitN(uri, ":", 2)
+	if len(params) == 0 {
+		return nil, fmt.Errorf("secret: invalid uri %s", uri)
+	}
+	name := params[0]
+	spi, ok := registry.Load(name)
+	if !ok {
+		return nil, fmt.Errorf("secret: %s not registered", name)
+	}
+
+	sp := spi.(Supplier)
+	return sp(uri)
+}
+
+// NewChain creates a chain of secret providers from the given URIs
+func NewChain(uris ...string) (c Chain, err error) {
+	for _, uri := range uris {
+		p, err := New(uri)
+		if err != nil {
+			return nil, err
+		}
+		c = append(c, p)
+	}
+
+	return c, nil
+}
+
+// Redact replaces all occurrences of the given secret values in s.
+// Longer values are replaced first, so that no part of a secret is left
+// when it contains another secret value.
+func Redact(s string, values []string) (r string) {
+	sorted := make([]string, 0, len(values))
+	for _, v := range values {
+		if v != "" {
+			sorted = append(sorted, v)
+		}
+	}
+
+	if len(sorted) == 0 {
+		return s
+	}
+
+	sort.SliceStable(sorted, func(i, j int) bool { return len(sorted[i]) > len(sorted[j]) })
+
+	pairs := make([]string, 0, len(sorted)*2)
+	for _, v := range sorted {
+		pairs = append(pairs, v, Redacted)
+	}
+
+	return strings.NewReplacer(pairs...).Replace(s)
+}
+
+// sensitiveHeaders are HTTP headers carrying credentials or session cookies
+var sensitiveHeaders = []string{"Authorization", "Proxy-Authorization", "Cookie", "Set-Cookie"}
+
+// SensitiveHeader checks if the given HTTP header carries credentials or session
+// cookies, which must not be included in results or artifacts
+func SensitiveHeader(name string) (ok bool) {
+	for _, h := range sensitiveHeaders {
+		if strings.EqualFold(name, h) {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidKey checks if the given key is a valid slash separated path
+// without empty, relative or hidden elements
+func ValidKey(key string) (ok bool) {
+	if key == "" {
+		return false
+	}
+
+	for _, e := range strings.Split(key, "/") {
+		if e == "" || strings.HasPrefix(e, ".") {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,94 @@
+package secret_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Unbabel/replicant/secret"
+	"github.com/Unbabel/replicant/secret/env"
+	"github.com/Unbabel/replicant/secret/file"
+)
+
+func TestChain(t *testing.T) {
+	dir, err := ioutil.TempDir("", "replicant-secrets")
+	if err != nil {
+		t.Fatalf("error creating temp dir: %s", err)
+	}
+	defer os.RemoveAll(dir)
+
+	os.MkdirAll(filepath.Join(dir, "payments"), 0700)
+	ioutil.WriteFile(filepath.Join(dir, "payments", "api_key"), []byte("file-key\n"), 0600)
+	ioutil.WriteFile(filepath.Join(dir, "token"), []byte("file-token"), 0600)
+
+	os.Setenv("REPLICANT_TEST_SECRET_PAYMENTS_API_KEY", "env-key")
+	defer os.Unsetenv("REPLICANT_TEST_SECRET_PAYMENTS_API_KEY")
+
+	e, _ := env.New("env:REPLICANT_TEST_SECRET_")
+	f, err := file.New("file:" + dir)
+	if err != nil {
+		t.Fatalf("file.New() error = %s", err)
+	}
+
+	chain := secret.Chain{e, f}
+
+	tests := []struct {
+		key   string
+		value string
+		err   error
+	}{
+		{key: "payments/api_key", value: "env-key"},
+		{key: "token", value: "file-token"},
+		{key: "missing", err: secret.ErrSecretNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			value, err := chain.Get(tt.key)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("Chain.Get() error = %v, want %v", err, tt.err)
+			}
+
+			if value != tt.value {
+				t.Errorf("Chain.Get() = %s, want %s", value, tt.value)
+			}
+		})
+	}
+
+	for _, key := range []string{"../token", "payments/../../etc/passwd", "/token", ""} {
+		if _, err := f.Get(key); err == nil || errors.Is(err, secret.ErrSecretNotFound) {
+			t.Errorf("file.Provider.Get(%q) expected invalid key error, got %v", key, err)
+		}
+	}
+}
+
+func TestRedact(t *testing.T) {
+	got := secret.Redact(`{"token":"s3cr3t","user":"admin"}`, []string{"s3cr3t", ""})
+	want := `{"token":"` + secret.Redacted + `","user":"admin"}`
+	if got != want {
+		t.Errorf("Redact() = %s, want %s", got, want)
+	}
+}
+
+func TestRedactOverlapping(t *testing.T) {
+	// the shorter secret must not leave parts of the longer one
+	got := secret.Redact("key=abc123456 pin=123", []string{"123", "abc123456"})
+	want := "key=" + secret.Redacted + " pin=" + secret.Redacted
+	if got != want {
+		t.Errorf("Redact() = %s, want %s", got, want)
+	}
+}
+
+func TestSensitiveHeader(t *testing.T) {
+	for _, name := range []string{"Authorization", "cookie", "Set-Cookie", "proxy-authorization"} {
+		if !secret.SensitiveHeader(name) {
+			t.Errorf("SensitiveHeader(%s) = false, want true", name)
+		}
+	}
+
+	if secret.SensitiveHeader("Content-Type") {
+		t.Errorf("SensitiveHeader(Content-Type) = true, want false")
+	}
+}
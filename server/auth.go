@@ -0,0 +1,285 @@
+package server
+
+/*
+   Copyright 2019 Bruno Moura <brunotm@gmail.com>
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Role of an authenticated api user
+type Role string
+
+const (
+	// RoleReader can fetch transactions, results and executor state
+	RoleReader Role = "reader"
+
+	// RoleOperator can add, update, remove and run transactions. Operators are also readers.
+	RoleOperator Role = "operator"
+
+	// RoleExecutor is used by executors for registering and proxying callbacks
+	RoleExecutor Role = "executor"
+)
+
+var (
+	// ErrUnauthenticated no or invalid credentials
+	ErrUnauthenticated = errors.New("unauthenticated")
+
+	// ErrUnauthorized insufficient role for the request
+	ErrUnauthorized = errors.New("unauthorized")
+)
+
+// Allows checks if this role grants access to resources requiring the given role
+func (r Role) Allows(required Role) (ok bool) {
+	return r == required || (r == RoleOperator && required == RoleReader)
+}
+
+// Authenticator authenticates api requests
+type Authenticator interface {
+	// Authenticate the request and return the role of the requester.
+	// Requests without valid credentials must return ErrUnauthenticated.
+	Authenticate(r *http.Request) (role Role, err error)
+}
+
+// AuthConfig for the server authenticator
+type AuthConfig struct {
+	// HtpasswdFile with lines in the user:hash[:role] format. Supported hashes
+	// are bcrypt and {SHA}. Users without a role are readers.
+	HtpasswdFile string `json:"htpasswd_file" yaml:"htpasswd_file"`
+
+	// TokenFile with lines in the name:token:role format for bearer token authentication
+	TokenFile string `json:"token_file" yaml:"token_file"`
+
+	// ExecutorSecret shared with executors, accepted as a bearer token with the executor role
+	ExecutorSecret string `json:"executor_secret" yaml:"executor_secret"`
+}
+
+type credential struct {
+	hash string
+	role Role
+}
+
+// Auth is an Authenticator using basic auth users, static bearer tokens
+// and the executor shared secret
+type Auth struct {
+	users          map[string]credential
+	tokens         map[string]Role
+	executorSecret string
+}
+
+// NewAuth creates a new authenticator from the given config
+func NewAuth(c AuthConfig) (a *Auth, err error) {
+	a = &Auth{}
+	a.users = make(map[string]credential)
+	a.tokens = make(map[string]Role)
+	a.executorSecret = c.ExecutorSecret
+
+	if c.HtpasswdFile != "" {
+		err = readAuthFile(c.HtpasswdFile, 2, func(fields []string) (err error) {
+			role := RoleReader
+			if len(fields) > 2 {
+				if role, err = parseRole(fields[2]); err != nil {
+					return err
+				}
+			}
+
+			if !strings.HasPrefix(fields[1], "$2") && !strings.HasPrefix(fields[1], "{SHA}") {
+				return fmt.Errorf("unsupported hash for user %s", fields[0])
+			}
+
+			a.users[fields[0]] = credential{hash: fields[1], role: role}
+			return nil
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("server: error reading htpasswd file: %w", err)
+		}
+	}
+
+	if c.TokenFile != "" {
+		err = readAuthFile(c.TokenFile, 3, func(fields []string) (err error) {
+			role, err := parseRole(fields[2])
+			if err != nil {
+				return err
+			}
+
+			a.tokens[fields[1]] = role
+			return nil
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("server: error reading token file: %w", err)
+		}
+	}
+
+	return a, nil
+}
+
+// Authenticate implements the Authenticator interface
+func (a *Auth) Authenticate(r *http.Request) (role Role, err error) {
+	if user, password, ok := r.BasicAuth(); ok {
+		c, ok := a.users[user]
+		if !ok || !checkHash(c.hash, password) {
+			return "", ErrUnauthenticated
+		}
+		return c.role, nil
+	}
+
+	header := r.Header.Get("Authorization")
+	if !strings.HasPrefix(header, "Bearer ") {
+		return "", ErrUnauthenticated
+	}
+	token := strings.TrimPrefix(header, "Bearer ")
+
+	if a.executorSecret != "" &&
+		subtle.ConstantTimeCompare([]byte(token), []byte(a.executorSecret)) == 1 {
+		return RoleExecutor, nil
+	}
+
+	for t, role := range a.tokens {
+		if subtle.ConstantTimeCompare([]byte(token), []byte(t)) == 1 {
+			return role, nil
+		}
+	}
+
+	return "", ErrUnauthenticated
+}
+
+// checkHash checks the password against a bcrypt or {SHA} hash
+func checkHash(hash, password string) (ok bool) {
+	if strings.HasPrefix(hash, "{SHA}") {
+		sum := sha1.Sum([]byte(password))
+		expected := "{SHA}" + base64.StdEncoding.EncodeToString(sum[:])
+		return subtle.ConstantTimeCompare([]byte(hash), []byte(expected)) == 1
+	}
+
+	return bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)) == nil
+}
+
+func parseRole(s string) (role Role, err error) {
+	switch role = Role(s); role {
+	case RoleReader, RoleOperator, RoleExecutor:
+		return role, nil
+	}
+	return "", fmt.Errorf("unknown role %s", s)
+}
+
+// readAuthFile reads colon separated entries with at least min fields.
+// Empty lines and lines starting with # are ignored.
+func readAuthFile(path string, min int, fn func(fields []string) error) (err error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		fields := strings.Split(text, ":")
+		if len(fields) < min {
+			return fmt.Errorf("line %d: expected at least %d fields", line, min)
+		}
+
+		if err = fn(fields); err != nil {
+			return fmt.Errorf("line %d: %w", line, err)
+		}
+	}
+
+	return scanner.Err()
+}
+
+// auth middleware. Requests are allowed if the requester role allows any of the
+// given roles, or if no authenticator is configured for the route.
+func (s *Server) authenticate(h Handler, roles []Role) (n Handler) {
+	return func(w http.ResponseWriter, r *http.Request, p Params) {
+		auth := s.auth
+		if auth == nil && executorOnly(roles) {
+			auth = s.exec
+		}
+
+		if auth == nil {
+			h(w, r, p)
+			return
+		}
+
+		role, err := auth.Authenticate(r)
+		if err != nil {
+			w.Header().Set("WWW-Authenticate", `Basic realm="replicant"`)
+			authError(w, err, http.StatusUnauthorized)
+			return
+		}
+
+		for _, required := range roles {
+			if role.Allows(required) {
+				h(w, r, p)
+				return
+			}
+		}
+
+		authError(w, ErrUnauthorized, http.StatusForbidden)
+	}
+}
+
+// executorOnly checks if the given roles only allow executors
+func executorOnly(roles []Role) (ok bool) {
+	for _, role := range roles {
+		if role != RoleExecutor {
+			return false
+		}
+	}
+	return len(roles) > 0
+}
+
+// defaultRoles for the given method when none are specified.
+// Reads are allowed for readers while changes require operators.
+func defaultRoles(method string, roles []Role) (r []Role) {
+	if len(roles) > 0 {
+		return roles
+	}
+
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return []Role{RoleReader}
+	default:
+		return []Role{RoleOperator}
+	}
+}
+
+// authError wraps http status codes and error messages as json responses
+func authError(w http.ResponseWriter, err error, code int) {
+	res, _ := json.Marshal(map[string]string{"error": err.Error()})
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(code)
+	w.Write(res)
+}
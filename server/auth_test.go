@@ -0,0 +1,141 @@
+package server
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/julienschmidt/httprouter"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func newTestAuth(t *testing.T, dir string) (a *Auth) {
+	hash, err := bcrypt.GenerateFromPassword([]byte("operator-password"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatalf("error hashing password: %s", err)
+	}
+
+	htpasswd := filepath.Join(dir, "htpasswd")
+	err = ioutil.WriteFile(htpasswd, []byte("# replicant users\n"+
+		"operator:"+string(hash)+":operator\n"+
+		"reader:{SHA}qUqP5cyxm6YcTAhz05Hph5gvu9M=\n"), 0600)
+	if err != nil {
+		t.Fatalf("error writing htpasswd file: %s", err)
+	}
+
+	tokens := filepath.Join(dir, "tokens")
+	err = ioutil.WriteFile(tokens, []byte("ci:ci-token:operator\ngrafana:grafana-token:reader\n"), 0600)
+	if err != nil {
+		t.Fatalf("error writing token file: %s", err)
+	}
+
+	a, err = NewAuth(AuthConfig{HtpasswdFile: htpasswd, TokenFile: tokens, ExecutorSecret: "executor-secret"})
+	if err != nil {
+		t.Fatalf("NewAuth() error = %s", err)
+	}
+
+	return a
+}
+
+func TestAuthRoles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "replicant-auth")
+	if err != nil {
+		t.Fatalf("error creating temp dir: %s", err)
+	}
+	defer os.RemoveAll(dir)
+
+	s, _ := New(Config{}, nil, httprouter.New())
+	s.SetAuthenticator(newTestAuth(t, dir))
+
+	ok := func(w http.ResponseWriter, r *http.Request, p Params) { w.WriteHeader(http.StatusOK) }
+	s.AddHandler(http.MethodGet, "/read", ok)
+	s.AddHandler(http.MethodPost, "/write", ok)
+	s.AddHandler(http.MethodPost, "/register", ok, RoleExecutor)
+
+	basic := func(user, password string) func(r *http.Request) {
+		return func(r *http.Request) { r.SetBasicAuth(user, password) }
+	}
+	bearer := func(token string) func(r *http.Request) {
+		return func(r *http.Request) { r.Header.Set("Authorization", "Bearer "+token) }
+	}
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		auth   func(r *http.Request)
+		status int
+	}{
+		{"anonymous read", http.MethodGet, "/read", func(*http.Request) {}, http.StatusUnauthorized},
+		{"wrong password", http.MethodGet, "/read", basic("operator", "wrong"), http.StatusUnauthorized},
+		{"unknown token", http.MethodGet, "/read", bearer("unknown"), http.StatusUnauthorized},
+		{"reader read", http.MethodGet, "/read", basic("reader", "test"), http.StatusOK},
+		{"reader write", http.MethodPost, "/write", basic("reader", "test"), http.StatusForbidden},
+		{"operator read", http.MethodGet, "/read", basic("operator", "operator-password"), http.StatusOK},
+		{"operator write", http.MethodPost, "/write", basic("operator", "operator-password"), http.StatusOK},
+		{"reader token write", http.MethodPost, "/write", bearer("grafana-token"), http.StatusForbidden},
+		{"operator token write", http.MethodPost, "/write", bearer("ci-token"), http.StatusOK},
+		{"operator register", http.MethodPost, "/register", bearer("ci-token"), http.StatusForbidden},
+		{"executor register", http.MethodPost, "/register", bearer("executor-secret"), http.StatusOK},
+		{"executor write", http.MethodPost, "/write", bearer("executor-secret"), http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			tt.auth(req)
+
+			w := httptest.NewRecorder()
+			s.ServeHTTP(w, req)
+
+			if w.Code != tt.status {
+				t.Errorf("%s %s status = %d, want %d", tt.method, tt.path, w.Code, tt.status)
+			}
+		})
+	}
+}
+
+func TestAuthExecutorOnly(t *testing.T) {
+	a, err := NewAuth(AuthConfig{ExecutorSecret: "executor-secret"})
+	if err != nil {
+		t.Fatalf("NewAuth() error = %s", err)
+	}
+
+	s, _ := New(Config{}, nil, httprouter.New())
+	s.SetExecutorAuthenticator(a)
+
+	ok := func(w http.ResponseWriter, r *http.Request, p Params) { w.WriteHeader(http.StatusOK) }
+	s.AddHandler(http.MethodPost, "/write", ok)
+	s.AddHandler(http.MethodGet, "/module", ok, RoleReader, RoleExecutor)
+	s.AddHandler(http.MethodPost, "/register", ok, RoleExecutor)
+
+	tests := []struct {
+		method string
+		path   string
+		token  string
+		status int
+	}{
+		{http.MethodPost, "/write", "", http.StatusOK},
+		{http.MethodGet, "/module", "", http.StatusOK},
+		{http.MethodPost, "/register", "", http.StatusUnauthorized},
+		{http.MethodPost, "/register", "wrong", http.StatusUnauthorized},
+		{http.MethodPost, "/register", "executor-secret", http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		req := httptest.NewRequest(tt.method, tt.path, nil)
+		if tt.token != "" {
+			req.Header.Set("Authorization", "Bearer "+tt.token)
+		}
+
+		w := httptest.NewRecorder()
+		s.ServeHTTP(w, req)
+
+		if w.Code != tt.status {
+			t.Errorf("%s %s with token %q status = %d, want %d", tt.method, tt.path, tt.token, w.Code, tt.status)
+		}
+	}
+}
@@ -26,6 +26,7 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/Unbabel/replicant/internal/tlsutil"
 	"github.com/Unbabel/replicant/log"
 	"github.com/Unbabel/replicant/manager"
 	"github.com/julienschmidt/httprouter"
@@ -37,6 +38,9 @@ type Config struct {
 	WriteTimeout      time.Duration `json:"write_timeout" yaml:"write_timeout"`
 	ReadTimeout       time.Duration `json:"read_timeout" yaml:"read_timeout"`
 	ReadHeaderTimeout time.Duration `json:"read_header_timeout" yaml:"read_header_timeout"`
+	TLSCertFile       string        `json:"tls_cert_file" yaml:"tls_cert_file"`
+	TLSKeyFile        string        `json:"tls_key_file" yaml:"tls_key_file"`
+	TLSClientCAFile   string        `json:"tls_client_ca_file" yaml:"tls_client_ca_file"`
 }
 
 // Server is an replicant manager and api server
@@ -45,6 +49,8 @@ type Server struct {
 	http    *http.Server
 	router  *httprouter.Router
 	manager *manager.Manager
+	auth    Authenticator
+	exec    Authenticator
 }
 
 // New creates a new replicant server
@@ -68,13 +74,27 @@ func New(config Config, m *manager.Manager, r *httprouter.Router) (server *Serve
 		server.http.ReadHeaderTimeout = config.ReadHeaderTimeout
 	}
 
+	if config.TLSCertFile != "" {
+		server.http.TLSConfig, err = tlsutil.ServerConfig(
+			config.TLSCertFile, config.TLSKeyFile, config.TLSClientCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("server: %w", err)
+		}
+	}
+
 	server.http.Handler = server.router
 	return server, nil
 }
 
 // Start serving
 func (s *Server) Start() (err error) {
-	if err = s.http.ListenAndServe(); err != http.ErrServerClosed {
+	if s.http.TLSConfig != nil {
+		err = s.http.ListenAndServeTLS("", "")
+	} else {
+		err = s.http.ListenAndServe()
+	}
+
+	if err != http.ErrServerClosed {
 		return fmt.Errorf("server: error starting http: %w", err)
 	}
 	return nil
@@ -90,6 +110,19 @@ func (s *Server) Manager() (m *manager.Manager) {
 	return s.manager
 }
 
+// SetAuthenticator sets the authenticator for api requests.
+// All requests are allowed when no authenticator is set.
+func (s *Server) SetAuthenticator(a Authenticator) {
+	s.auth = a
+}
+
+// SetExecutorAuthenticator sets the authenticator for requests to routes only
+// accessible by executors, for servers where api clients are not authenticated.
+// It is not used when an authenticator for all api requests is set.
+func (s *Server) SetExecutorAuthenticator(a Authenticator) {
+	s.exec = a
+}
+
 // ServeHTTP implements the http.Handler interface for testing and handler usage
 func (s *Server) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 	s.router.ServeHTTP(w, req)
@@ -97,20 +130,24 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 
 // Close this server
 func (s *Server) Close(ctx context.Context) (err error) {
+	// end result streams as shutdown waits for active requests
+	s.manager.Stream().Close()
 	s.http.Shutdown(ctx)
 	return s.manager.Close()
 }
 
-// AddHandler adds a handler for the given method and path
-func (s *Server) AddHandler(method, path string, handler Handler) {
+// AddHandler adds a handler for the given method and path, accessible by the given roles.
+// If no roles are given, GET requests require readers and other methods require operators.
+func (s *Server) AddHandler(method, path string, handler Handler, roles ...Role) {
 	log.Info("adding handler").String("path", path).String("method", method).Log()
-	s.router.Handle(method, path, logger(recovery(handler)))
+	s.router.Handle(method, path, logger(recovery(s.authenticate(handler, defaultRoles(method, roles)))))
 }
 
-// AddServerHandler adds a handler for the given method and path
-func (s *Server) AddServerHandler(method, path string, handler SHandler) {
+// AddServerHandler adds a handler for the given method and path, accessible by the given roles.
+// If no roles are given, GET requests require readers and other methods require operators.
+func (s *Server) AddServerHandler(method, path string, handler SHandler, roles ...Role) {
 	log.Info("adding handler").String("path", path).String("method", method).Log()
-	s.router.Handle(method, path, logger(recovery(handler(s))))
+	s.router.Handle(method, path, logger(recovery(s.authenticate(handler(s), defaultRoles(method, roles)))))
 }
 
 // SHandler is handler that has access to the server
@@ -183,3 +220,10 @@ func (w *statusWriter) Write(b []byte) (int, error) {
 	w.length += n
 	return n, err
 }
+
+// Flush implements http.Flusher for streaming responses
+func (w *statusWriter) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
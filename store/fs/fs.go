@@ -0,0 +1,134 @@
+// Package fs implements a local filesystem artifact store.
+package fs
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/Unbabel/replicant/store"
+	"github.com/Unbabel/replicant/transaction"
+)
+
+var _ store.ArtifactStore = (*ArtifactStore)(nil)
+
+func init() {
+	store.RegisterArtifactStore("fs",
+		func(uri string) (s store.ArtifactStore, err error) {
+			return NewArtifactStore(uri)
+		})
+}
+
+// ArtifactStore is a local filesystem artifact store.
+// Artifacts are stored in <path>/<uuid>/<name> with their
+// metadata in <path>/<uuid>/.meta/<name>.json
+type ArtifactStore struct {
+	path string
+}
+
+// NewArtifactStore creates a new filesystem artifact store from the given uri
+// in the fs:/<path> format
+func NewArtifactStore(uri string) (s *ArtifactStore, err error) {
+	params := strings.SplitN(uri, ":", 2)
+	if len(params) != 2 || params[1] == "" {
+		return nil, fmt.Errorf("store/fs: invalid uri %s", uri)
+	}
+
+	s = &ArtifactStore{path: params[1]}
+	if err = os.MkdirAll(s.path, 0750); err != nil {
+		return nil, fmt.Errorf("store/fs: error creating artifact directory: %w", err)
+	}
+
+	return s, nil
+}
+
+// Close the store
+func (s *ArtifactStore) Close() (err error) {
+	return nil
+}
+
+// Put stores the artifact data for the result with the given uuid
+func (s *ArtifactStore) Put(uuid string, artifact transaction.Artifact) (ref string, err error) {
+	if err = store.ValidateArtifact(uuid, artifact.Name); err != nil {
+		return "", err
+	}
+
+	if err = os.MkdirAll(filepath.Join(s.path, uuid, ".meta"), 0750); err != nil {
+		return "", fmt.Errorf("store/fs: error creating artifact directory: %w", err)
+	}
+
+	ref = filepath.Join(s.path, uuid, artifact.Name)
+	if err = ioutil.WriteFile(ref, artifact.Data, 0640); err != nil {
+		return "", fmt.Errorf("store/fs: error writing artifact: %w", err)
+	}
+
+	artifact.Data = nil
+	artifact.Ref = ref
+	buf, err := json.Marshal(&artifact)
+	if err != nil {
+		return "", fmt.Errorf("store/fs: error serializing artifact metadata: %w", err)
+	}
+
+	if err = ioutil.WriteFile(s.metaPath(uuid, artifact.Name), buf, 0640); err != nil {
+		return "", fmt.Errorf("store/fs: error writing artifact metadata: %w", err)
+	}
+
+	return ref, nil
+}
+
+// Get returns the named artifact with its data for the result with the given uuid
+func (s *ArtifactStore) Get(uuid, name string) (artifact transaction.Artifact, err error) {
+	if err = store.ValidateArtifact(uuid, name); err != nil {
+		return artifact, err
+	}
+
+	buf, err := ioutil.ReadFile(s.metaPath(uuid, name))
+	switch {
+	case os.IsNotExist(err):
+		return artifact, store.ErrArtifactNotFound
+	case err != nil:
+		return artifact, fmt.Errorf("store/fs: error reading artifact metadata: %w", err)
+	}
+
+	if err = json.Unmarshal(buf, &artifact); err != nil {
+		return artifact, fmt.Errorf("store/fs: error deserializing artifact metadata: %w", err)
+	}
+
+	artifact.Data, err = ioutil.ReadFile(filepath.Join(s.path, uuid, name))
+	switch {
+	case os.IsNotExist(err):
+		return artifact, store.ErrArtifactNotFound
+	case err != nil:
+		return artifact, fmt.Errorf("store/fs: error reading artifact: %w", err)
+	}
+
+	return artifact, nil
+}
+
+// Prune removes the artifacts of results stored before the given time
+func (s *ArtifactStore) Prune(before time.Time) (err error) {
+	dirs, err := ioutil.ReadDir(s.path)
+	if err != nil {
+		return fmt.Errorf("store/fs: error listing artifact directory: %w", err)
+	}
+
+	for _, dir := range dirs {
+		if !dir.IsDir() || !dir.ModTime().Before(before) {
+			continue
+		}
+
+		if err = os.RemoveAll(filepath.Join(s.path, dir.Name())); err != nil {
+			return fmt.Errorf("store/fs: error removing artifacts: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (s *ArtifactStore) metaPath(uuid, name string) (path string) {
+	return filepath.Join(s.path, uuid, ".meta", name+".json")
+}
@@ -0,0 +1,34 @@
+package fs
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/Unbabel/replicant/store"
+	"github.com/Unbabel/replicant/store/tests"
+)
+
+func TestArtifactStore(t *testing.T) {
+	tests.RunArtifacts(t, initArtifactStore, cleanArtifactStore)
+}
+
+func initArtifactStore(t *testing.T) store.ArtifactStore {
+	dir, err := ioutil.TempDir("", "replicant-artifacts")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s, err := NewArtifactStore("fs:" + dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return s
+}
+
+func cleanArtifactStore(t *testing.T, s store.ArtifactStore) {
+	if err := os.RemoveAll(s.(*ArtifactStore).path); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,152 @@
+package leveldb
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Unbabel/replicant/store"
+	"github.com/Unbabel/replicant/transaction"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+var _ store.ArtifactStore = (*ArtifactStore)(nil)
+
+func init() {
+	store.RegisterArtifactStore("leveldb",
+		func(uri string) (s store.ArtifactStore, err error) {
+			return NewArtifactStore(uri)
+		})
+}
+
+const (
+	metaPrefix = "m"
+	dataPrefix = "d"
+)
+
+// ArtifactStore is a leveldb result artifact store.
+// Artifact metadata is keyed by m<uuid>\x00<name> and the artifact data by d<uuid>\x00<name>.
+type ArtifactStore struct {
+	uri  string
+	data *leveldb.DB
+}
+
+// artifactMeta is the stored artifact metadata
+type artifactMeta struct {
+	Time time.Time `json:"time"`
+	transaction.Artifact
+}
+
+// NewArtifactStore creates a new leveldb result artifact store
+func NewArtifactStore(uri string) (s *ArtifactStore, err error) {
+	s = &ArtifactStore{uri: uri}
+
+	params := strings.SplitN(uri, ":", 2)
+	if len(params) != 2 {
+		return nil, fmt.Errorf("store: invalid uri %s", uri)
+	}
+	path := params[1]
+
+	s.data, err = leveldb.OpenFile(path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// Close the store
+func (s *ArtifactStore) Close() (err error) {
+	return s.data.Close()
+}
+
+// Put stores the artifact data for the result with the given uuid
+func (s *ArtifactStore) Put(uuid string, artifact transaction.Artifact) (ref string, err error) {
+	if err = store.ValidateArtifact(uuid, artifact.Name); err != nil {
+		return "", err
+	}
+
+	ref = s.uri + "/" + uuid + "/" + artifact.Name
+	data := artifact.Data
+
+	artifact.Data = nil
+	artifact.Ref = ref
+	b, err := json.Marshal(&artifactMeta{Time: time.Now(), Artifact: artifact})
+	if err != nil {
+		return "", err
+	}
+
+	batch := &leveldb.Batch{}
+	batch.Put(artifactKey(metaPrefix, uuid, artifact.Name), b)
+	batch.Put(artifactKey(dataPrefix, uuid, artifact.Name), data)
+	if err = s.data.Write(batch, nil); err != nil {
+		return "", err
+	}
+
+	return ref, nil
+}
+
+// Get returns the named artifact with its data for the result with the given uuid
+func (s *ArtifactStore) Get(uuid, name string) (artifact transaction.Artifact, err error) {
+	if err = store.ValidateArtifact(uuid, name); err != nil {
+		return artifact, err
+	}
+
+	b, err := s.data.Get(artifactKey(metaPrefix, uuid, name), nil)
+	switch {
+	case err == leveldb.ErrNotFound:
+		return artifact, store.ErrArtifactNotFound
+	case err != nil:
+		return artifact, err
+	}
+
+	var meta artifactMeta
+	if err = json.Unmarshal(b, &meta); err != nil {
+		return artifact, err
+	}
+	artifact = meta.Artifact
+
+	artifact.Data, err = s.data.Get(artifactKey(dataPrefix, uuid, name), nil)
+	switch {
+	case err == leveldb.ErrNotFound:
+		return artifact, store.ErrArtifactNotFound
+	case err != nil:
+		return artifact, err
+	}
+
+	return artifact, nil
+}
+
+// Prune removes the artifacts stored before the given time
+func (s *ArtifactStore) Prune(before time.Time) (err error) {
+	iter := s.data.NewIterator(util.BytesPrefix([]byte(metaPrefix)), nil)
+	defer iter.Release()
+
+	batch := &leveldb.Batch{}
+	for iter.Next() {
+		var meta artifactMeta
+		if err = json.Unmarshal(iter.Value(), &meta); err != nil {
+			return err
+		}
+
+		if !meta.Time.Before(before) {
+			continue
+		}
+
+		key := iter.Key()
+		batch.Delete(append([]byte(nil), key...))
+		batch.Delete(append([]byte(dataPrefix), key[len(metaPrefix):]...))
+	}
+
+	if err = iter.Error(); err != nil {
+		return err
+	}
+
+	return s.data.Write(batch, nil)
+}
+
+func artifactKey(prefix, uuid, name string) (key []byte) {
+	return []byte(prefix + uuid + "\x00" + name)
+}
@@ -2,6 +2,7 @@ package leveldb
 
 import (
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/Unbabel/replicant/store"
@@ -33,3 +34,55 @@ func cleanStore(t *testing.T, s store.Store) {
 		t.Fatal(err)
 	}
 }
+
+var resultURI = "leveldb:/tmp/testresultdb"
+
+func TestResultStore(t *testing.T) {
+	tests.RunResults(t, initResultStore, cleanResultStore)
+}
+
+func initResultStore(t *testing.T) store.ResultStore {
+	s, err := NewResultStore(resultURI + t.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return s
+}
+
+func cleanResultStore(t *testing.T, s store.ResultStore) {
+
+	if err := s.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.RemoveAll(strings.TrimPrefix(resultURI, "leveldb:") + t.Name()); err != nil {
+		t.Fatal(err)
+	}
+}
+
+var artifactURI = "leveldb:/tmp/testartifactdb"
+
+func TestArtifactStore(t *testing.T) {
+	tests.RunArtifacts(t, initArtifactStore, cleanArtifactStore)
+}
+
+func initArtifactStore(t *testing.T) store.ArtifactStore {
+	s, err := NewArtifactStore(artifactURI + t.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return s
+}
+
+func cleanArtifactStore(t *testing.T, s store.ArtifactStore) {
+
+	if err := s.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.RemoveAll(strings.TrimPrefix(artifactURI, "leveldb:") + t.Name()); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,164 @@
+package leveldb
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Unbabel/replicant/store"
+	"github.com/Unbabel/replicant/transaction"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+var _ store.ResultStore = (*ResultStore)(nil)
+
+func init() {
+	store.RegisterResultStore("leveldb",
+		func(uri string) (s store.ResultStore, err error) {
+			return NewResultStore(uri)
+		})
+}
+
+// ResultStore is a leveldb transaction result store.
+// Results are keyed by <name>\x00<big endian unix nano time><uuid>
+// so that results for a given transaction are ordered by time.
+type ResultStore struct {
+	data *leveldb.DB
+}
+
+// NewResultStore creates a new leveldb transaction result store
+func NewResultStore(uri string) (s *ResultStore, err error) {
+	s = &ResultStore{}
+
+	params := strings.SplitN(uri, ":", 2)
+	if len(params) != 2 {
+		return nil, fmt.Errorf("store: invalid uri %s", uri)
+	}
+	path := params[1]
+
+	s.data, err = leveldb.OpenFile(path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// Close the store
+func (s *ResultStore) Close() (err error) {
+	return s.data.Close()
+}
+
+// Append stores the given result
+func (s *ResultStore) Append(result transaction.Result) (err error) {
+	b, err := json.Marshal(&result)
+	if err != nil {
+		return err
+	}
+
+	key := append(timeKey(result.Name, result.Time.UnixNano()), result.UUID...)
+	return s.data.Put(key, b, nil)
+}
+
+// Prune removes the results before the given time
+func (s *ResultStore) Prune(before time.Time) (err error) {
+	iter := s.data.NewIterator(nil, nil)
+	defer iter.Release()
+
+	batch := &leveldb.Batch{}
+	for iter.Next() {
+		key := iter.Key()
+		x := bytes.IndexByte(key, 0)
+		if x < 0 || len(key) < x+9 || int64(binary.BigEndian.Uint64(key[x+1:])) >= before.UnixNano() {
+			continue
+		}
+		batch.Delete(append([]byte(nil), key...))
+	}
+
+	if err = iter.Error(); err != nil {
+		return err
+	}
+
+	return s.data.Write(batch, nil)
+}
+
+// Query returns the results matching the given query ordered from the most recent
+func (s *ResultStore) Query(query store.ResultQuery) (results []transaction.Result, err error) {
+	if query.Name == "" {
+		return s.queryAll(query)
+	}
+
+	r := &util.Range{Start: timeKey(query.Name, 0), Limit: timeKey(query.Name, math.MaxInt64)}
+	if !query.From.IsZero() {
+		r.Start = timeKey(query.Name, query.From.UnixNano())
+	}
+	if !query.To.IsZero() {
+		r.Limit = timeKey(query.Name, query.To.UnixNano())
+	}
+
+	iter := s.data.NewIterator(r, nil)
+	defer iter.Release()
+
+	for ok := iter.Last(); ok; ok = iter.Prev() {
+		var result transaction.Result
+		if err = json.Unmarshal(iter.Value(), &result); err != nil {
+			return nil, err
+		}
+
+		if !query.Match(result) {
+			continue
+		}
+
+		results = append(results, result)
+		if query.Limit > 0 && len(results) == query.Limit {
+			break
+		}
+	}
+
+	return results, iter.Error()
+}
+
+// queryAll handles queries not bound to a transaction name, which require
+// a full scan as results are keyed by transaction name.
+func (s *ResultStore) queryAll(query store.ResultQuery) (results []transaction.Result, err error) {
+	iter := s.data.NewIterator(nil, nil)
+	defer iter.Release()
+
+	for iter.Next() {
+		var result transaction.Result
+		if err = json.Unmarshal(iter.Value(), &result); err != nil {
+			return nil, err
+		}
+
+		if query.Match(result) {
+			results = append(results, result)
+		}
+	}
+
+	if err = iter.Error(); err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Time.After(results[j].Time)
+	})
+
+	if query.Limit > 0 && len(results) > query.Limit {
+		results = results[:query.Limit]
+	}
+
+	return results, nil
+}
+
+func timeKey(name string, nano int64) (key []byte) {
+	key = make([]byte, len(name)+9)
+	copy(key, name)
+	binary.BigEndian.PutUint64(key[len(name)+1:], uint64(nano))
+	return key
+}
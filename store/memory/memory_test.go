@@ -1,10 +1,13 @@
 package memory
 
 import (
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/Unbabel/replicant/store"
 	"github.com/Unbabel/replicant/store/tests"
+	"github.com/Unbabel/replicant/transaction"
 )
 
 func TestStore(t *testing.T) {
@@ -26,3 +29,58 @@ func cleanStore(t *testing.T, s store.Store) {
 	}
 
 }
+
+func TestResultStore(t *testing.T) {
+	tests.RunResults(t, initResultStore, cleanResultStore)
+}
+
+func initResultStore(t *testing.T) store.ResultStore {
+	s, err := NewResultStore("")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return s
+}
+
+func cleanResultStore(t *testing.T, s store.ResultStore) {
+	if err := s.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestResultStoreLimit(t *testing.T) {
+	if _, err := NewResultStore("memory:none"); err == nil {
+		t.Fatal("expected error for invalid uri")
+	}
+
+	s, err := NewResultStore("memory:2")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	base := time.Date(2019, 11, 16, 9, 0, 0, 0, time.UTC)
+	for _, uuid := range []string{"1", "2", "3"} {
+		base = base.Add(time.Minute)
+		if err = s.Append(transaction.Result{UUID: uuid, Name: "txn1", Time: base}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	results, err := s.Query(store.ResultQuery{Name: "txn1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(results) != 2 || results[0].UUID != "3" || results[1].UUID != "2" {
+		t.Fatalf("unexpected results: %#v", results)
+	}
+
+	if err = s.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = s.Append(transaction.Result{UUID: "4", Name: "txn1", Time: base}); !errors.Is(err, ErrClosed) {
+		t.Fatalf("expected closed error, got: %v", err)
+	}
+}
@@ -0,0 +1,141 @@
+package memory
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Unbabel/replicant/store"
+	"github.com/Unbabel/replicant/transaction"
+)
+
+var _ store.ResultStore = (*ResultStore)(nil)
+
+// DefaultMaxResults is the default number of results kept per transaction
+const DefaultMaxResults = 1000
+
+// ErrClosed the store is closed
+var ErrClosed = fmt.Errorf("store: memory result store is closed")
+
+func init() {
+	store.RegisterResultStore("memory",
+		func(uri string) (s store.ResultStore, err error) {
+			return NewResultStore(uri)
+		})
+}
+
+// ResultStore is a in memory transaction result store.
+// Only the most recent results of each transaction are kept.
+type ResultStore struct {
+	mtx  sync.RWMutex
+	max  int
+	data map[string][]transaction.Result
+}
+
+// NewResultStore creates a new in memory transaction result store.
+// The uri is in the form memory:<max results per transaction>, with memory:-
+// keeping the DefaultMaxResults.
+func NewResultStore(uri string) (s *ResultStore, err error) {
+	s = &ResultStore{max: DefaultMaxResults, data: make(map[string][]transaction.Result)}
+
+	params := strings.SplitN(uri, ":", 2)
+	if len(params) == 2 && params[1] != "" && params[1] != "-" {
+		if s.max, err = strconv.Atoi(params[1]); err != nil || s.max < 1 {
+			return nil, fmt.Errorf("store: invalid uri %s", uri)
+		}
+	}
+
+	return s, nil
+}
+
+// Close the store
+func (s *ResultStore) Close() (err error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.data = nil
+	return nil
+}
+
+// Append stores the given result, discarding the oldest result
+// for the transaction when the store is full
+func (s *ResultStore) Append(result transaction.Result) (err error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if s.data == nil {
+		return ErrClosed
+	}
+
+	results := append(s.data[result.Name], result)
+
+	// keep results ordered by time, results are mostly appended in order
+	for x := len(results) - 1; x > 0 && results[x].Time.Before(results[x-1].Time); x-- {
+		results[x], results[x-1] = results[x-1], results[x]
+	}
+
+	if n := len(results) - s.max; n > 0 {
+		copy(results, results[n:])
+		results = results[:s.max]
+	}
+
+	s.data[result.Name] = results
+	return nil
+}
+
+// Prune removes the results before the given time
+func (s *ResultStore) Prune(before time.Time) (err error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if s.data == nil {
+		return ErrClosed
+	}
+
+	for name, results := range s.data {
+		// results are ordered by time
+		n := sort.Search(len(results), func(x int) bool { return !results[x].Time.Before(before) })
+		switch {
+		case n == len(results):
+			delete(s.data, name)
+		case n > 0:
+			s.data[name] = append(results[:0], results[n:]...)
+		}
+	}
+
+	return nil
+}
+
+// Query returns the results matching the given query ordered from the most recent
+func (s *ResultStore) Query(query store.ResultQuery) (results []transaction.Result, err error) {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+
+	if s.data == nil {
+		return nil, ErrClosed
+	}
+
+	for name, rs := range s.data {
+		if query.Name != "" && query.Name != name {
+			continue
+		}
+
+		for x := len(rs) - 1; x >= 0; x-- {
+			if query.Match(rs[x]) {
+				results = append(results, rs[x])
+			}
+		}
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Time.After(results[j].Time)
+	})
+
+	if query.Limit > 0 && len(results) > query.Limit {
+		results = results[:query.Limit]
+	}
+
+	return results, nil
+}
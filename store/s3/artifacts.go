@@ -0,0 +1,137 @@
+package s3
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	"github.com/Unbabel/replicant/store"
+	"github.com/Unbabel/replicant/transaction"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+var _ store.ArtifactStore = (*ArtifactStore)(nil)
+
+func init() {
+	store.RegisterArtifactStore("s3",
+		func(uri string) (s store.ArtifactStore, err error) {
+			return NewArtifactStore(uri)
+		})
+}
+
+// ArtifactStore is a S3 result artifact store.
+// Artifacts are stored under <prefix>/<uuid>/<name> with their content type.
+type ArtifactStore struct {
+	data       s3i    // S3 data source object.
+	bucketName string // Name of the bucket to store data.
+	prefix     string // Path inside the bucket to use as prefix.
+}
+
+// NewArtifactStore function creates a new artifact store with a subtype of s3.
+// receives uri which should be in the form of s3://<access>:<secret>/<bucket>/<prefix>.
+func NewArtifactStore(uri string) (*ArtifactStore, error) {
+	svc, bucket, prefix, err := newClient(uri)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ArtifactStore{data: svc, bucketName: bucket, prefix: prefix}, nil
+}
+
+// Close function does nothing as the connection is not persistent.
+func (s *ArtifactStore) Close() (err error) {
+	return nil
+}
+
+// Put function stores the artifact data for the result with the given uuid.
+// returns the s3://<bucket>/<key> reference for the stored object.
+func (s *ArtifactStore) Put(uuid string, artifact transaction.Artifact) (ref string, err error) {
+	if err = store.ValidateArtifact(uuid, artifact.Name); err != nil {
+		return "", err
+	}
+
+	input := &s3.PutObjectInput{
+		Body:        aws.ReadSeekCloser(bytes.NewReader(artifact.Data)),
+		Bucket:      aws.String(s.bucketName),
+		Key:         aws.String(s.key(uuid, artifact.Name)),
+		ContentType: aws.String(artifact.ContentType),
+	}
+
+	if _, err = s.data.PutObject(input); err != nil {
+		return "", fmt.Errorf("store/s3: failed to put artifact: %w", err)
+	}
+
+	return "s3://" + s.bucketName + "/" + s.key(uuid, artifact.Name), nil
+}
+
+// Get function gets the named artifact with its data for the result with the given uuid.
+// returns store.ErrArtifactNotFound if the object is not found.
+func (s *ArtifactStore) Get(uuid, name string) (artifact transaction.Artifact, err error) {
+	if err = store.ValidateArtifact(uuid, name); err != nil {
+		return artifact, err
+	}
+
+	input := &s3.GetObjectInput{
+		Bucket: aws.String(s.bucketName),
+		Key:    aws.String(s.key(uuid, name)),
+	}
+
+	result, err := s.data.GetObject(input)
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == s3.ErrCodeNoSuchKey {
+			return artifact, store.ErrArtifactNotFound
+		}
+		return artifact, fmt.Errorf("store/s3: failed to retrieve artifact: %w", err)
+	}
+	defer result.Body.Close()
+
+	if artifact.Data, err = ioutil.ReadAll(result.Body); err != nil {
+		return artifact, fmt.Errorf("store/s3: failed to read artifact: %w", err)
+	}
+
+	artifact.Name = name
+	artifact.ContentType = aws.StringValue(result.ContentType)
+	artifact.Size = int64(len(artifact.Data))
+	artifact.Ref = "s3://" + s.bucketName + "/" + s.key(uuid, name)
+	return artifact, nil
+}
+
+// Prune function removes the artifacts stored before the given time.
+// returns error in case of unexpected behaviour.
+func (s *ArtifactStore) Prune(before time.Time) (err error) {
+	input := &s3.ListObjectsInput{
+		Bucket: aws.String(s.bucketName),
+		Prefix: aws.String(s.prefix + "/"),
+	}
+
+	var output *s3.ListObjectsOutput
+	for {
+		if output, err = s.data.ListObjects(input); err != nil {
+			return fmt.Errorf("store/s3: failed to list artifacts: %w", err)
+		}
+
+		for _, object := range output.Contents {
+			if !aws.TimeValue(object.LastModified).Before(before) {
+				continue
+			}
+
+			_, err = s.data.DeleteObject(&s3.DeleteObjectInput{Bucket: input.Bucket, Key: object.Key})
+			if err != nil {
+				return fmt.Errorf("store/s3: failed to delete artifact: %w", err)
+			}
+		}
+
+		// object listings are returned in pages of at most 1000 keys
+		if !aws.BoolValue(output.IsTruncated) || len(output.Contents) == 0 {
+			return nil
+		}
+		input.Marker = output.Contents[len(output.Contents)-1].Key
+	}
+}
+
+func (s *ArtifactStore) key(uuid, name string) (key string) {
+	return s.prefix + "/" + uuid + "/" + name
+}
@@ -45,15 +45,26 @@ type Store struct {
 // receives uri which should be in the form of s3://<access>:<secret>/<bucket>/<prefix>.
 // returns a store with subtype s3.
 func New(uri string) (*Store, error) {
-	var err error
+	svc, bucket, prefix, err := newClient(uri)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Store{data: svc, bucketName: bucket, prefix: prefix}, nil
+}
+
+// newClient function creates a S3 client from the given uri, shared by the s3 stores.
+// receives uri which should be in the form of s3://<access>:<secret>/<bucket>/<prefix>.
+// returns the client with the bucket name and prefix.
+func newClient(uri string) (svc *s3.S3, bucket, prefix string, err error) {
 	u, err := url.Parse(uri)
 
 	if err != nil {
-		return nil, fmt.Errorf("store/s3: failed to parse URI: %w", err)
+		return nil, "", "", fmt.Errorf("store/s3: failed to parse URI: %w", err)
 	}
 
 	if u.Scheme != "s3" {
-		return nil, fmt.Errorf("store/s3: invalid S3 scheme in URI: %s", u.Scheme)
+		return nil, "", "", fmt.Errorf("store/s3: invalid S3 scheme in URI: %s", u.Scheme)
 	}
 
 	var awsconfig *aws.Config = aws.NewConfig()
@@ -73,13 +84,11 @@ func New(uri string) (*Store, error) {
 		sess, err = session.NewSession(awsconfig.WithRegion(reg[0]))
 
 		if err != nil {
-			return nil, fmt.Errorf("store/s3: failed to create session: %w", err)
+			return nil, "", "", fmt.Errorf("store/s3: failed to create session: %w", err)
 		}
 	}
 
-	svc := s3.New(sess)
-
-	return &Store{data: svc, bucketName: u.Host, prefix: strings.Replace(u.Path, "/", "", 1)}, nil
+	return s3.New(sess), u.Host, strings.Replace(u.Path, "/", "", 1), nil
 }
 
 // Close function does nothing as the connection is not persistent.
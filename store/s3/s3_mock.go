@@ -6,6 +6,7 @@ import (
 	"sort"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -24,13 +25,17 @@ var _ s3i = (*mockS3)(nil)
 
 // mockS3 is a S3 mock for the store implementation
 type mockS3 struct {
-	mtx  sync.RWMutex
-	data map[string]bucket
+	mtx   sync.RWMutex
+	data  map[string]bucket
+	types map[string]string
+	times map[string]time.Time
 }
 
 func newMockS3() *mockS3 {
 	return &mockS3{
-		data: map[string]bucket{},
+		data:  map[string]bucket{},
+		types: map[string]string{},
+		times: map[string]time.Time{},
 	}
 }
 
@@ -100,8 +105,9 @@ func (s *mockS3) ListObjects(input *s3.ListObjectsInput) (*s3.ListObjectsOutput,
 	for _, key := range keys {
 		value := bucket[key]
 		object := s3.Object{
-			Key:  aws.String(key),
-			Size: aws.Int64(int64(len(value))),
+			Key:          aws.String(key),
+			Size:         aws.Int64(int64(len(value))),
+			LastModified: aws.Time(s.times[*input.Bucket+"/"+key]),
 		}
 		contents = append(contents, &object)
 	}
@@ -131,6 +137,9 @@ func (s *mockS3) GetObject(input *s3.GetObjectInput) (*s3.GetObjectOutput, error
 
 	body := ioutil.NopCloser(bytes.NewReader(object))
 	output := s3.GetObjectOutput{Body: body}
+	if contentType, ok := s.types[*input.Bucket+"/"+*input.Key]; ok {
+		output.ContentType = aws.String(contentType)
+	}
 	return &output, nil
 
 }
@@ -147,6 +156,10 @@ func (s *mockS3) PutObject(input *s3.PutObjectInput) (*s3.PutObjectOutput, error
 	}
 
 	bucket[*input.Key] = content
+	s.times[*input.Bucket+"/"+*input.Key] = time.Now()
+	if input.ContentType != nil {
+		s.types[*input.Bucket+"/"+*input.Key] = *input.ContentType
+	}
 	return &s3.PutObjectOutput{}, nil
 }
 
@@ -28,3 +28,24 @@ func initStore(t *testing.T) store.Store {
 
 	return s
 }
+
+func TestArtifactStore(t *testing.T) {
+	tests.RunArtifacts(t, initArtifactStore, func(t *testing.T, s store.ArtifactStore) { return })
+}
+
+func initArtifactStore(t *testing.T) store.ArtifactStore {
+
+	bucket := "test-bucket"
+	s3c := newMockS3()
+
+	if _, err := s3c.CreateBucket(&s3.CreateBucketInput{Bucket: &bucket}); err != nil {
+		t.Fatalf("error initializing store: %s", err)
+	}
+
+	s := &ArtifactStore{}
+	s.data = s3c
+	s.bucketName = bucket
+	s.prefix = "replicant/artifacts"
+
+	return s
+}
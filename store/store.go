@@ -1,21 +1,32 @@
 /*
 Package store implements a simple interface and registry for
-transaction stores.
+transaction and result stores.
 */
 package store
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
+	"time"
 
 	"github.com/Unbabel/replicant/internal/xz"
 	"github.com/Unbabel/replicant/transaction"
 )
 
 var (
-	registry = xz.NewMap()
+	registry         = xz.NewMap()
+	resultRegistry   = xz.NewMap()
+	artifactRegistry = xz.NewMap()
 	// ErrTransactionNotFound transaction not found
 	ErrTransactionNotFound = fmt.Errorf("transaction not found")
+	// ErrArtifactNotFound artifact not found
+	ErrArtifactNotFound = fmt.Errorf("artifact not found")
+	// ErrInvalidArtifact artifact names and result uuids must only have letters,
+	// digits, '_', '-' and '.' and must not start with a '.'
+	ErrInvalidArtifact = fmt.Errorf("invalid artifact name or result uuid")
+
+	artifactNameRx = regexp.MustCompile(`^[a-zA-Z0-9_\-][a-zA-Z0-9_\-.]*$`)
 )
 
 // Store for transaction configurations
@@ -41,6 +52,89 @@ type Store interface {
 	Iter(callback func(name string, config transaction.Config) (proceed bool)) (err error)
 }
 
+// ResultStore for transaction results
+type ResultStore interface {
+
+	// Close the store
+	Close() (err error)
+
+	// Append stores the given result
+	Append(result transaction.Result) (err error)
+
+	// Prune removes the results before the given time
+	Prune(before time.Time) (err error)
+
+	// Query returns the results matching the given query ordered from the most recent
+	Query(query ResultQuery) (results []transaction.Result, err error)
+}
+
+// ArtifactStore for result artifacts
+type ArtifactStore interface {
+
+	// Close the store
+	Close() (err error)
+
+	// Put stores the artifact data for the result with the given uuid
+	// and returns the storage reference for it
+	Put(uuid string, artifact transaction.Artifact) (ref string, err error)
+
+	// Get returns the named artifact with its data for the result with the given uuid
+	Get(uuid, name string) (artifact transaction.Artifact, err error)
+
+	// Prune removes the artifacts stored before the given time
+	Prune(before time.Time) (err error)
+}
+
+// ValidateArtifact checks if the given result uuid and artifact name are
+// safe for use as storage keys
+func ValidateArtifact(uuid, name string) (err error) {
+	if !artifactNameRx.MatchString(uuid) || !artifactNameRx.MatchString(name) {
+		return fmt.Errorf("%w: %s/%s", ErrInvalidArtifact, uuid, name)
+	}
+	return nil
+}
+
+// ResultQuery specifies the criteria for querying results from a ResultStore.
+// Empty fields are not used for filtering.
+type ResultQuery struct {
+	// Name of the transaction
+	Name string `json:"name" yaml:"name"`
+
+	// Labels that must be present with the same values in the result metadata
+	Labels map[string]string `json:"labels" yaml:"labels"`
+
+	// From and To limit the time window for the result execution time.
+	// From is inclusive while To is exclusive.
+	From time.Time `json:"from" yaml:"from"`
+	To   time.Time `json:"to" yaml:"to"`
+
+	// Limit the number of returned results
+	Limit int `json:"limit" yaml:"limit"`
+}
+
+// Match checks if the given result matches this query criteria, except for the limit
+func (q ResultQuery) Match(result transaction.Result) (ok bool) {
+	if q.Name != "" && q.Name != result.Name {
+		return false
+	}
+
+	if !q.From.IsZero() && result.Time.Before(q.From) {
+		return false
+	}
+
+	if !q.To.IsZero() && !result.Time.Before(q.To) {
+		return false
+	}
+
+	for k, v := range q.Labels {
+		if lv, ok := result.Metadata[k]; !ok || lv != v {
+			return false
+		}
+	}
+
+	return true
+}
+
 // Supplier for manager.Store
 type Supplier func(uri string) (s Store, err error)
 
@@ -69,3 +163,61 @@ func New(uri string) (s Store, err error) {
 	sp := spi.(Supplier)
 	return sp(uri)
 }
+
+// ResultSupplier for manager.ResultStore
+type ResultSupplier func(uri string) (s ResultStore, err error)
+
+// RegisterResultStore registers result store suppliers
+func RegisterResultStore(name string, s ResultSupplier) (err error) {
+	if _, ok := resultRegistry.Load(name); ok {
+		return fmt.Errorf("store: result store %s already registered", name)
+	}
+	resultRegistry.Store(name, s)
+	return nil
+}
+
+// NewResultStore creates a new result store with the registered suppliers from the given URI.
+// URI spec: <store>:<arguments>
+func NewResultStore(uri string) (s ResultStore, err error) {
+	params := strings.SplitN(uri, ":", 2)
+	if len(params) == 0 {
+		return nil, fmt.Errorf("store: invalid uri %s", uri)
+	}
+	name := params[0]
+	spi, ok := resultRegistry.Load(name)
+	if !ok {
+		return nil, fmt.Errorf("store: result store %s not registered", name)
+	}
+
+	sp := spi.(ResultSupplier)
+	return sp(uri)
+}
+
+// ArtifactSupplier for manager.ArtifactStore
+type ArtifactSupplier func(uri string) (s ArtifactStore, err error)
+
+// RegisterArtifactStore registers artifact store suppliers
+func RegisterArtifactStore(name string, s ArtifactSupplier) (err error) {
+	if _, ok := artifactRegistry.Load(name); ok {
+		return fmt.Errorf("store: artifact store %s already registered", name)
+	}
+	artifactRegistry.Store(name, s)
+	return nil
+}
+
+// NewArtifactStore creates a new artifact store with the registered suppliers from the given URI.
+// URI spec: <store>:<arguments>
+func NewArtifactStore(uri string) (s ArtifactStore, err error) {
+	params := strings.SplitN(uri, ":", 2)
+	if len(params) == 0 {
+		return nil, fmt.Errorf("store: invalid uri %s", uri)
+	}
+	name := params[0]
+	spi, ok := artifactRegistry.Load(name)
+	if !ok {
+		return nil, fmt.Errorf("store: artifact store %s not registered", name)
+	}
+
+	sp := spi.(ArtifactSupplier)
+	return sp(uri)
+}
@@ -0,0 +1,113 @@
+package tests
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Unbabel/replicant/store"
+	"github.com/Unbabel/replicant/transaction"
+)
+
+// ArtifactSetup function type for creating an artifact store for tests
+type ArtifactSetup func(t *testing.T) (s store.ArtifactStore)
+
+// ArtifactDestroy function type for cleaning up after tests
+type ArtifactDestroy func(t *testing.T, s store.ArtifactStore)
+
+// RunArtifacts artifact store test suite
+func RunArtifacts(t *testing.T, s ArtifactSetup, d ArtifactDestroy) {
+	t.Run("ArtifactStorePutGet", ArtifactStorePutGet(t, s, d))
+	t.Run("ArtifactStoreInvalid", ArtifactStoreInvalid(t, s, d))
+	t.Run("ArtifactStorePrune", ArtifactStorePrune(t, s, d))
+}
+
+// ArtifactStorePutGet test
+func ArtifactStorePutGet(t *testing.T, setup ArtifactSetup, destroy ArtifactDestroy) func(t *testing.T) {
+	return func(t *testing.T) {
+		s := setup(t)
+		defer destroy(t, s)
+
+		artifact := transaction.Artifact{
+			Name:        "screenshot.png",
+			ContentType: "image/png",
+			Size:        4,
+			Data:        []byte{0x89, 'P', 'N', 'G'},
+		}
+
+		ref, err := s.Put("1SYFQoTdxVmSEbYAGpOXIwmTjPa", artifact)
+		if err != nil {
+			t.Fatalf("ArtifactStore.Put() error = %v", err)
+		}
+
+		if ref == "" {
+			t.Fatalf("ArtifactStore.Put() returned an empty reference")
+		}
+
+		got, err := s.Get("1SYFQoTdxVmSEbYAGpOXIwmTjPa", "screenshot.png")
+		if err != nil {
+			t.Fatalf("ArtifactStore.Get() error = %v", err)
+		}
+
+		if got.Name != artifact.Name || got.ContentType != artifact.ContentType ||
+			got.Size != artifact.Size || !bytes.Equal(got.Data, artifact.Data) {
+			t.Fatalf("ArtifactStore.Get() = %#v, want %#v", got, artifact)
+		}
+
+		if _, err = s.Get("1SYFQoTdxVmSEbYAGpOXIwmTjPa", "dom.html"); !errors.Is(err, store.ErrArtifactNotFound) {
+			t.Fatalf("ArtifactStore.Get() error = %v, want %v", err, store.ErrArtifactNotFound)
+		}
+
+		if _, err = s.Get("1SYFQoTdxVmSEbYAGpOXIwmTjPb", "screenshot.png"); !errors.Is(err, store.ErrArtifactNotFound) {
+			t.Fatalf("ArtifactStore.Get() error = %v, want %v", err, store.ErrArtifactNotFound)
+		}
+	}
+}
+
+// ArtifactStoreInvalid test
+func ArtifactStoreInvalid(t *testing.T, setup ArtifactSetup, destroy ArtifactDestroy) func(t *testing.T) {
+	return func(t *testing.T) {
+		s := setup(t)
+		defer destroy(t, s)
+
+		for _, name := range []string{"../secret", ".meta", "a/b", ""} {
+			if _, err := s.Put("1SYFQoTdxVmSEbYAGpOXIwmTjPa", transaction.Artifact{Name: name}); !errors.Is(err, store.ErrInvalidArtifact) {
+				t.Fatalf("ArtifactStore.Put(%q) error = %v, want %v", name, err, store.ErrInvalidArtifact)
+			}
+
+			if _, err := s.Get(name, "screenshot.png"); !errors.Is(err, store.ErrInvalidArtifact) {
+				t.Fatalf("ArtifactStore.Get(%q) error = %v, want %v", name, err, store.ErrInvalidArtifact)
+			}
+		}
+	}
+}
+
+// ArtifactStorePrune test
+func ArtifactStorePrune(t *testing.T, setup ArtifactSetup, destroy ArtifactDestroy) func(t *testing.T) {
+	return func(t *testing.T) {
+		s := setup(t)
+		defer destroy(t, s)
+
+		artifact := transaction.Artifact{Name: "dom.html", ContentType: "text/html", Size: 4, Data: []byte("<br>")}
+		if _, err := s.Put("1SYFQoTdxVmSEbYAGpOXIwmTjPa", artifact); err != nil {
+			t.Fatalf("ArtifactStore.Put() error = %v", err)
+		}
+
+		if err := s.Prune(time.Now().Add(-time.Hour)); err != nil {
+			t.Fatalf("ArtifactStore.Prune() error = %v", err)
+		}
+
+		if _, err := s.Get("1SYFQoTdxVmSEbYAGpOXIwmTjPa", "dom.html"); err != nil {
+			t.Fatalf("ArtifactStore.Get() error = %v", err)
+		}
+
+		if err := s.Prune(time.Now().Add(time.Hour)); err != nil {
+			t.Fatalf("ArtifactStore.Prune() error = %v", err)
+		}
+
+		if _, err := s.Get("1SYFQoTdxVmSEbYAGpOXIwmTjPa", "dom.html"); !errors.Is(err, store.ErrArtifactNotFound) {
+			t.Fatalf("ArtifactStore.Get() error = %v, want %v", err, store.ErrArtifactNotFound)
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Unbabel/replicant/store"
+	"github.com/Unbabel/replicant/transaction"
+)
+
+// ResultSetup function type for creating a result store for tests
+type ResultSetup func(t *testing.T) (s store.ResultStore)
+
+// ResultDestroy function type for cleaning up after tests
+type ResultDestroy func(t *testing.T, s store.ResultStore)
+
+// RunResults result store test suite
+func RunResults(t *testing.T, s ResultSetup, d ResultDestroy) {
+	t.Run("ResultStoreQuery", ResultStoreQuery(t, s, d))
+	t.Run("ResultStorePrune", ResultStorePrune(t, s, d))
+}
+
+// ResultStoreQuery test
+func ResultStoreQuery(t *testing.T, setup ResultSetup, destroy ResultDestroy) func(t *testing.T) {
+	return func(t *testing.T) {
+		s := setup(t)
+		defer destroy(t, s)
+
+		base := time.Date(2019, 11, 16, 9, 0, 0, 0, time.UTC)
+		results := []transaction.Result{
+			{UUID: "1", Name: "txn1", Time: base, Metadata: map[string]string{"environment": "production"}},
+			{UUID: "2", Name: "txn2", Time: base.Add(time.Minute), Metadata: map[string]string{"environment": "staging"}},
+			{UUID: "3", Name: "txn1", Time: base.Add(2 * time.Minute), Metadata: map[string]string{"environment": "production"}, Failed: true},
+			{UUID: "4", Name: "txn1", Time: base.Add(3 * time.Minute), Metadata: map[string]string{"environment": "staging"}},
+			{UUID: "5", Name: "txn2", Time: base.Add(4 * time.Minute), Metadata: map[string]string{"environment": "production"}},
+		}
+
+		for _, r := range results {
+			if err := s.Append(r); err != nil {
+				t.Fatalf("ResultStore.Append() error = %v", err)
+			}
+		}
+
+		tests := []struct {
+			name  string
+			query store.ResultQuery
+			want  []string
+		}{
+			{name: "all", query: store.ResultQuery{}, want: []string{"5", "4", "3", "2", "1"}},
+			{name: "by name", query: store.ResultQuery{Name: "txn1"}, want: []string{"4", "3", "1"}},
+			{name: "latest", query: store.ResultQuery{Name: "txn1", Limit: 1}, want: []string{"4"}},
+			{name: "by labels", query: store.ResultQuery{
+				Labels: map[string]string{"environment": "production"}}, want: []string{"5", "3", "1"}},
+			{name: "by time window", query: store.ResultQuery{
+				From: base.Add(time.Minute), To: base.Add(4 * time.Minute)}, want: []string{"4", "3", "2"}},
+			{name: "by name and time window", query: store.ResultQuery{
+				Name: "txn1", From: base.Add(time.Minute), To: base.Add(3 * time.Minute)}, want: []string{"3"}},
+			{name: "by name labels and limit", query: store.ResultQuery{
+				Name: "txn1", Labels: map[string]string{"environment": "production"}, Limit: 1}, want: []string{"3"}},
+			{name: "no match", query: store.ResultQuery{Name: "txn3"}, want: nil},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				got, err := s.Query(tt.query)
+				if err != nil {
+					t.Fatalf("ResultStore.Query() error = %v", err)
+				}
+
+				if len(got) != len(tt.want) {
+					t.Fatalf("ResultStore.Query() returned %d results, want %d", len(got), len(tt.want))
+				}
+
+				for x := range got {
+					if got[x].UUID != tt.want[x] {
+						t.Errorf("ResultStore.Query()[%d] = %s, want %s", x, got[x].UUID, tt.want[x])
+					}
+				}
+			})
+		}
+	}
+}
+
+// ResultStorePrune test
+func ResultStorePrune(t *testing.T, setup ResultSetup, destroy ResultDestroy) func(t *testing.T) {
+	return func(t *testing.T) {
+		s := setup(t)
+		defer destroy(t, s)
+
+		base := time.Date(2019, 11, 16, 9, 0, 0, 0, time.UTC)
+		for x, name := range []string{"txn1", "txn2", "txn1", "txn10"} {
+			r := transaction.Result{UUID: name + "-" + string(rune('a'+x)), Name: name, Time: base.Add(time.Duration(x) * time.Minute)}
+			if err := s.Append(r); err != nil {
+				t.Fatalf("ResultStore.Append() error = %v", err)
+			}
+		}
+
+		if err := s.Prune(base.Add(2 * time.Minute)); err != nil {
+			t.Fatalf("ResultStore.Prune() error = %v", err)
+		}
+
+		got, err := s.Query(store.ResultQuery{})
+		if err != nil {
+			t.Fatalf("ResultStore.Query() error = %v", err)
+		}
+
+		if len(got) != 2 || got[0].UUID != "txn10-d" || got[1].UUID != "txn1-c" {
+			t.Fatalf("ResultStore.Query() after prune = %#v", got)
+		}
+	}
+}
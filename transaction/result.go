@@ -19,24 +19,97 @@ package transaction
 import (
 	"encoding/json"
 	"fmt"
+	"sync"
 	"time"
 )
 
 // Result represents a transaction execution result
 type Result struct {
-	UUID            string            `json:"uuid" yaml:"uuid"`
-	Name            string            `json:"name" yaml:"name"`
-	Driver          string            `json:"driver" yaml:"driver"`
-	Failed          bool              `json:"failed" yaml:"failed"`
-	Status          int               `json:"status" yaml:"status"`
-	Message         string            `json:"message" yaml:"message"`
-	Data            string            `json:"data" yaml:"data"`
-	Time            time.Time         `json:"time" yaml:"time"`
-	Error           error             `json:"-" yaml:"-"`
-	Metadata        map[string]string `json:"metadata" yaml:"metadata"`
-	RetryCount      int               `json:"retry_count" yaml:"retry_count"`
-	WithCallback    bool              `json:"with_callback" yaml:"with_callback"`
-	DurationSeconds float64           `json:"duration_seconds" yaml:"duration_seconds"`
+	UUID            string             `json:"uuid" yaml:"uuid"`
+	Name            string             `json:"name" yaml:"name"`
+	Driver          string             `json:"driver" yaml:"driver"`
+	Failed          bool               `json:"failed" yaml:"failed"`
+	Status          int                `json:"status" yaml:"status"`
+	Message         string             `json:"message" yaml:"message"`
+	Data            string             `json:"data" yaml:"data"`
+	Time            time.Time          `json:"time" yaml:"time"`
+	Error           error              `json:"-" yaml:"-"`
+	Metadata        map[string]string  `json:"metadata" yaml:"metadata"`
+	RetryCount      int                `json:"retry_count" yaml:"retry_count"`
+	WithCallback    bool               `json:"with_callback" yaml:"with_callback"`
+	DurationSeconds float64            `json:"duration_seconds" yaml:"duration_seconds"`
+	Metrics         map[string]float64 `json:"metrics,omitempty" yaml:"metrics,omitempty"`
+	Artifacts       []Artifact         `json:"artifacts,omitempty" yaml:"artifacts,omitempty"`
+}
+
+// Artifact is a file produced by a transaction run, such as a screenshot of a failed web transaction.
+// Artifacts are sent with their data by executors and stored by the server, which
+// replaces the data with the URL for retrieving them. The storage reference is only
+// reported by local runs.
+type Artifact struct {
+	Name        string `json:"name" yaml:"name"`
+	ContentType string `json:"content_type" yaml:"content_type"`
+	Size        int64  `json:"size" yaml:"size"`
+	Ref         string `json:"ref,omitempty" yaml:"ref,omitempty"`
+	URL         string `json:"url,omitempty" yaml:"url,omitempty"`
+	Data        []byte `json:"data,omitempty" yaml:"-"`
+}
+
+const (
+	// MaxArtifactSize is the maximum size of an artifact attached during a run
+	MaxArtifactSize = 10 << 20
+
+	// MaxArtifactsSize is the maximum total size of the artifacts attached during a run
+	MaxArtifactsSize = 32 << 20
+)
+
+// Artifacts collects the artifacts attached by transaction scripts during a run.
+// Artifacts is safe for concurrent use and its zero value is ready to use.
+type Artifacts struct {
+	mtx  sync.Mutex
+	list []Artifact
+	size int64
+}
+
+// Add attaches the named artifact with the given content type and data,
+// within the MaxArtifactSize and MaxArtifactsSize limits
+func (a *Artifacts) Add(name, contentType string, data []byte) (err error) {
+	if name == "" {
+		return fmt.Errorf("artifact name must not be empty")
+	}
+
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+
+	size := int64(len(data))
+	if size > MaxArtifactSize {
+		return fmt.Errorf("artifact %s exceeds the maximum size of %d bytes", name, MaxArtifactSize)
+	}
+
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+
+	for _, artifact := range a.list {
+		if artifact.Name == name {
+			return fmt.Errorf("artifact %s already added", name)
+		}
+	}
+
+	if a.size+size > MaxArtifactsSize {
+		return fmt.Errorf("artifact %s exceeds the maximum total artifacts size of %d bytes", name, MaxArtifactsSize)
+	}
+
+	a.size += size
+	a.list = append(a.list, Artifact{Name: name, ContentType: contentType, Size: size, Data: data})
+	return nil
+}
+
+// List returns the collected artifacts
+func (a *Artifacts) List() (artifacts []Artifact) {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+	return append(artifacts, a.list...)
 }
 
 // MarshalJSON is custom marshaler for result
@@ -0,0 +1,31 @@
+package transaction
+
+import (
+	"testing"
+)
+
+func TestArtifactsLimits(t *testing.T) {
+	var a Artifacts
+
+	if err := a.Add("large.bin", "", make([]byte, MaxArtifactSize+1)); err == nil {
+		t.Fatalf("Add() expected error for artifact larger than %d bytes", MaxArtifactSize)
+	}
+
+	for x := 0; x < MaxArtifactsSize/MaxArtifactSize; x++ {
+		if err := a.Add(string(rune('a'+x))+".bin", "", make([]byte, MaxArtifactSize)); err != nil {
+			t.Fatalf("Add() error = %s", err)
+		}
+	}
+
+	if err := a.Add("last.bin", "", make([]byte, MaxArtifactsSize%MaxArtifactSize+1)); err == nil {
+		t.Fatalf("Add() expected error for artifacts larger than %d bytes", MaxArtifactsSize)
+	}
+
+	if err := a.Add("last.bin", "", make([]byte, MaxArtifactsSize%MaxArtifactSize)); err != nil {
+		t.Fatalf("Add() error = %s", err)
+	}
+
+	if l := a.List(); len(l) != MaxArtifactsSize/MaxArtifactSize+1 || l[0].ContentType != "application/octet-stream" {
+		t.Fatalf("List() unexpected artifacts: %d", len(l))
+	}
+}
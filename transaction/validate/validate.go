@@ -0,0 +1,152 @@
+// Package validate implements validation of transaction configs before they are
+// stored, scheduled or run.
+package validate
+
+/*
+   Copyright 2019 Bruno Moura <brunotm@gmail.com>
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+import (
+	"strings"
+	"time"
+
+	"github.com/Unbabel/replicant/driver"
+	"github.com/Unbabel/replicant/internal/scheduler"
+	"github.com/Unbabel/replicant/internal/tmpl"
+	"github.com/Unbabel/replicant/secret"
+	"github.com/Unbabel/replicant/transaction"
+	"github.com/Unbabel/replicant/transaction/callback"
+)
+
+// FieldError is a validation error for a transaction config field
+type FieldError struct {
+	Field   string `json:"field" yaml:"field"`
+	Message string `json:"message" yaml:"message"`
+}
+
+// Errors is the list of validation errors for a transaction config
+type Errors []FieldError
+
+// Error implements the error interface
+func (e Errors) Error() (s string) {
+	msgs := make([]string, 0, len(e))
+	for _, fe := range e {
+		msgs = append(msgs, fe.Field+": "+fe.Message)
+	}
+	return "validate: invalid transaction config: " + strings.Join(msgs, "; ")
+}
+
+// Config for the validator
+type Config struct {
+	// Drivers used for checking the transaction scripts. Only drivers
+	// implementing driver.Checker are used, as scripts are never run.
+	Drivers []driver.Driver
+
+	// SkipCallbacks skips checking if the callback type is registered.
+	// Used when validating outside of a replicant server, where no callback
+	// listeners are registered.
+	SkipCallbacks bool
+}
+
+// Validator validates transaction configs
+type Validator struct {
+	drivers       map[string]driver.Driver
+	skipCallbacks bool
+}
+
+// New creates a new validator
+func New(c Config) (v *Validator) {
+	v = &Validator{}
+	v.skipCallbacks = c.SkipCallbacks
+	v.drivers = make(map[string]driver.Driver, len(c.Drivers))
+	for _, d := range c.Drivers {
+		v.drivers[d.Type()] = d
+	}
+
+	return v
+}
+
+// noSecrets resolves all secrets to empty strings for validation
+var noSecrets = secret.ProviderFunc(func(key string) (value string, err error) { return "", nil })
+
+// Validate the given transaction config. It parses the schedule and timeout,
+// renders the script template with the transaction inputs and checks the
+// resulting script with the transaction driver, without running it.
+// The returned error is of type Errors when the config is invalid.
+func (v *Validator) Validate(config transaction.Config) (err error) {
+	var errs Errors
+
+	if config.Schedule != "" {
+		if err = scheduler.ParseSchedule(config.Schedule); err != nil {
+			errs = append(errs, FieldError{Field: "schedule", Message: err.Error()})
+		}
+	}
+
+	validTimeout := true
+	if config.Timeout != "" {
+		timeout, err := time.ParseDuration(config.Timeout)
+		switch {
+		case err != nil:
+			validTimeout = false
+			errs = append(errs, FieldError{Field: "timeout", Message: err.Error()})
+		case timeout <= 0:
+			validTimeout = false
+			errs = append(errs, FieldError{Field: "timeout", Message: "timeout must be greater than zero"})
+		}
+	}
+
+	if config.RetryCount < 0 {
+		errs = append(errs, FieldError{Field: "retry_count", Message: "retry count must not be negative"})
+	}
+
+	if config.CallBack != nil && !v.skipCallbacks {
+		if _, err = callback.GetListener(config.CallBack.Type); err != nil {
+			errs = append(errs, FieldError{Field: "callback.type", Message: err.Error()})
+		}
+	}
+
+	drv, ok := v.drivers[config.Driver]
+	if !ok {
+		errs = append(errs, FieldError{Field: "driver", Message: "unknown driver " + config.Driver})
+	}
+
+	// templates are only rendered when inputs are specified or secrets referenced,
+	// as when running. Secrets are not resolved, their references render as empty strings.
+	var tmplErr error
+	parsed := config
+	if tmpl.Templated(config) {
+		if parsed, _, tmplErr = tmpl.ParseWithSecrets(config, noSecrets); tmplErr != nil {
+			errs = append(errs, FieldError{Field: "script", Message: tmplErr.Error()})
+		}
+	}
+
+	// only check when the script template renders with the given inputs.
+	// Invalid timeouts are already reported and are not passed to the driver.
+	if !validTimeout {
+		parsed.Timeout = ""
+	}
+
+	if checker, isChecker := drv.(driver.Checker); ok && isChecker && tmplErr == nil {
+		if err := checker.Check(parsed); err != nil {
+			errs = append(errs, FieldError{Field: "script", Message: err.Error()})
+		}
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+
+	return nil
+}
@@ -0,0 +1,93 @@
+package validate
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Unbabel/replicant/driver"
+	"github.com/Unbabel/replicant/driver/javascript"
+	"github.com/Unbabel/replicant/transaction"
+	"github.com/Unbabel/replicant/transaction/callback"
+)
+
+func TestValidate(t *testing.T) {
+	js, err := javascript.New()
+	if err != nil {
+		t.Fatalf("error creating driver: %s", err)
+	}
+
+	v := New(Config{Drivers: []driver.Driver{js}})
+
+	valid := transaction.Config{
+		Name:     "test-transaction",
+		Driver:   "javascript",
+		Schedule: "@every 60s",
+		Timeout:  "5s",
+		Inputs:   map[string]interface{}{"message": "test"},
+		Script:   `function Run(ctx) { return "{{ index . "message" }}" }`,
+	}
+
+	tests := []struct {
+		name   string
+		config func(c transaction.Config) transaction.Config
+		fields []string
+	}{
+		{name: "valid", config: func(c transaction.Config) transaction.Config { return c }},
+		{name: "schedule", fields: []string{"schedule"},
+			config: func(c transaction.Config) transaction.Config { c.Schedule = "@every blade"; return c }},
+		{name: "timeout", fields: []string{"timeout"},
+			config: func(c transaction.Config) transaction.Config { c.Timeout = "5 seconds"; return c }},
+		{name: "retry count", fields: []string{"retry_count"},
+			config: func(c transaction.Config) transaction.Config { c.RetryCount = -1; return c }},
+		{name: "driver", fields: []string{"driver"},
+			config: func(c transaction.Config) transaction.Config { c.Driver = "replicant"; return c }},
+		{name: "template", fields: []string{"script"},
+			config: func(c transaction.Config) transaction.Config { c.Script = `{{ index . "message" `; return c }},
+		{name: "script", fields: []string{"script"},
+			config: func(c transaction.Config) transaction.Config { c.Script = `function Run(ctx) {`; return c }},
+		{name: "script not run",
+			config: func(c transaction.Config) transaction.Config {
+				c.Script = `throw "ran"; function Run(ctx) {}`
+				return c
+			}},
+		{name: "callback", fields: []string{"callback.type"},
+			config: func(c transaction.Config) transaction.Config {
+				c.CallBack = &callback.Config{Type: "unregistered"}
+				return c
+			}},
+		{name: "multiple", fields: []string{"schedule", "timeout", "driver"},
+			config: func(c transaction.Config) transaction.Config {
+				c.Schedule = "* *"
+				c.Timeout = "-1s"
+				c.Driver = ""
+				return c
+			}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Validate(tt.config(valid))
+			if len(tt.fields) == 0 {
+				if err != nil {
+					t.Fatalf("Validator.Validate() error = %s", err)
+				}
+				return
+			}
+
+			var errs Errors
+			if !errors.As(err, &errs) {
+				t.Fatalf("Validator.Validate() error = %v, want Errors", err)
+			}
+
+			if len(errs) != len(tt.fields) {
+				t.Fatalf("Validator.Validate() = %v, want errors for %v", errs, tt.fields)
+			}
+
+			for x := range errs {
+				if errs[x].Field != tt.fields[x] {
+					t.Errorf("Validator.Validate()[%d] field = %s, want %s", x, errs[x].Field, tt.fields[x])
+				}
+			}
+		})
+	}
+}